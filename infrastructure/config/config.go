@@ -3,20 +3,36 @@ package config
 import (
 	"net"
 	"strconv"
+	"time"
 
 	"github.com/alecthomas/kong"
 	"github.com/go-sql-driver/mysql"
 )
 
 type Config struct {
-	AppAddr string `env:"APP_ADDR" default:":8080"`
-	DBUser  string `env:"NS_MARIADB_USER" default:"root"`
-	DBPass  string `env:"NS_MARIADB_PASSWORD" default:"pass"`
-	DBHost  string `env:"NS_MARIADB_HOSTNAME" default:"localhost"`
-	DBPort  int    `env:"NS_MARIADB_PORT" default:"3306"`
-	DBName  string `env:"NS_MARIADB_DATABASE" default:"app"`
-	LiteLLMAPIKey  string `env:"LITELLM_API_KEY" default:""` 
-    LiteLLMBaseURL string `env:"LITELLM_BASE_URL" default:"https://api.openai.com/v1https://llm-proxy.trap.jp"`}
+	AppAddr            string        `env:"APP_ADDR" default:":8080"`
+	DBUser             string        `env:"NS_MARIADB_USER" default:"root"`
+	DBPass             string        `env:"NS_MARIADB_PASSWORD" default:"pass"`
+	DBHost             string        `env:"NS_MARIADB_HOSTNAME" default:"localhost"`
+	DBPort             int           `env:"NS_MARIADB_PORT" default:"3306"`
+	DBName             string        `env:"NS_MARIADB_DATABASE" default:"app"`
+	LiteLLMAPIKey      string        `env:"LITELLM_API_KEY" default:""`
+	LiteLLMBaseURL     string        `env:"LITELLM_BASE_URL" default:"https://api.openai.com/v1https://llm-proxy.trap.jp"`
+	ReminderInterval   time.Duration `env:"REMINDER_INTERVAL" default:"10m"`
+	TrashPurgeInterval time.Duration `env:"TRASH_PURGE_INTERVAL" default:"1h"`
+	OAuthClientID      string        `env:"TRAQ_OAUTH_CLIENT_ID" default:""`
+	OAuthClientSecret  string        `env:"TRAQ_OAUTH_CLIENT_SECRET" default:""`
+	OAuthRedirectURL   string        `env:"TRAQ_OAUTH_REDIRECT_URL" default:""`
+	LoginRedirectURL   string        `env:"LOGIN_REDIRECT_URL" default:"/"`
+	SessionTTL         time.Duration `env:"SESSION_TTL" default:"168h"`
+	CookieSecure       bool          `env:"SESSION_COOKIE_SECURE" default:"true"`
+	TrustedProxyCIDRs  []string      `env:"TRUSTED_PROXY_CIDRS" sep:","`
+	UserSyncInterval   time.Duration `env:"USER_SYNC_INTERVAL" default:"1h"`
+	ManagerGroupIDs    []string      `env:"TRAQ_MANAGER_GROUP_IDS" sep:","`
+	AssistantGroupIDs  []string      `env:"TRAQ_ASSISTANT_GROUP_IDS" sep:","`
+	MemberGroupIDs     []string      `env:"TRAQ_MEMBER_GROUP_IDS" sep:","`
+	UserCacheTTL       time.Duration `env:"TRAQ_USER_CACHE_TTL" default:"10m"`
+}
 
 func (c *Config) Parse() {
 	kong.Parse(c)
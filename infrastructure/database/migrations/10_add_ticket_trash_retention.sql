@@ -0,0 +1,19 @@
+-- +goose Up
+
+ALTER TABLE configs
+  ADD COLUMN trash_retention_days INT NOT NULL DEFAULT 30 AFTER due_policy;
+
+ALTER TABLE ticket_events
+  MODIFY COLUMN action ENUM(
+    'ticket_created',
+    'ticket_updated',
+    'ticket_deleted',
+    'ticket_restored',
+    'status_derived',
+    'note_created',
+    'note_updated',
+    'note_deleted',
+    'review_created',
+    'review_updated',
+    'review_deleted'
+  ) NOT NULL;
@@ -0,0 +1,5 @@
+-- +goose Up
+
+ALTER TABLE tickets
+  ADD INDEX idx_tickets_created_at (deleted_at, created_at, id),
+  ADD INDEX idx_tickets_due (deleted_at, due, id);
@@ -0,0 +1,19 @@
+-- +goose Up
+
+-- MariaDB には ngram パーサーがないため、アプリケーション側で 2-gram に分割したトークンを全文検索する
+CREATE TABLE IF NOT EXISTS search_documents (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    kind ENUM('ticket', 'note', 'review') NOT NULL,
+    source_id INT UNSIGNED NOT NULL,
+    ticket_id INT UNSIGNED NOT NULL,
+    note_id INT UNSIGNED,
+    review_id INT UNSIGNED,
+    tokens MEDIUMTEXT NOT NULL,
+    censored_tokens MEDIUMTEXT NOT NULL,
+    UNIQUE KEY uk_search_documents_source (kind, source_id),
+    FULLTEXT INDEX ft_search_documents_tokens (tokens),
+    FULLTEXT INDEX ft_search_documents_censored_tokens (censored_tokens),
+    CONSTRAINT `1` FOREIGN KEY (ticket_id) REFERENCES tickets(id) ON DELETE CASCADE,
+    CONSTRAINT `2` FOREIGN KEY (note_id) REFERENCES notes(id) ON DELETE CASCADE,
+    CONSTRAINT `3` FOREIGN KEY (review_id) REFERENCES reviews(id) ON DELETE CASCADE
+);
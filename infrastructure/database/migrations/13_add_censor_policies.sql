@@ -0,0 +1,8 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS censor_policies (
+    category VARCHAR(64) NOT NULL PRIMARY KEY,
+    roles JSON NOT NULL,
+    assignees BOOLEAN NOT NULL DEFAULT FALSE,
+    updated_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP
+);
@@ -0,0 +1,4 @@
+-- +goose Up
+
+ALTER TABLE configs
+  ADD COLUMN pii_auto_censor BOOLEAN NOT NULL DEFAULT FALSE AFTER trash_retention_days;
@@ -0,0 +1,13 @@
+-- +goose Up
+
+-- チケットを完全に削除した後も記録を残すため、外部キーは張らない
+CREATE TABLE IF NOT EXISTS uncensored_view_logs (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    actor VARCHAR(64) NOT NULL,
+    ticket_id INT UNSIGNED NOT NULL,
+    note_id INT UNSIGNED,
+    endpoint VARCHAR(255) NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_uncensored_view_logs_created_at (created_at, id),
+    INDEX idx_uncensored_view_logs_actor (actor, created_at, id)
+);
@@ -0,0 +1,14 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS sessions (
+    token_hash CHAR(64) NOT NULL PRIMARY KEY,
+    traq_id VARCHAR(64) NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_sessions_expires_at (expires_at)
+);
+
+CREATE TABLE IF NOT EXISTS oauth_states (
+    state_hash CHAR(64) NOT NULL PRIMARY KEY,
+    expires_at TIMESTAMP NOT NULL
+);
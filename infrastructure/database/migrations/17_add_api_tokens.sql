@@ -0,0 +1,14 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS api_tokens (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    traq_id VARCHAR(64) NOT NULL,
+    name VARCHAR(255) NOT NULL,
+    token_hash CHAR(64) NOT NULL,
+    scope ENUM('read', 'tickets:write', 'admin') NOT NULL,
+    expires_at TIMESTAMP NOT NULL,
+    last_used_at TIMESTAMP NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    UNIQUE KEY uq_api_tokens_token_hash (token_hash),
+    INDEX idx_api_tokens_traq_id (traq_id)
+);
@@ -0,0 +1,4 @@
+-- +goose Up
+
+ALTER TABLE tickets
+  ADD COLUMN visibility ENUM('public', 'involved', 'managers') NOT NULL DEFAULT 'public' AFTER manual_status;
@@ -0,0 +1,13 @@
+-- +goose Up
+
+-- ユーザーを削除した後も記録を残すため、外部キーは張らない
+CREATE TABLE IF NOT EXISTS user_role_changes (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    traq_id VARCHAR(64) NOT NULL,
+    actor VARCHAR(64) NOT NULL,
+    before_role VARCHAR(32),
+    after_role VARCHAR(32),
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_user_role_changes_created_at (created_at, id),
+    INDEX idx_user_role_changes_traq_id (traq_id, created_at, id)
+);
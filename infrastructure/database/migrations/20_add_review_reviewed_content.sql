@@ -0,0 +1,5 @@
+-- +goose Up
+
+-- レビュー時点のノートの本文 (本文の編集でレビューが無効化された後に差分を確認するため)
+-- このカラムの追加前のレビューは本文が記録されていないため NULL になる
+ALTER TABLE reviews ADD COLUMN reviewed_content TEXT AFTER comment;
@@ -0,0 +1,46 @@
+-- +goose Up
+
+-- ノートの本文の版
+-- revision はノートごとに 1 から振る通し番号
+CREATE TABLE IF NOT EXISTS note_revisions (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    note_id INT UNSIGNED NOT NULL,
+    revision INT UNSIGNED NOT NULL,
+    author VARCHAR(64) NOT NULL,
+    content TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (note_id) REFERENCES notes(id) ON DELETE CASCADE
+);
+
+-- 既存のノートは、無効化されたレビューが記録したレビュー時点の本文と現在の本文を版にする
+-- 過去の版の作成者は分からないため、ノートの作成者とする
+INSERT INTO note_revisions (note_id, revision, author, content, created_at)
+SELECT r.note_id, 0, n.author, r.reviewed_content, MIN(r.created_at)
+FROM reviews r
+JOIN notes n ON r.note_id = n.id
+WHERE r.reviewed_content IS NOT NULL AND r.reviewed_content <> n.content
+GROUP BY r.note_id, n.author, r.reviewed_content;
+
+INSERT INTO note_revisions (note_id, revision, author, content, created_at)
+SELECT id, 0, author, content, updated_at FROM notes;
+
+UPDATE note_revisions nr
+JOIN (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY note_id ORDER BY created_at, id) AS num
+    FROM note_revisions
+) numbered ON nr.id = numbered.id
+SET nr.revision = numbered.num;
+
+ALTER TABLE note_revisions ADD UNIQUE KEY uq_note_revisions_note_revision (note_id, revision);
+
+-- レビューした版 (版の記録前のレビューで本文が分からない場合は NULL)
+ALTER TABLE reviews ADD COLUMN revision_id INT UNSIGNED AFTER comment;
+ALTER TABLE reviews ADD CONSTRAINT fk_reviews_revision FOREIGN KEY (revision_id) REFERENCES note_revisions(id) ON DELETE SET NULL;
+
+UPDATE reviews r
+JOIN (
+    SELECT note_id, content, MAX(id) AS id FROM note_revisions GROUP BY note_id, content
+) nr ON nr.note_id = r.note_id AND nr.content = r.reviewed_content
+SET r.revision_id = nr.id;
+
+ALTER TABLE reviews DROP COLUMN reviewed_content;
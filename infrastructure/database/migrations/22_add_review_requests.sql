@@ -0,0 +1,8 @@
+-- +goose Up
+
+-- レビュー依頼 (note_review_assignees) の依頼者と依頼日時
+ALTER TABLE note_review_assignees ADD COLUMN requested_by VARCHAR(64) NOT NULL DEFAULT '' AFTER assignee;
+ALTER TABLE note_review_assignees ADD COLUMN created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP AFTER requested_by;
+
+-- 送信待ちになるためにレビューを依頼した全員の承認を必要とするか
+ALTER TABLE notes ADD COLUMN require_requested_reviews BOOLEAN NOT NULL DEFAULT FALSE AFTER status;
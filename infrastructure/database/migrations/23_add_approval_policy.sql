@@ -0,0 +1,5 @@
+-- +goose Up
+
+-- ノートが送信待ちになる条件 (タグごとに上書きできる)
+ALTER TABLE configs
+  ADD COLUMN approval_policy JSON NOT NULL DEFAULT ('{"default":{"threshold":5,"max_weights":{"manager":5,"assistant":4,"member":0},"require_manager_approval":false,"block_on_change_requests":false},"tag_rules":[]}') AFTER due_policy;
@@ -0,0 +1,9 @@
+-- +goose Up
+
+-- ノートをレビューに出した日時と送信した日時
+ALTER TABLE notes ADD COLUMN submitted_at TIMESTAMP NULL DEFAULT NULL AFTER require_requested_reviews;
+ALTER TABLE notes ADD COLUMN sent_at TIMESTAMP NULL DEFAULT NULL AFTER submitted_at;
+
+-- 既存のノートは正確な日時が分からないため、最終更新日時で埋める
+UPDATE notes SET submitted_at = updated_at, updated_at = updated_at WHERE status IN ('waiting_review', 'waiting_sent', 'sent');
+UPDATE notes SET sent_at = updated_at, updated_at = updated_at WHERE status = 'sent';
@@ -0,0 +1,23 @@
+-- +goose Up
+
+-- リマインドの基準日時 (送信待ちのノートは最後に更新された日時、期日超過のチケットは期日)
+-- 送信待ちに戻ったノートや期日を変更したチケットには、改めてリマインドを送る
+ALTER TABLE reminder_logs ADD COLUMN base_time DATETIME NOT NULL DEFAULT '1970-01-01 00:00:00' AFTER offset_value;
+
+UPDATE reminder_logs rl
+JOIN notes n ON rl.target_id = n.id
+SET rl.base_time = n.updated_at
+WHERE rl.kind = 'note_waiting_sent';
+
+UPDATE reminder_logs rl
+JOIN tickets t ON rl.target_id = t.id
+SET rl.base_time = t.due
+WHERE rl.kind = 'ticket_overdue' AND t.due IS NOT NULL;
+
+ALTER TABLE reminder_logs ALTER COLUMN base_time DROP DEFAULT;
+ALTER TABLE reminder_logs DROP PRIMARY KEY, ADD PRIMARY KEY (kind, target_id, offset_value, base_time);
+
+-- 送信待ちのノートのリマインドは、設定した時間によらずノートと基準日時ごとに 1 回だけ送る
+-- 設定した時間を変更しても送り直さないよう、記録済みの時間を 0 にまとめる
+UPDATE IGNORE reminder_logs SET offset_value = 0 WHERE kind = 'note_waiting_sent';
+DELETE FROM reminder_logs WHERE kind = 'note_waiting_sent' AND offset_value <> 0;
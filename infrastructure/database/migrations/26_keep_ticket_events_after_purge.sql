@@ -0,0 +1,4 @@
+-- +goose Up
+
+-- 変更履歴は監査のため、チケットを完全に削除した後も残す
+ALTER TABLE ticket_events DROP FOREIGN KEY `1`;
@@ -0,0 +1,9 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS reminder_logs (
+    kind ENUM('ticket_overdue', 'note_waiting_sent') NOT NULL,
+    target_id INT UNSIGNED NOT NULL,
+    offset_value INT NOT NULL,
+    sent_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY(kind, target_id, offset_value)
+);
@@ -0,0 +1,4 @@
+-- +goose Up
+
+ALTER TABLE configs
+  ADD COLUMN due_policy JSON NOT NULL DEFAULT ('{"default_business_days":7,"tag_business_days":[]}') AFTER overdue_day;
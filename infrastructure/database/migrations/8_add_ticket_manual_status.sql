@@ -0,0 +1,4 @@
+-- +goose Up
+
+ALTER TABLE tickets
+  ADD COLUMN manual_status BOOLEAN NOT NULL DEFAULT FALSE AFTER status;
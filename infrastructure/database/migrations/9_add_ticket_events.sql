@@ -0,0 +1,25 @@
+-- +goose Up
+
+CREATE TABLE IF NOT EXISTS ticket_events (
+    id INT UNSIGNED AUTO_INCREMENT PRIMARY KEY,
+    ticket_id INT UNSIGNED NOT NULL,
+    actor VARCHAR(64) NOT NULL,
+    action ENUM(
+        'ticket_created',
+        'ticket_updated',
+        'ticket_deleted',
+        'status_derived',
+        'note_created',
+        'note_updated',
+        'note_deleted',
+        'review_created',
+        'review_updated',
+        'review_deleted'
+    ) NOT NULL,
+    note_id INT UNSIGNED,
+    review_id INT UNSIGNED,
+    changes JSON NOT NULL,
+    created_at TIMESTAMP DEFAULT CURRENT_TIMESTAMP,
+    INDEX idx_ticket_events_ticket_id (ticket_id, id),
+    CONSTRAINT `1` FOREIGN KEY (ticket_id) REFERENCES tickets(id) ON DELETE CASCADE
+);
@@ -1,29 +1,66 @@
 package injector
 
 import (
+	"net/http"
+
 	"github.com/jmoiron/sqlx"
 	"github.com/traP-jp/anshin-techo-backend/internal/api"
 	"github.com/traP-jp/anshin-techo-backend/internal/handler"
 	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/auth"
 	"github.com/traP-jp/anshin-techo-backend/internal/service/bot"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/pii"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/reminder"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/retention"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/userdir"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/usersync"
 )
 
 type Dependencies struct {
 	DB  *sqlx.DB
 	Bot bot.Client
+	// Users は traQ のユーザー一覧のキャッシュ (サーバー・スケジューラなどで共有する)
+	Users *userdir.Directory
+}
+
+func InjectRepository(deps Dependencies) *repository.Repository {
+	return repository.New(deps.DB, deps.Bot, deps.Users)
 }
 
-func InjectServer(deps Dependencies) (*api.Server, error) {
-	repo := repository.New(deps.DB, deps.Bot)
-	h := handler.New(repo)
-	s, err := api.NewServer(h, h)
+func InjectServer(deps Dependencies, authCfg auth.Config, userSyncCfg usersync.Config) (http.Handler, error) {
+	trustedProxies, err := auth.ParseCIDRs(authCfg.TrustedProxyCIDRs)
+	if err != nil {
+		return nil, err
+	}
+
+	repo := repository.New(deps.DB, deps.Bot, deps.Users)
+	h := handler.New(repo, pii.NewDefaultDetector(), authCfg, usersync.NewSyncer(repo, deps.Bot.API(), userSyncCfg), deps.Users)
+	s, err := api.NewServer(h, h, api.WithMiddleware(h.AuditMiddleware, h.AuthorizationMiddleware), api.WithErrorHandler(handler.ErrorHandler))
 	if err != nil {
 		return nil, err
 	}
 
-	return s, nil
+	return auth.TrustedProxy(s, trustedProxies), nil
 }
 
 func InjectBotHandlerService(deps Dependencies) *bot.HandlerService {
 	return bot.NewHandlerService(deps.Bot)
 }
+
+func InjectReminderScheduler(deps Dependencies, cfg reminder.Config) *reminder.Scheduler {
+	repo := repository.New(deps.DB, deps.Bot, deps.Users)
+
+	return reminder.NewScheduler(repo, deps.Bot, deps.Users, cfg)
+}
+
+func InjectTrashPurger(deps Dependencies, cfg retention.Config) *retention.Purger {
+	repo := repository.New(deps.DB, deps.Bot, deps.Users)
+
+	return retention.NewPurger(repo, cfg)
+}
+
+func InjectUserSyncer(deps Dependencies, cfg usersync.Config) *usersync.Syncer {
+	repo := repository.New(deps.DB, deps.Bot, deps.Users)
+
+	return usersync.NewSyncer(repo, deps.Bot.API(), cfg)
+}
@@ -0,0 +1,98 @@
+package integrationtests
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestApprovalPolicy(t *testing.T) {
+	truncateAllTables(t)
+
+	noteStatus := func(t *testing.T, ticketPath string) any {
+		t.Helper()
+
+		rec := doRequest(t, "GET", ticketPath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		return unmarshalResponse(t, rec)["notes"].([]any)[0].(map[string]any)["status"]
+	}
+
+	// createNote : ramdos が担当するチケットにレビュー待ちのノートを作り、チケットとノートのパスを返す
+	createNote := func(t *testing.T, tags string) (string, string) {
+		t.Helper()
+
+		rec := doRequest(t, "POST", "/tickets", "Pugma", fmt.Sprintf(`{"title": "協賛","status": "not_written","assignee": "ramdos","tags": %s}`, tags))
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath := fmt.Sprintf("/tickets/%v", unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "協賛をお願いします。","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		notePath := fmt.Sprintf("%s/notes/%v", ticketPath, unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", notePath+"/submit", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		return ticketPath, notePath
+	}
+
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"},{"traq_id":"Hokaze","role":"assistant"},{"traq_id":"jupiter_68","role":"assistant"},{"traq_id":"Akira_256","role":"assistant"},{"traq_id":"gUuUnya","role":"member"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		body := `{"reminder_interval":{"overdue_day":[],"notesent_hour":0},"revise_prompt":"","approval_policy":{` +
+			`"default":{"threshold":5,"max_weights":{"manager":5,"assistant":4,"member":0},"require_manager_approval":true,"block_on_change_requests":true},` +
+			`"tag_rules":[{"tag":"急ぎ","rule":{"threshold":2,"max_weights":{"manager":5,"assistant":4,"member":1},"require_manager_approval":false,"block_on_change_requests":false}}]}}`
+		rec = doRequest(t, "POST", "/config", "Pugma", body)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("default rule", func(t *testing.T) {
+		ticketPath, notePath := createNote(t, `[]`)
+
+		rec := doRequest(t, "POST", notePath+"/reviews", "Hokaze", `{"type": "approve","weight": 5,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+
+		rec = doRequest(t, "POST", notePath+"/reviews", "Hokaze", `{"type": "approve","weight": 4,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		rec = doRequest(t, "POST", notePath+"/reviews", "jupiter_68", `{"type": "approve","weight": 4,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		// 重みは足りているが、本職の承認がない
+		assert.Equal(t, noteStatus(t, ticketPath), "waiting_review")
+
+		rec = doRequest(t, "POST", notePath+"/reviews", "Akira_256", `{"type": "change_request","weight": 0,"comment": "not LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		crID := unmarshalResponse(t, rec)["id"]
+
+		rec = doRequest(t, "POST", notePath+"/reviews", "Pugma", `{"type": "approve","weight": 1,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		// 修正依頼が残っている
+		assert.Equal(t, noteStatus(t, ticketPath), "draft")
+
+		rec = doRequest(t, "PUT", fmt.Sprintf("%s/reviews/%v", notePath, crID), "Akira_256", `{"type": "comment","weight": 0,"comment": "fixed"}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		// 下書きに戻ったノートはレビューに出し直すと送信待ちになる
+		assert.Equal(t, noteStatus(t, ticketPath), "draft")
+
+		rec = doRequest(t, "POST", notePath+"/submit", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, noteStatus(t, ticketPath), "waiting_sent")
+	})
+
+	t.Run("tag rule", func(t *testing.T) {
+		ticketPath, notePath := createNote(t, `["急ぎ"]`)
+
+		// タグのルールでは一般ユーザーも承認の重みを持つ
+		rec := doRequest(t, "POST", notePath+"/reviews", "gUuUnya", `{"type": "approve","weight": 1,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		assert.Equal(t, noteStatus(t, ticketPath), "waiting_review")
+
+		rec = doRequest(t, "POST", notePath+"/reviews", "Hokaze", `{"type": "approve","weight": 1,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		assert.Equal(t, noteStatus(t, ticketPath), "waiting_sent")
+	})
+}
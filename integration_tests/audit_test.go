@@ -0,0 +1,80 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestUncensoredViewAudit(t *testing.T) {
+	truncateAllTables(t)
+
+	var ticketID int
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"kenken","role":"manager"},{"traq_id":"ramdos","role":"assistant"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", "/tickets", "ramdos", `{"title": "協賛","description": "金額は!!10万円!!","status": "not_written","assignee": "ramdos"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketID = int(unmarshalResponse(t, rec)["id"].(float64))
+
+		rec = doRequest(t, "POST", "/tickets/"+strconv.Itoa(ticketID)+"/notes", "ramdos", `{"type": "outgoing","content": "本文!!秘密!!","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		rec = doRequest(t, "POST", "/tickets", "ramdos", `{"title": "伏字なし","status": "not_written","assignee": "ramdos"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+	})
+
+	t.Run("censored views are not recorded", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/tickets/"+strconv.Itoa(ticketID), "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", "/audit/uncensored-views", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `[]`)
+	})
+
+	t.Run("uncensored views are recorded", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/tickets", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", "/tickets/"+strconv.Itoa(ticketID), "kenken", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", "/audit/uncensored-views", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		expectedBody := `[` +
+			`{"id":[ID],"actor":"kenken","ticket_id":[ID],"endpoint":"GET /tickets/` + strconv.Itoa(ticketID) + `","created_at":"[TIME]"},` +
+			`{"id":[ID],"actor":"kenken","ticket_id":[ID],"note_id":[ID],"endpoint":"GET /tickets/` + strconv.Itoa(ticketID) + `","created_at":"[TIME]"},` +
+			`{"id":[ID],"actor":"Pugma","ticket_id":[ID],"endpoint":"GET /tickets","created_at":"[TIME]"}` +
+			`]`
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+
+	t.Run("filter by actor and date", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/audit/uncensored-views?actor=Pugma", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), `[{"id":[ID],"actor":"Pugma","ticket_id":[ID],"endpoint":"GET /tickets","created_at":"[TIME]"}]`)
+
+		since := url.QueryEscape(time.Now().Add(24 * time.Hour).Format(time.RFC3339))
+		rec = doRequest(t, "GET", "/audit/uncensored-views?since="+since, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `[]`)
+
+		until := url.QueryEscape(time.Now().Add(-24 * time.Hour).Format(time.RFC3339))
+		rec = doRequest(t, "GET", "/audit/uncensored-views?until="+until, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `[]`)
+	})
+
+	t.Run("non-manager cannot read the log", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/audit/uncensored-views", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+	})
+}
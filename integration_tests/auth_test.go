@@ -0,0 +1,133 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestAuth(t *testing.T) {
+	truncateAllTables(t)
+
+	// login : ログインを開始し、state と state の Cookie を返す
+	login := func(t *testing.T) (string, *http.Cookie) {
+		t.Helper()
+
+		rec := doRequest(t, "GET", "/auth/login", "", ``)
+		assert.Equal(t, rec.Result().Status, `302 Found`)
+		location, err := url.Parse(rec.Header().Get("Location"))
+		assert.NilError(t, err)
+		state := location.Query().Get("state")
+		assert.Assert(t, state != "")
+
+		cookies := rec.Result().Cookies()
+		assert.Equal(t, len(cookies), 1)
+		assert.Equal(t, cookies[0].Name, `anshin_oauth_state`)
+		assert.Assert(t, cookies[0].HttpOnly)
+
+		return state, cookies[0]
+	}
+
+	var sessionCookie *http.Cookie
+	t.Run("login with traQ OAuth", func(t *testing.T) {
+		state, stateCookie := login(t)
+
+		rec := doCookieRequest(t, "GET", "/auth/callback?code=Pugma&state="+url.QueryEscape(state), stateCookie)
+		assert.Equal(t, rec.Result().Status, `302 Found`)
+		assert.Equal(t, rec.Header().Get("Location"), `/`)
+
+		cookies := rec.Result().Cookies()
+		assert.Equal(t, len(cookies), 1)
+		assert.Equal(t, cookies[0].Name, `anshin_session`)
+		assert.Assert(t, cookies[0].HttpOnly)
+		sessionCookie = cookies[0]
+	})
+
+	t.Run("session cookie authenticates", func(t *testing.T) {
+		rec := doCookieRequest(t, "GET", "/me", sessionCookie)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `{"id":"Pugma"}`)
+	})
+
+	t.Run("state cannot be reused", func(t *testing.T) {
+		state, stateCookie := login(t)
+
+		rec := doCookieRequest(t, "GET", "/auth/callback?code=Pugma&state="+url.QueryEscape(state), stateCookie)
+		assert.Equal(t, rec.Result().Status, `302 Found`)
+
+		rec = doCookieRequest(t, "GET", "/auth/callback?code=Pugma&state="+url.QueryEscape(state), stateCookie)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+	})
+
+	t.Run("unknown state is rejected", func(t *testing.T) {
+		//nolint:exhaustruct
+		rec := doCookieRequest(t, "GET", "/auth/callback?code=Pugma&state=unknown", &http.Cookie{Name: "anshin_oauth_state", Value: "unknown"})
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+	})
+
+	t.Run("state from another browser is rejected", func(t *testing.T) {
+		// 攻撃者が開始したログインのコールバックを、Cookie を持たない被害者のブラウザで開いた場合
+		state, _ := login(t)
+
+		rec := doRequest(t, "GET", "/auth/callback?code=Pugma&state="+url.QueryEscape(state), "", ``)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+
+		_, otherCookie := login(t)
+		rec = doCookieRequest(t, "GET", "/auth/callback?code=Pugma&state="+url.QueryEscape(state), otherCookie)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+	})
+
+	t.Run("failed code exchange is rejected", func(t *testing.T) {
+		state, stateCookie := login(t)
+
+		rec := doCookieRequest(t, "GET", "/auth/callback?code=&state="+url.QueryEscape(state), stateCookie)
+		assert.Equal(t, rec.Result().Status, `401 Unauthorized`)
+	})
+
+	t.Run("logout deletes the session", func(t *testing.T) {
+		rec := doCookieRequest(t, "POST", "/auth/logout", sessionCookie)
+		assert.Equal(t, rec.Result().Status, `204 No Content`)
+
+		cookies := rec.Result().Cookies()
+		assert.Equal(t, len(cookies), 1)
+		assert.Equal(t, cookies[0].Name, `anshin_session`)
+		assert.Equal(t, cookies[0].MaxAge, -1)
+
+		rec = doCookieRequest(t, "GET", "/me", sessionCookie)
+		assert.Equal(t, rec.Result().Status, `401 Unauthorized`)
+	})
+
+	t.Run("forwarded user header from trusted proxy", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/me", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `{"id":"Pugma"}`)
+	})
+
+	t.Run("forwarded user header from untrusted source is ignored", func(t *testing.T) {
+		req := httptest.NewRequest("GET", "/me", nil)
+		req.RemoteAddr = "203.0.113.1:1234"
+		req.Header.Set("X-Forwarded-User", "Pugma")
+		rec := httptest.NewRecorder()
+
+		globalServer.ServeHTTP(rec, req)
+
+		assert.Equal(t, rec.Result().Status, `401 Unauthorized`)
+	})
+}
+
+func doCookieRequest(t *testing.T, method, path string, cookie *http.Cookie) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, nil)
+	req.AddCookie(cookie)
+	rec := httptest.NewRecorder()
+
+	globalServer.ServeHTTP(rec, req)
+
+	return rec
+}
@@ -0,0 +1,69 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"strconv"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestAuthorization(t *testing.T) {
+	truncateAllTables(t)
+
+	t.Run("anyone can register users before a manager exists", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "kitsne", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"},{"traq_id":"kitsne","role":"member"},{"traq_id":"H1rono_K","role":"member"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("only managers can update users", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "kitsne", `[{"traq_id":"kitsne","role":"manager"}]`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "PUT", "/users", "ramdos", `[{"traq_id":"ramdos","role":"manager"}]`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+	})
+
+	var ticketPath, notePath string
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/tickets", "ramdos", `{"title": "協賛","status": "not_written","assignee": "ramdos","stakeholders": ["kitsne"]}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath = "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "本文","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		notePath = ticketPath + "/notes/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+	})
+
+	t.Run("only managers, assistants and related members can add notes", func(t *testing.T) {
+		rec := doRequest(t, "POST", ticketPath+"/notes", "H1rono_K", `{"type": "outgoing","content": "本文","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "kitsne", `{"type": "other","content": "メモ","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+	})
+
+	t.Run("only managers and the author can edit and delete notes", func(t *testing.T) {
+		rec := doRequest(t, "PUT", notePath, "kitsne", `{"status": "draft","content": "書き換え","reset_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "DELETE", notePath, "kitsne", ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "PUT", notePath, "ramdos", `{"status": "draft","content": "修正","reset_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "DELETE", notePath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `204 No Content`)
+	})
+
+	t.Run("unregistered users cannot review", func(t *testing.T) {
+		rec := doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "本文","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		reviewPath := ticketPath + "/notes/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64))) + "/reviews"
+
+		rec = doRequest(t, "POST", reviewPath, "cp20", `{"type": "comment","weight": 0,"comment": "コメント"}`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+	})
+}
@@ -0,0 +1,106 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCensorPreservingEdit(t *testing.T) {
+	truncateAllTables(t)
+
+	var ticketPath, notePath string
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "件名!!社名!!","description": "連絡先は!!090-0000-0000!!と!!a@example.com!!","status": "not_written","assignee": "ramdos"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath = "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "Pugma", `{"type": "outgoing","content": "本文!!秘密!!","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		notePath = ticketPath + "/notes/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+	})
+
+	t.Run("edit ticket keeping placeholders", func(t *testing.T) {
+		rec := doRequest(t, "PATCH", ticketPath, "ramdos", `{"title": "件名!!■■■!! (更新)","description": "電話は!!■■■!!、メールは!!■■■!!"}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", ticketPath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		body := unmarshalResponse(t, rec)
+		assert.Equal(t, body["title"], `件名!!社名!! (更新)`)
+		assert.Equal(t, body["description"], `電話は!!090-0000-0000!!、メールは!!a@example.com!!`)
+	})
+
+	t.Run("edit ticket with mismatched placeholders", func(t *testing.T) {
+		rec := doRequest(t, "PATCH", ticketPath, "ramdos", `{"description": "電話は!!■■■!!"}`)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+
+		expectedBody := `{"message":"the number of censored placeholders does not match the original text","field":"description","expected_placeholders":2,"actual_placeholders":1}`
+		assert.Equal(t, rec.Body.String(), expectedBody)
+	})
+
+	t.Run("edit ticket removing all placeholders", func(t *testing.T) {
+		// 伏字をすべて消すと閲覧できない内容が失われるので受け付けない
+		rec := doRequest(t, "PATCH", ticketPath, "ramdos", `{"description": "連絡先なし"}`)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+
+		expectedBody := `{"message":"the number of censored placeholders does not match the original text","field":"description","expected_placeholders":2,"actual_placeholders":0}`
+		assert.Equal(t, rec.Body.String(), expectedBody)
+
+		rec = doRequest(t, "GET", ticketPath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, unmarshalResponse(t, rec)["description"], `電話は!!090-0000-0000!!、メールは!!a@example.com!!`)
+	})
+
+	t.Run("edit ticket with mismatched categories", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "カテゴリ","description": "!!phone:090-0000-0000!!と!!mail:a@example.com!!","status": "not_written","assignee": "ramdos"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		categoryTicketPath := "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		rec = doRequest(t, "PATCH", categoryTicketPath, "ramdos", `{"description": "!!mail:■■■!!と!!phone:■■■!!"}`)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+
+		expectedBody := `{"message":"the categories of censored placeholders do not match the original text","field":"description","expected_placeholders":2,"actual_placeholders":2}`
+		assert.Equal(t, rec.Body.String(), expectedBody)
+
+		rec = doRequest(t, "PATCH", categoryTicketPath, "ramdos", `{"description": "電話: !!phone:■■■!!、メール: !!mail:■■■!!"}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", categoryTicketPath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, unmarshalResponse(t, rec)["description"], `電話: !!phone:090-0000-0000!!、メール: !!mail:a@example.com!!`)
+	})
+
+	t.Run("edit note keeping placeholders", func(t *testing.T) {
+		rec := doRequest(t, "PUT", notePath, "ramdos", `{"status": "draft","content": "本文!!■■■!!を修正","reset_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", ticketPath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Assert(t, strings.Contains(rec.Body.String(), `"content":"本文!!秘密!!を修正"`))
+	})
+
+	t.Run("edit note with mismatched placeholders", func(t *testing.T) {
+		rec := doRequest(t, "PUT", notePath, "ramdos", `{"status": "draft","content": "!!■■■!!と!!■■■!!","reset_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+
+		expectedBody := `{"message":"the number of censored placeholders does not match the original text","field":"content","expected_placeholders":1,"actual_placeholders":2}`
+		assert.Equal(t, rec.Body.String(), expectedBody)
+	})
+
+	t.Run("manager edits placeholders literally", func(t *testing.T) {
+		rec := doRequest(t, "PUT", notePath, "Pugma", `{"status": "draft","content": "本文!!■■■!!","reset_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", ticketPath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Assert(t, strings.Contains(rec.Body.String(), `"content":"本文!!■■■!!"`))
+	})
+}
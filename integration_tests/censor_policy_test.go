@@ -0,0 +1,123 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestCensorPolicy(t *testing.T) {
+	truncateAllTables(t)
+
+	globalTraQ.reset([]fakeTraQUser{
+		{Name: "Pugma", Bot: false, Suspended: false},
+		{Name: "ramdos", Bot: false, Suspended: false},
+		{Name: "kitsne", Bot: false, Suspended: false},
+		{Name: "H1rono_K", Bot: false, Suspended: false},
+	}, map[string][]string{})
+
+	var ticketPath string
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"},{"traq_id":"kitsne","role":"member"},{"traq_id":"H1rono_K","role":"member"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "協賛","description": "担当は!!contact:山田様!!、金額は!!price:10万円!!、備考!!社外秘!!","status": "not_written","assignee": "kitsne"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath = "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+	})
+
+	t.Run("update policy by non-manager", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/config/censor-policy", "ramdos", `[]`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "GET", "/config/censor-policy", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+	})
+
+	t.Run("update policy with duplicate categories", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/config/censor-policy", "Pugma", `[{"category":"contact","roles":["assistant"],"assignees":false},{"category":"contact","roles":[],"assignees":true}]`)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+	})
+
+	t.Run("update policy", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/config/censor-policy", "Pugma", `[{"category":"price","roles":[],"assignees":true},{"category":"contact","roles":["assistant"],"assignees":false}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		expectedBody := `[{"category":"contact","roles":["assistant"],"assignees":false},{"category":"price","roles":[],"assignees":true}]`
+		assert.Equal(t, rec.Body.String(), expectedBody)
+
+		rec = doRequest(t, "GET", "/config/censor-policy", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), expectedBody)
+	})
+
+	t.Run("censor by category", func(t *testing.T) {
+		cases := []struct {
+			user        string
+			description string
+		}{
+			{"Pugma", `担当は!!contact:山田様!!、金額は!!price:10万円!!、備考!!社外秘!!`},
+			{"ramdos", `担当は!!contact:山田様!!、金額は!!price:■■■!!、備考!!■■■!!`},
+			{"kitsne", `担当は!!contact:■■■!!、金額は!!price:10万円!!、備考!!■■■!!`},
+			{"H1rono_K", `担当は!!contact:■■■!!、金額は!!price:■■■!!、備考!!■■■!!`},
+		}
+		for _, c := range cases {
+			rec := doRequest(t, "GET", ticketPath, c.user, ``)
+			assert.Equal(t, rec.Result().Status, `200 OK`)
+			assert.Equal(t, unmarshalResponse(t, rec)["description"], c.description)
+		}
+	})
+
+	t.Run("edit keeping hidden categories", func(t *testing.T) {
+		rec := doRequest(t, "PATCH", ticketPath, "ramdos", `{"description": "担当は!!contact:佐藤様!!、金額は!!price:■■■!!、備考!!■■■!!"}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", ticketPath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, unmarshalResponse(t, rec)["description"], `担当は!!contact:佐藤様!!、金額は!!price:10万円!!、備考!!社外秘!!`)
+	})
+
+	t.Run("censor direct messages by category", func(t *testing.T) {
+		globalDMs.take()
+
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "!!contact:山田様!!への連絡","description": "金額は!!price:10万円!!","status": "not_written","assignee": "kitsne","stakeholders": ["ramdos","H1rono_K"]}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketID := strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		cases := []struct {
+			user     string
+			title    string
+			contains string
+		}{
+			{"ramdos", "タイトル: !!contact:山田様!!への連絡", "金額は!!price:■■■!!"},
+			{"kitsne", "タイトル: !!contact:■■■!!への連絡", "金額は!!price:10万円!!"},
+			{"H1rono_K", "タイトル: !!contact:■■■!!への連絡", "金額は!!price:■■■!!"},
+		}
+		for _, c := range cases {
+			content := globalDMs.content("uuid-" + c.user)
+			assert.Assert(t, strings.Contains(content, c.title), "%s: %s", c.user, content)
+			assert.Assert(t, strings.Contains(content, c.contains), "%s: %s", c.user, content)
+		}
+
+		// 伏字を伏せずに送った宛先は閲覧したものとして記録する
+		rec = doRequest(t, "GET", "/audit/uncensored-views", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		actors := []string{}
+		views := []map[string]any{}
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &views))
+		for _, view := range views {
+			if view["endpoint"] == "traQ DM" && fmt.Sprint(view["ticket_id"]) == ticketID {
+				actors = append(actors, view["actor"].(string))
+			}
+		}
+		slices.Sort(actors)
+		assert.DeepEqual(t, actors, []string{"kitsne", "ramdos"})
+	})
+}
@@ -3,7 +3,10 @@
 package integrationtests
 
 import (
+	"strconv"
+	"strings"
 	"testing"
+	"time"
 
 	"gotest.tools/v3/assert"
 )
@@ -24,7 +27,7 @@ func TestConfig(t *testing.T) {
 		rec := doRequest(t, "GET", "/config", "Pugma", "")
 
 		expectedStatus := `200 OK`
-		expectedBody := `{"reminder_interval":{"overdue_day":[],"notesent_hour":0},"revise_prompt":""}`
+		expectedBody := `{"reminder_interval":{"overdue_day":[],"notesent_hour":0},"revise_prompt":"","due_policy":{"default_business_days":7,"tag_business_days":[]},"approval_policy":{"default":{"threshold":5,"max_weights":{"manager":5,"assistant":4,"member":0},"require_manager_approval":false,"block_on_change_requests":false},"tag_rules":[]},"trash_retention_days":30,"pii_auto_censor":false}`
 		assert.Equal(t, rec.Result().Status, expectedStatus)
 		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 	})
@@ -43,7 +46,7 @@ func TestConfig(t *testing.T) {
 		rec := doRequest(t, "POST", "/config", "Pugma", body)
 
 		expectedStatus := `200 OK`
-		expectedBody := `{"reminder_interval":{"overdue_day":[1,3,7],"notesent_hour":12},"revise_prompt":"Please revise."}`
+		expectedBody := `{"reminder_interval":{"overdue_day":[1,3,7],"notesent_hour":12},"revise_prompt":"Please revise.","due_policy":{"default_business_days":7,"tag_business_days":[]},"approval_policy":{"default":{"threshold":5,"max_weights":{"manager":5,"assistant":4,"member":0},"require_manager_approval":false,"block_on_change_requests":false},"tag_rules":[]},"trash_retention_days":30,"pii_auto_censor":false}`
 		assert.Equal(t, rec.Result().Status, expectedStatus)
 		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 	})
@@ -62,8 +65,98 @@ func TestConfig(t *testing.T) {
 		rec := doRequest(t, "GET", "/config", "Pugma", "")
 
 		expectedStatus := `200 OK`
-		expectedBody := `{"reminder_interval":{"overdue_day":[1,3,7],"notesent_hour":12},"revise_prompt":"Please revise."}`
+		expectedBody := `{"reminder_interval":{"overdue_day":[1,3,7],"notesent_hour":12},"revise_prompt":"Please revise.","due_policy":{"default_business_days":7,"tag_business_days":[]},"approval_policy":{"default":{"threshold":5,"max_weights":{"manager":5,"assistant":4,"member":0},"require_manager_approval":false,"block_on_change_requests":false},"tag_rules":[]},"trash_retention_days":30,"pii_auto_censor":false}`
 		assert.Equal(t, rec.Result().Status, expectedStatus)
 		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 	})
+
+	t.Run("update due policy as manager", func(t *testing.T) {
+		body := `{"reminder_interval":{"overdue_day":[1,3,7],"notesent_hour":12},"revise_prompt":"Please revise.","due_policy":{"default_business_days":5,"tag_business_days":[{"tag":"問い合わせ","business_days":0},{"tag":"協賛","business_days":10}]}}`
+		rec := doRequest(t, "POST", "/config", "Pugma", body)
+
+		expectedStatus := `200 OK`
+		expectedBody := `{"reminder_interval":{"overdue_day":[1,3,7],"notesent_hour":12},"revise_prompt":"Please revise.","due_policy":{"default_business_days":5,"tag_business_days":[{"tag":"問い合わせ","business_days":0},{"tag":"協賛","business_days":10}]},"approval_policy":{"default":{"threshold":5,"max_weights":{"manager":5,"assistant":4,"member":0},"require_manager_approval":false,"block_on_change_requests":false},"tag_rules":[]},"trash_retention_days":30,"pii_auto_censor":false}`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+
+	t.Run("keep due policy when omitted", func(t *testing.T) {
+		body := `{"reminder_interval":{"overdue_day":[1],"notesent_hour":12},"revise_prompt":"Please revise."}`
+		rec := doRequest(t, "POST", "/config", "Pugma", body)
+
+		expectedStatus := `200 OK`
+		expectedBody := `{"reminder_interval":{"overdue_day":[1],"notesent_hour":12},"revise_prompt":"Please revise.","due_policy":{"default_business_days":5,"tag_business_days":[{"tag":"問い合わせ","business_days":0},{"tag":"協賛","business_days":10}]},"approval_policy":{"default":{"threshold":5,"max_weights":{"manager":5,"assistant":4,"member":0},"require_manager_approval":false,"block_on_change_requests":false},"tag_rules":[]},"trash_retention_days":30,"pii_auto_censor":false}`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+
+	t.Run("reject negative business days", func(t *testing.T) {
+		body := `{"reminder_interval":{"overdue_day":[1],"notesent_hour":12},"revise_prompt":"","due_policy":{"default_business_days":-1,"tag_business_days":[]}}`
+		rec := doRequest(t, "POST", "/config", "Pugma", body)
+
+		expectedStatus := `400 Bad Request`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+	})
+
+	t.Run("update approval policy as manager", func(t *testing.T) {
+		body := `{"reminder_interval":{"overdue_day":[1],"notesent_hour":12},"revise_prompt":"Please revise.","approval_policy":{"default":{"threshold":6,"max_weights":{"manager":5,"assistant":3,"member":1},"require_manager_approval":true,"block_on_change_requests":true},"tag_rules":[{"tag":"問い合わせ","rule":{"threshold":1,"max_weights":{"manager":1,"assistant":1,"member":0},"require_manager_approval":false,"block_on_change_requests":false}}]}}`
+		rec := doRequest(t, "POST", "/config", "Pugma", body)
+
+		expectedStatus := `200 OK`
+		expectedBody := `{"reminder_interval":{"overdue_day":[1],"notesent_hour":12},"revise_prompt":"Please revise.","due_policy":{"default_business_days":5,"tag_business_days":[{"tag":"問い合わせ","business_days":0},{"tag":"協賛","business_days":10}]},"approval_policy":{"default":{"threshold":6,"max_weights":{"manager":5,"assistant":3,"member":1},"require_manager_approval":true,"block_on_change_requests":true},"tag_rules":[{"tag":"問い合わせ","rule":{"threshold":1,"max_weights":{"manager":1,"assistant":1,"member":0},"require_manager_approval":false,"block_on_change_requests":false}}]},"trash_retention_days":30,"pii_auto_censor":false}`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+
+	t.Run("reject unsatisfiable approval policy", func(t *testing.T) {
+		// 本職の承認が必要だが、本職は承認の重みを持てない
+		body := `{"reminder_interval":{"overdue_day":[1],"notesent_hour":12},"revise_prompt":"","approval_policy":{"default":{"threshold":5,"max_weights":{"manager":0,"assistant":5,"member":0},"require_manager_approval":true,"block_on_change_requests":false},"tag_rules":[]}}`
+		rec := doRequest(t, "POST", "/config", "Pugma", body)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+
+		// 同じタグのルールが複数ある
+		body = `{"reminder_interval":{"overdue_day":[1],"notesent_hour":12},"revise_prompt":"","approval_policy":{"default":{"threshold":5,"max_weights":{"manager":5,"assistant":4,"member":0},"require_manager_approval":false,"block_on_change_requests":false},"tag_rules":[` +
+			`{"tag":"協賛","rule":{"threshold":1,"max_weights":{"manager":1,"assistant":1,"member":0},"require_manager_approval":false,"block_on_change_requests":false}},` +
+			`{"tag":"協賛","rule":{"threshold":2,"max_weights":{"manager":2,"assistant":2,"member":0},"require_manager_approval":false,"block_on_change_requests":false}}]}}`
+		rec = doRequest(t, "POST", "/config", "Pugma", body)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+
+		// しきい値が 0
+		body = `{"reminder_interval":{"overdue_day":[1],"notesent_hour":12},"revise_prompt":"","approval_policy":{"default":{"threshold":0,"max_weights":{"manager":5,"assistant":4,"member":0},"require_manager_approval":false,"block_on_change_requests":false},"tag_rules":[]}}`
+		rec = doRequest(t, "POST", "/config", "Pugma", body)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+	})
+
+	t.Run("set due automatically by tag policy", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "協賛","status": "not_written","assignee": "Pugma","tags": ["協賛"]}`)
+
+		expectedStatus := `201 Created`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+
+		due, err := time.Parse(time.DateOnly, unmarshalResponse(t, rec)["due"].(string))
+		assert.NilError(t, err)
+		// 10営業日後は土日を挟むため、少なくとも14日後になる
+		assert.Assert(t, !due.Before(time.Now().AddDate(0, 0, 13)))
+	})
+
+	t.Run("leave due empty when policy is zero", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "問い合わせ","status": "not_written","assignee": "Pugma","tags": ["問い合わせ"]}`)
+
+		expectedStatus := `201 Created`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Assert(t, strings.Contains(rec.Body.String(), `"due":null`))
+	})
+
+	t.Run("reset due when ticket is reopened", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "再開","status": "completed","assignee": "Pugma","due": "2020-01-01"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketID := int(unmarshalResponse(t, rec)["id"].(float64))
+
+		rec = doRequest(t, "PATCH", "/tickets/"+strconv.Itoa(ticketID), "Pugma", `{"status": "not_written"}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", "/tickets/"+strconv.Itoa(ticketID), "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Assert(t, !strings.Contains(rec.Body.String(), `"due":"2020-01-01"`))
+	})
 }
@@ -35,6 +35,7 @@ func truncateAllTables(t *testing.T) {
 		"SET FOREIGN_KEY_CHECKS = 0",
 		"TRUNCATE TABLE note_review_assignees",
 		"TRUNCATE TABLE reviews",
+		"TRUNCATE TABLE note_revisions",
 		"TRUNCATE TABLE notes",
 		"TRUNCATE TABLE ticket_sub_assignees",
 		"TRUNCATE TABLE ticket_stakeholders",
@@ -42,6 +43,15 @@ func truncateAllTables(t *testing.T) {
 		"TRUNCATE TABLE tickets",
 		"TRUNCATE TABLE users",
 		"TRUNCATE TABLE configs",
+		"TRUNCATE TABLE reminder_logs",
+		"TRUNCATE TABLE ticket_events",
+		"TRUNCATE TABLE search_documents",
+		"TRUNCATE TABLE censor_policies",
+		"TRUNCATE TABLE uncensored_view_logs",
+		"TRUNCATE TABLE sessions",
+		"TRUNCATE TABLE oauth_states",
+		"TRUNCATE TABLE api_tokens",
+		"TRUNCATE TABLE user_role_changes",
 		"SET FOREIGN_KEY_CHECKS = 1",
 		"INSERT INTO configs (id, revise_prompt, notesent_hour, overdue_day) VALUES (1, '', 0, JSON_ARRAY()) ON DUPLICATE KEY UPDATE id = VALUES(id)",
 	}
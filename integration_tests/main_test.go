@@ -4,19 +4,29 @@ import (
 	"fmt"
 	"log"
 	"net/http"
+	"net/http/httptest"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/ory/dockertest/v3"
 	"github.com/traP-jp/anshin-techo-backend/infrastructure/config"
 	"github.com/traP-jp/anshin-techo-backend/infrastructure/database"
 	"github.com/traP-jp/anshin-techo-backend/infrastructure/injector"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/auth"
 	"github.com/traP-jp/anshin-techo-backend/internal/service/bot"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/userdir"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/usersync"
+	"github.com/traPtitech/go-traq"
 )
 
 var (
 	globalServer http.Handler
 	globalDB     *sqlx.DB
+	globalTraQ   *fakeTraQ
+	globalDMs    *directMessages
+	globalUsers  *userdir.Directory
 )
 
 func TestMain(m *testing.M) {
@@ -73,11 +83,33 @@ func run(m *testing.M) error {
 		return fmt.Errorf("connect to database container: %w", err)
 	}
 
+	globalTraQ = newFakeTraQ()
+	traqServer := httptest.NewServer(globalTraQ)
+	defer traqServer.Close()
+
 	mockBot := bot.NewMockService()
+	mockBot.APIFunc = func() *traq.APIClient { return globalTraQ.client(traqServer) }
+	globalDMs = &directMessages{mu: sync.Mutex{}, userIDs: nil, contents: map[string]string{}}
+	mockBot.PostDirectMessageFunc = globalDMs.record
+
+	// キャッシュせずに毎回偽の traQ から取得する
+	globalUsers = userdir.New(mockBot.API(), userdir.Config{TTL: time.Nanosecond})
 
 	server, err := injector.InjectServer(injector.Dependencies{
-		DB:  db,
-		Bot: mockBot,
+		DB:    db,
+		Bot:   mockBot,
+		Users: globalUsers,
+	}, auth.Config{
+		Provider:          auth.NewFakeProvider(),
+		SessionTTL:        time.Hour,
+		CookieSecure:      false,
+		LoginRedirectURL:  "/",
+		TrustedProxyCIDRs: []string{"192.0.2.0/24"},
+	}, usersync.Config{
+		Interval:          time.Hour,
+		ManagerGroupIDs:   []string{"managers"},
+		AssistantGroupIDs: []string{"assistants"},
+		MemberGroupIDs:    []string{"members"},
 	})
 	if err != nil {
 		return fmt.Errorf("inject server: %w", err)
@@ -0,0 +1,92 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestNoteRevisions(t *testing.T) {
+	truncateAllTables(t)
+
+	var notePath string
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "協賛","status": "not_written","assignee": "Pugma"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath := fmt.Sprintf("/tickets/%v", unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "Pugma", `{"type": "outgoing","content": "金額は!!10万円!!です。","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		notePath = fmt.Sprintf("%s/notes/%v", ticketPath, unmarshalResponse(t, rec)["id"])
+
+		// 本文が変わらない編集は版にならない
+		rec = doRequest(t, "PUT", notePath, "Pugma", `{"status": "draft","content": "金額は!!10万円!!です。","reset_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "PUT", notePath, "Pugma", `{"status": "draft","content": "金額は!!20万円!!です。\nよろしくお願いします。","reset_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("list revisions", func(t *testing.T) {
+		rec := doRequest(t, "GET", notePath+"/revisions", "Pugma", ``)
+
+		expectedStatus := `200 OK`
+		expectedBody := `[` +
+			`{"revision":1,"author":"Pugma","content":"金額は!!10万円!!です。","created_at":"[TIME]"},` +
+			`{"revision":2,"author":"Pugma","content":"金額は!!20万円!!です。\nよろしくお願いします。","created_at":"[TIME]"}` +
+			`]`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+
+	t.Run("list censored revisions", func(t *testing.T) {
+		rec := doRequest(t, "GET", notePath+"/revisions", "ramdos", ``)
+
+		expectedStatus := `200 OK`
+		expectedBody := `[` +
+			`{"revision":1,"author":"Pugma","content":"金額は!!■■■!!です。","created_at":"[TIME]"},` +
+			`{"revision":2,"author":"Pugma","content":"金額は!!■■■!!です。\nよろしくお願いします。","created_at":"[TIME]"}` +
+			`]`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+
+	t.Run("diff revisions", func(t *testing.T) {
+		rec := doRequest(t, "GET", notePath+"/revisions/1/diff/2", "Pugma", ``)
+
+		expectedStatus := `200 OK`
+		expectedBody := `{"from_revision":1,"to_revision":2,"changed":true,"lines":[` +
+			`{"type":"delete","text":"金額は!!10万円!!です。","segments":[{"type":"equal","text":"金額は!!"},{"type":"delete","text":"1"},{"type":"equal","text":"0万円!!です。"}]},` +
+			`{"type":"insert","text":"金額は!!20万円!!です。","segments":[{"type":"equal","text":"金額は!!"},{"type":"insert","text":"2"},{"type":"equal","text":"0万円!!です。"}]},` +
+			`{"type":"insert","text":"よろしくお願いします。"}` +
+			`]}`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+
+	t.Run("diff hides changes inside censored text", func(t *testing.T) {
+		rec := doRequest(t, "GET", notePath+"/revisions/1/diff/2", "ramdos", ``)
+
+		expectedStatus := `200 OK`
+		expectedBody := `{"from_revision":1,"to_revision":2,"changed":true,"lines":[` +
+			`{"type":"equal","text":"金額は!!■■■!!です。"},` +
+			`{"type":"insert","text":"よろしくお願いします。"}` +
+			`]}`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+
+	t.Run("unknown revision", func(t *testing.T) {
+		rec := doRequest(t, "GET", notePath+"/revisions/1/diff/3", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+
+		rec = doRequest(t, "GET", notePath+"0/revisions", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+	})
+}
@@ -0,0 +1,184 @@
+package integrationtests
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestNoteWorkflow(t *testing.T) {
+	truncateAllTables(t)
+
+	globalTraQ.reset([]fakeTraQUser{
+		{Name: "Pugma", Bot: false, Suspended: false},
+		{Name: "ramdos", Bot: false, Suspended: false},
+		{Name: "Hokaze", Bot: false, Suspended: false},
+		{Name: "jupiter_68", Bot: false, Suspended: false},
+	}, map[string][]string{})
+
+	var ticketPath, notePath string
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"},{"traq_id":"Hokaze","role":"assistant"},{"traq_id":"jupiter_68","role":"assistant"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "協賛","status": "not_written","assignee": "ramdos","sub_assignees": ["Hokaze"],"stakeholders": ["jupiter_68"]}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath = fmt.Sprintf("/tickets/%v", unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "協賛をお願いします。","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		notePath = fmt.Sprintf("%s/notes/%v", ticketPath, unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", notePath+"/review-requests", "ramdos", `{"reviewers": ["Pugma"],"require_requested_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("put does not change status", func(t *testing.T) {
+		rec := doRequest(t, "PUT", notePath, "ramdos", `{"status": "sent","content": "協賛をお願いします。"}`)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+
+		// 現在と同じステータスは受け付ける
+		rec = doRequest(t, "PUT", notePath, "ramdos", `{"status": "draft","content": "協賛をお願いします。"}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", ticketPath, "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		note := unmarshalResponse(t, rec)["notes"].([]any)[0].(map[string]any)
+		assert.Equal(t, note["status"], "draft")
+	})
+
+	t.Run("invalid transitions", func(t *testing.T) {
+		rec := doRequest(t, "POST", notePath+"/send", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+
+		rec = doRequest(t, "POST", notePath+"/withdraw", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+
+		// 副担当者でもノートの作成者でなければ操作できない
+		rec = doRequest(t, "POST", notePath+"/submit", "Hokaze", ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "POST", ticketPath+"/notes/0/submit", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+	})
+
+	t.Run("submit and withdraw", func(t *testing.T) {
+		globalDMs.take()
+
+		rec := doRequest(t, "POST", notePath+"/submit", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		note := unmarshalResponse(t, rec)
+		assert.Equal(t, note["status"], "waiting_review")
+		assert.Assert(t, note["submitted_at"] != nil)
+
+		// 担当者・副担当者とレビューを依頼したユーザーに通知する (操作したユーザーを除く)
+		assert.DeepEqual(t, globalDMs.take(), []string{"uuid-Hokaze", "uuid-Pugma"})
+
+		rec = doRequest(t, "POST", notePath+"/withdraw", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		note = unmarshalResponse(t, rec)
+		assert.Equal(t, note["status"], "draft")
+		assert.Equal(t, note["submitted_at"], nil)
+
+		rec = doRequest(t, "POST", notePath+"/submit", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("send", func(t *testing.T) {
+		rec := doRequest(t, "POST", notePath+"/reviews", "Pugma", `{"type": "approve","weight": 5,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		globalDMs.take()
+
+		rec = doRequest(t, "POST", notePath+"/send", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		note := unmarshalResponse(t, rec)
+		assert.Equal(t, note["status"], "sent")
+		assert.Assert(t, note["sent_at"] != nil)
+
+		// 担当者・副担当者と関係者に通知する
+		assert.DeepEqual(t, globalDMs.take(), []string{"uuid-Hokaze", "uuid-jupiter_68"})
+
+		rec = doRequest(t, "POST", notePath+"/cancel", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+
+		// 送信済みのノートは本文も編集できない
+		rec = doRequest(t, "PUT", notePath, "ramdos", `{"content": "送信後に書き換えた本文です。"}`)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+		assert.Equal(t, unmarshalResponse(t, rec)["status"], "sent")
+
+		rec = doRequest(t, "GET", notePath+"/revisions", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		revisions := []map[string]any{}
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &revisions))
+		assert.Equal(t, len(revisions), 1)
+	})
+
+	t.Run("incoming note", func(t *testing.T) {
+		rec := doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "incoming","content": "承知しました。","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		incomingNotePath := fmt.Sprintf("%s/notes/%v", ticketPath, unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", incomingNotePath+"/submit", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+
+		rec = doRequest(t, "POST", incomingNotePath+"/cancel", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, unmarshalResponse(t, rec)["status"], "canceled")
+
+		// 破棄済みのノートは本文も編集できない
+		rec = doRequest(t, "PUT", incomingNotePath, "ramdos", `{"content": "承知しました。よろしくお願いします。"}`)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+		assert.Equal(t, unmarshalResponse(t, rec)["status"], "canceled")
+	})
+
+	t.Run("approvals do not skip the workflow", func(t *testing.T) {
+		noteStatus := func(t *testing.T, notePath string) any {
+			t.Helper()
+
+			rec := doRequest(t, "GET", ticketPath, "ramdos", ``)
+			assert.Equal(t, rec.Result().Status, `200 OK`)
+			for _, note := range unmarshalResponse(t, rec)["notes"].([]any) {
+				note := note.(map[string]any)
+				if fmt.Sprintf("%s/notes/%v", ticketPath, note["id"]) == notePath {
+					return note["status"]
+				}
+			}
+			t.Fatalf("note %s not found", notePath)
+
+			return nil
+		}
+
+		// 下書きのノートは承認されてもレビューに出すまで送信待ちにならない
+		rec := doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "追加のご連絡です。","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		draftNotePath := fmt.Sprintf("%s/notes/%v", ticketPath, unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", draftNotePath+"/reviews", "Pugma", `{"type": "approve","weight": 5,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		assert.Equal(t, noteStatus(t, draftNotePath), "draft")
+
+		rec = doRequest(t, "POST", draftNotePath+"/submit", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, unmarshalResponse(t, rec)["status"], "waiting_sent")
+
+		// 送信済みのノートは承認されても送信待ちに戻らない
+		rec = doRequest(t, "POST", notePath+"/reviews", "Hokaze", `{"type": "approve","weight": 4,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		assert.Equal(t, noteStatus(t, notePath), "sent")
+
+		// 破棄したノートは承認されても送信待ちに戻らない
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "不要な連絡です。","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		canceledNotePath := fmt.Sprintf("%s/notes/%v", ticketPath, unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", canceledNotePath+"/cancel", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", canceledNotePath+"/reviews", "Pugma", `{"type": "approve","weight": 5,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		assert.Equal(t, noteStatus(t, canceledNotePath), "canceled")
+	})
+}
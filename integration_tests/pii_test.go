@@ -0,0 +1,87 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"strconv"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestPIIDetection(t *testing.T) {
+	truncateAllTables(t)
+
+	var ticketPath, notePath string
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("warn on create", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/tickets", "ramdos", `{"title": "問い合わせ","description": "連絡先 yamada@example.com / 03-1234-5678 (!!090-1111-2222!!)","status": "not_written","assignee": "ramdos"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		body := unmarshalResponse(t, rec)
+		ticketPath = "/tickets/" + strconv.Itoa(int(body["id"].(float64)))
+		assert.Equal(t, body["description"], `連絡先 yamada@example.com / 03-1234-5678 (!!■■■!!)`)
+		assert.DeepEqual(t, body["pii_warnings"], []any{
+			map[string]any{"field": "description", "kind": "email", "text": "yamada@example.com", "censored": false},
+			map[string]any{"field": "description", "kind": "phone", "text": "03-1234-5678", "censored": false},
+		})
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "incoming","content": "振込先 普通 1234567 / 〒100-0001","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		body = unmarshalResponse(t, rec)
+		notePath = ticketPath + "/notes/" + strconv.Itoa(int(body["id"].(float64)))
+		assert.DeepEqual(t, body["pii_warnings"], []any{
+			map[string]any{"field": "content", "kind": "bank_account", "text": "1234567", "censored": false},
+			map[string]any{"field": "content", "kind": "postal_code", "text": "〒100-0001", "censored": false},
+		})
+	})
+
+	t.Run("no warnings without pii", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/tickets", "ramdos", `{"title": "個人情報なし","status": "not_written","assignee": "ramdos","due": "2025-12-31"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		_, ok := unmarshalResponse(t, rec)["pii_warnings"]
+		assert.Assert(t, !ok)
+	})
+
+	t.Run("scan existing rows", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/pii/scan", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "GET", "/pii/scan", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		expectedBody := `[` +
+			`{"ticket_id":[ID],"field":"description","kind":"email","text":"yamada@example.com"},` +
+			`{"ticket_id":[ID],"field":"description","kind":"phone","text":"03-1234-5678"},` +
+			`{"ticket_id":[ID],"note_id":[ID],"field":"content","kind":"bank_account","text":"1234567"},` +
+			`{"ticket_id":[ID],"note_id":[ID],"field":"content","kind":"postal_code","text":"〒100-0001"}` +
+			`]`
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+
+	t.Run("auto censor on update", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/config", "Pugma", `{"reminder_interval":{"overdue_day":[],"notesent_hour":0},"revise_prompt":"","pii_auto_censor":true}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "PATCH", ticketPath, "Pugma", `{"description": "連絡先 yamada@example.com (!!090-1111-2222!!)"}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `{"pii_warnings":[{"field":"description","kind":"email","text":"yamada@example.com","censored":true}]}`)
+
+		rec = doRequest(t, "PUT", notePath, "ramdos", `{"status": "draft","content": "振込先 普通 1234567","reset_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `{"pii_warnings":[{"field":"content","kind":"bank_account","text":"1234567","censored":true}]}`)
+
+		rec = doRequest(t, "GET", ticketPath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		body := unmarshalResponse(t, rec)
+		assert.Equal(t, body["description"], `連絡先 !!email:yamada@example.com!! (!!090-1111-2222!!)`)
+		assert.Equal(t, body["notes"].([]any)[0].(map[string]any)["content"], `振込先 普通 !!bank_account:1234567!!`)
+
+		rec = doRequest(t, "GET", "/pii/scan", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `[]`)
+	})
+}
@@ -0,0 +1,141 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/traP-jp/anshin-techo-backend/infrastructure/injector"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/bot"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/reminder"
+	"gotest.tools/v3/assert"
+)
+
+func TestReminder(t *testing.T) {
+	truncateAllTables(t)
+
+	globalTraQ.reset([]fakeTraQUser{
+		{Name: "Pugma", Bot: false, Suspended: false},
+		{Name: "ramdos", Bot: false, Suspended: false},
+	}, map[string][]string{})
+
+	sent := []string{}
+	messages := map[string][]string{}
+	failing := false
+	mockBot := bot.NewMockService()
+	mockBot.PostDirectMessageFunc = func(_ context.Context, userID string, content string) error {
+		if failing {
+			return fmt.Errorf("traQ is unavailable")
+		}
+		sent = append(sent, userID)
+		messages[userID] = append(messages[userID], content)
+
+		return nil
+	}
+
+	now := time.Date(2025, 12, 20, 12, 0, 0, 0, time.UTC)
+	scheduler := injector.InjectReminderScheduler(injector.Dependencies{
+		DB:    globalDB,
+		Bot:   mockBot,
+		Users: globalUsers,
+	}, reminder.Config{
+		Interval: time.Minute,
+		Now:      func() time.Time { return now },
+	})
+
+	var ticketID int
+	var noteID int
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", "/config", "Pugma", `{"reminder_interval":{"overdue_day":[1,3],"notesent_hour":12},"revise_prompt":""}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "期限切れ!!秘密!!","status": "not_written","assignee": "ramdos","sub_assignees": ["Pugma"],"due": "2025-12-18"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketID = int(unmarshalResponse(t, rec)["id"].(float64))
+
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "期限内","status": "not_written","assignee": "ramdos","due": "2025-12-25"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "完了済み","status": "completed","assignee": "ramdos","due": "2025-12-01"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		rec = doRequest(t, "POST", fmt.Sprintf("/tickets/%d/notes", ticketID), "ramdos", `{"type": "outgoing","content": "送信待ち","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		noteID = int(unmarshalResponse(t, rec)["id"].(float64))
+
+		_, err := globalDB.Exec("UPDATE notes SET status = 'waiting_sent', updated_at = ? WHERE id = ?", now.Add(-13*time.Hour), noteID)
+		assert.NilError(t, err)
+	})
+
+	t.Run("send reminders for overdue tickets and waiting notes", func(t *testing.T) {
+		sent = []string{}
+		assert.NilError(t, scheduler.RunOnce(context.Background()))
+
+		sort.Strings(sent)
+		assert.DeepEqual(t, sent, []string{"uuid-Pugma", "uuid-Pugma", "uuid-ramdos", "uuid-ramdos"})
+
+		// タイトルの伏字は宛先が閲覧できない場合は伏せる
+		assert.Assert(t, strings.Contains(strings.Join(messages["uuid-Pugma"], "\n"), "タイトル: 期限切れ!!秘密!!"))
+		assert.Assert(t, strings.Contains(strings.Join(messages["uuid-ramdos"], "\n"), "タイトル: 期限切れ!!■■■!!"))
+	})
+
+	t.Run("do not resend reminders", func(t *testing.T) {
+		sent = []string{}
+		assert.NilError(t, scheduler.RunOnce(context.Background()))
+
+		assert.DeepEqual(t, sent, []string{})
+	})
+
+	t.Run("send next reminder after more days passed", func(t *testing.T) {
+		sent = []string{}
+		now = now.AddDate(0, 0, 1)
+		assert.NilError(t, scheduler.RunOnce(context.Background()))
+
+		sort.Strings(sent)
+		assert.DeepEqual(t, sent, []string{"uuid-Pugma", "uuid-ramdos"})
+	})
+
+	t.Run("changing the notesent hour does not resend reminders", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/config", "Pugma", `{"reminder_interval":{"overdue_day":[1,3],"notesent_hour":6},"revise_prompt":""}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		sent = []string{}
+		assert.NilError(t, scheduler.RunOnce(context.Background()))
+		assert.DeepEqual(t, sent, []string{})
+	})
+
+	t.Run("remind again when a note is waiting to be sent again", func(t *testing.T) {
+		_, err := globalDB.Exec("UPDATE notes SET updated_at = ? WHERE id = ?", now.Add(-13*time.Hour), noteID)
+		assert.NilError(t, err)
+
+		sent = []string{}
+		assert.NilError(t, scheduler.RunOnce(context.Background()))
+
+		sort.Strings(sent)
+		assert.DeepEqual(t, sent, []string{"uuid-Pugma", "uuid-ramdos"})
+	})
+
+	t.Run("retry reminders that failed to send", func(t *testing.T) {
+		_, err := globalDB.Exec("UPDATE notes SET updated_at = ? WHERE id = ?", now.Add(-14*time.Hour), noteID)
+		assert.NilError(t, err)
+
+		sent = []string{}
+		failing = true
+		assert.NilError(t, scheduler.RunOnce(context.Background()))
+		assert.DeepEqual(t, sent, []string{})
+
+		failing = false
+		assert.NilError(t, scheduler.RunOnce(context.Background()))
+
+		sort.Strings(sent)
+		assert.DeepEqual(t, sent, []string{"uuid-Pugma", "uuid-ramdos"})
+	})
+}
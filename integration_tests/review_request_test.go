@@ -0,0 +1,148 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestReviewRequests(t *testing.T) {
+	truncateAllTables(t)
+
+	globalTraQ.reset([]fakeTraQUser{
+		{Name: "Pugma", Bot: false, Suspended: false},
+		{Name: "ramdos", Bot: false, Suspended: false},
+		{Name: "Hokaze", Bot: false, Suspended: false},
+		{Name: "jupiter_68", Bot: false, Suspended: false},
+	}, map[string][]string{})
+
+	var ticketPath, notePath, incomingNotePath string
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"},{"traq_id":"Hokaze","role":"assistant"},{"traq_id":"jupiter_68","role":"assistant"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "協賛","status": "not_written","assignee": "ramdos"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath = fmt.Sprintf("/tickets/%v", unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "協賛をお願いします。","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		notePath = fmt.Sprintf("%s/notes/%v", ticketPath, unmarshalResponse(t, rec)["id"])
+
+		// 同じチケットにノートを増やすと並び順が作成日時の秒単位で決まらなくなるため、別のチケットに作る
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "返信","status": "not_written","assignee": "ramdos"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		otherTicketPath := fmt.Sprintf("/tickets/%v", unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", otherTicketPath+"/notes", "ramdos", `{"type": "incoming","content": "承知しました。","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		incomingNotePath = fmt.Sprintf("%s/notes/%v", otherTicketPath, unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", notePath+"/submit", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("request reviews", func(t *testing.T) {
+		globalDMs.take()
+
+		rec := doRequest(t, "POST", notePath+"/review-requests", "ramdos", `{"reviewers": ["Hokaze","jupiter_68"],"require_requested_reviews": true}`)
+
+		expectedStatus := `200 OK`
+		expectedBody := `[{"reviewer":"Hokaze","requested_by":"ramdos","status":"pending","requested_at":"[TIME]"},{"reviewer":"jupiter_68","requested_by":"ramdos","status":"pending","requested_at":"[TIME]"}]`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+
+		// 依頼したユーザーに通知する
+		assert.DeepEqual(t, globalDMs.take(), []string{"uuid-Hokaze", "uuid-jupiter_68"})
+
+		// すでに依頼しているユーザーには再度通知しない
+		rec = doRequest(t, "POST", notePath+"/review-requests", "ramdos", `{"reviewers": ["Hokaze"],"require_requested_reviews": true}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, len(globalDMs.take()), 0)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		rec := doRequest(t, "POST", notePath+"/review-requests", "ramdos", `{"reviewers": ["unknown"],"require_requested_reviews": true}`)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+
+		rec = doRequest(t, "POST", incomingNotePath+"/review-requests", "ramdos", `{"reviewers": ["Hokaze"],"require_requested_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+
+		rec = doRequest(t, "POST", notePath+"/review-requests", "Hokaze", `{"reviewers": ["Pugma"],"require_requested_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+	})
+
+	t.Run("reviewers must be able to see the ticket", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "内密の協賛","status": "not_written","assignee": "ramdos","stakeholders": ["Hokaze"],"visibility": "involved"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		involvedTicketPath := fmt.Sprintf("/tickets/%v", unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", involvedTicketPath+"/notes", "ramdos", `{"type": "outgoing","content": "協賛をお願いします。","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		involvedNotePath := fmt.Sprintf("%s/notes/%v", involvedTicketPath, unmarshalResponse(t, rec)["id"])
+
+		globalDMs.take()
+
+		// jupiter_68 はチケットの関係者ではないので、依頼も通知もしない
+		rec = doRequest(t, "POST", involvedNotePath+"/review-requests", "ramdos", `{"reviewers": ["Hokaze","jupiter_68"],"require_requested_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+		assert.Equal(t, len(globalDMs.take()), 0)
+
+		rec = doRequest(t, "POST", involvedNotePath+"/review-requests", "ramdos", `{"reviewers": ["Hokaze","Pugma"],"require_requested_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.DeepEqual(t, globalDMs.take(), []string{"uuid-Hokaze", "uuid-Pugma"})
+	})
+
+	t.Run("weight alone is not enough", func(t *testing.T) {
+		rec := doRequest(t, "POST", notePath+"/reviews", "Pugma", `{"type": "approve","weight": 5,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		rec = doRequest(t, "POST", notePath+"/reviews", "Hokaze", `{"type": "approve","weight": 4,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		rec = doRequest(t, "POST", notePath+"/reviews", "jupiter_68", `{"type": "comment","weight": 0,"comment": "確認中"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		reviewID := unmarshalResponse(t, rec)["id"]
+
+		rec = doRequest(t, "GET", ticketPath, "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		note := unmarshalResponse(t, rec)["notes"].([]any)[0].(map[string]any)
+		assert.Equal(t, note["status"], "waiting_review")
+		assert.Equal(t, note["require_requested_reviews"], true)
+		// コメントでもレビュー済みになるが、承認ではないので送信待ちにはならない
+		for _, request := range note["review_requests"].([]any) {
+			assert.Equal(t, request.(map[string]any)["status"], "complete")
+		}
+
+		// 依頼した全員が承認すると送信待ちになる
+		rec = doRequest(t, "PUT", fmt.Sprintf("%s/reviews/%v", notePath, reviewID), "jupiter_68", `{"type": "approve","weight": 1,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", ticketPath, "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		note = unmarshalResponse(t, rec)["notes"].([]any)[0].(map[string]any)
+		assert.Equal(t, note["status"], "waiting_sent")
+	})
+
+	t.Run("new request returns note to waiting review", func(t *testing.T) {
+		rec := doRequest(t, "POST", notePath+"/review-requests", "Pugma", `{"reviewers": ["Pugma"],"require_requested_reviews": true}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", ticketPath, "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		note := unmarshalResponse(t, rec)["notes"].([]any)[0].(map[string]any)
+		// Pugma はすでに承認しているので送信待ちのまま
+		assert.Equal(t, note["status"], "waiting_sent")
+
+		rec = doRequest(t, "POST", notePath+"/review-requests", "Pugma", `{"reviewers": ["ramdos"],"require_requested_reviews": true}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", ticketPath, "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		note = unmarshalResponse(t, rec)["notes"].([]any)[0].(map[string]any)
+		assert.Equal(t, note["status"], "waiting_review")
+	})
+}
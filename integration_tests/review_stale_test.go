@@ -0,0 +1,112 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"fmt"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestStaleReviews(t *testing.T) {
+	truncateAllTables(t)
+
+	globalTraQ.reset([]fakeTraQUser{
+		{Name: "Pugma", Bot: false, Suspended: false},
+		{Name: "ramdos", Bot: false, Suspended: false},
+		{Name: "Hokaze", Bot: false, Suspended: false},
+	}, map[string][]string{})
+
+	var ticketPath, notePath string
+	var hokazeReviewID int
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"},{"traq_id":"Hokaze","role":"assistant"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "協賛","status": "not_written","assignee": "ramdos"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath = fmt.Sprintf("/tickets/%v", unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "お世話になっております。\n協賛をお願いします。","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		notePath = fmt.Sprintf("%s/notes/%v", ticketPath, unmarshalResponse(t, rec)["id"])
+
+		rec = doRequest(t, "POST", notePath+"/submit", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", notePath+"/reviews", "Hokaze", `{"type": "approve","weight": 4,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		hokazeReviewID = int(unmarshalResponse(t, rec)["id"].(float64))
+
+		rec = doRequest(t, "POST", notePath+"/reviews", "Pugma", `{"type": "approve","weight": 1,"comment": "LGTM"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+	})
+
+	t.Run("diff is empty before edit", func(t *testing.T) {
+		rec := doRequest(t, "GET", fmt.Sprintf("%s/reviews/%d/diff", notePath, hokazeReviewID), "Hokaze", ``)
+
+		expectedStatus := `200 OK`
+		expectedBody := `{"review_id":[ID],"revision":1,"latest_revision":1,"status":"active","reviewed_at":"[TIME]","changed":false,"lines":[{"type":"equal","text":"お世話になっております。"},{"type":"equal","text":"協賛をお願いします。"}]}`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+
+	t.Run("editing content makes reviews stale", func(t *testing.T) {
+		globalDMs.take()
+
+		rec := doRequest(t, "PUT", notePath, "ramdos", `{"content": "お世話になっております。\nご協賛をお願いいたします。","reset_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		// レビュワーに通知する
+		assert.DeepEqual(t, globalDMs.take(), []string{"uuid-Hokaze", "uuid-Pugma"})
+
+		rec = doRequest(t, "GET", ticketPath, "Hokaze", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		note := unmarshalResponse(t, rec)["notes"].([]any)[0].(map[string]any)
+		// 承認の重みが無効になるので送信待ちには戻らない
+		assert.Equal(t, note["status"], "waiting_review")
+		for _, review := range note["reviews"].([]any) {
+			assert.Equal(t, review.(map[string]any)["status"], "stale")
+		}
+	})
+
+	t.Run("diff since review", func(t *testing.T) {
+		rec := doRequest(t, "GET", fmt.Sprintf("%s/reviews/%d/diff", notePath, hokazeReviewID), "Hokaze", ``)
+
+		expectedStatus := `200 OK`
+		expectedBody := `{"review_id":[ID],"revision":1,"latest_revision":2,"status":"stale","reviewed_at":"[TIME]","changed":true,"lines":[` +
+			`{"type":"equal","text":"お世話になっております。"},` +
+			`{"type":"delete","text":"協賛をお願いします。","segments":[{"type":"equal","text":"協賛をお願いします。"}]},` +
+			`{"type":"insert","text":"ご協賛をお願いいたします。","segments":[{"type":"insert","text":"ご"},{"type":"equal","text":"協賛をお願い"},{"type":"insert","text":"いた"},{"type":"equal","text":"します。"}]}` +
+			`]}`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+
+		rec = doRequest(t, "GET", fmt.Sprintf("%s/reviews/%d/diff", notePath, hokazeReviewID+100), "Hokaze", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+	})
+
+	t.Run("stale reviewers can review again", func(t *testing.T) {
+		rec := doRequest(t, "POST", notePath+"/reviews", "Hokaze", `{"type": "approve","weight": 4,"comment": "LGTM again"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		rec = doRequest(t, "POST", notePath+"/reviews", "Pugma", `{"type": "approve","weight": 1,"comment": "LGTM again"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		rec = doRequest(t, "GET", ticketPath, "Hokaze", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		note := unmarshalResponse(t, rec)["notes"].([]any)[0].(map[string]any)
+		assert.Equal(t, note["status"], "waiting_sent")
+	})
+
+	t.Run("saving the same content keeps reviews", func(t *testing.T) {
+		rec := doRequest(t, "PUT", notePath, "ramdos", `{"content": "お世話になっております。\nご協賛をお願いいたします。","reset_reviews": false}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", ticketPath, "Hokaze", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		note := unmarshalResponse(t, rec)["notes"].([]any)[0].(map[string]any)
+		assert.Equal(t, note["status"], "waiting_sent")
+	})
+}
@@ -25,10 +25,10 @@ func TestReview(t *testing.T) {
 			})
 			var ticketID int
 			t.Run("prepare: create a ticket", func(t *testing.T) {
-				rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "タイトル","description": "説明","status": "completed","assignee": "hoge","sub_assignees": ["fuga"],"stakeholders": ["piyo"],"due": "2025-12-17","tags": ["タグ"]}`)
+				rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "タイトル","description": "説明","status": "not_written","assignee": "hoge","sub_assignees": ["fuga"],"stakeholders": ["piyo"],"due": "2025-12-17","tags": ["タグ"]}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"title":"タイトル","description":"説明","assignee":"hoge","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","tags":["タグ"],"due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"title":"タイトル","description":"説明","assignee":"hoge","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_written","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 				ticketID = int(unmarshalResponse(t, rec)["id"].(float64))
@@ -38,7 +38,7 @@ func TestReview(t *testing.T) {
 				rec := doRequest(t, "POST", "/tickets/"+fmt.Sprintf("%v", ticketID)+"/notes", "ramdos", `{"type": "outgoing","content": "毎々お世話になっております。","mention_notification": false}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"ticket_id":[ID],"type":"outgoing","status":"draft","author":"ramdos","content":"毎々お世話になっております。","reviews":[],"created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"ticket_id":[ID],"type":"outgoing","status":"draft","author":"ramdos","content":"毎々お世話になっております。","reviews":[],"review_requests":[],"require_requested_reviews":false,"submitted_at":null,"sent_at":null,"created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 				noteID = int(unmarshalResponse(t, rec)["id"].(float64))
@@ -47,10 +47,10 @@ func TestReview(t *testing.T) {
 			reviewPath = "/tickets/" + fmt.Sprintf("%v", ticketID) + "/notes/" + fmt.Sprintf("%v", noteID) + "/reviews"
 			ticketPath = "/tickets/" + fmt.Sprintf("%v", ticketID)
 			t.Run("prepare: make ticket ready", func(t *testing.T) {
-				rec := doRequest(t, "PUT", "/tickets/"+fmt.Sprintf("%v", ticketID)+"/notes/"+fmt.Sprintf("%v", noteID), "ramdos", `{"status": "waiting_review","content": "毎々お世話になっております。","reset_reviews": false}`)
+				rec := doRequest(t, "POST", "/tickets/"+fmt.Sprintf("%v", ticketID)+"/notes/"+fmt.Sprintf("%v", noteID)+"/submit", "ramdos", ``)
 
 				expectedStatus := `200 OK`
-				expectedBody := ``
+				expectedBody := `{"id":[ID],"ticket_id":[ID],"type":"outgoing","status":"waiting_review","author":"ramdos","content":"毎々お世話になっております。","reviews":[],"review_requests":[],"require_requested_reviews":false,"submitted_at":"[TIME]","sent_at":null,"created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -69,7 +69,7 @@ func TestReview(t *testing.T) {
 				rec := doRequest(t, "POST", reviewPath, "Hokaze", `{"type": "approve","weight": 4,"comment": "LGTM"}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"note_id":[ID],"reviewer":"Hokaze","type":"approve","weight":4,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"Hokaze","type":"approve","weight":4,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -78,7 +78,7 @@ func TestReview(t *testing.T) {
 				fmt.Println(ticketPath)
 
 				expectedStatus := `200 OK`
-				expectedBody := `{"id":[ID],"title":"タイトル","description":"説明","assignee":"hoge","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","tags":["タグ"],"due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]","notes":[{"id":[ID],"ticket_id":[ID],"type":"outgoing","status":"waiting_review","author":"ramdos","content":"毎々お世話になっております。","reviews":[{"id":[ID],"note_id":[ID],"reviewer":"Hokaze","type":"approve","weight":4,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"}],"created_at":"[TIME]","updated_at":"[TIME]"}]}`
+				expectedBody := `{"id":[ID],"title":"タイトル","description":"説明","assignee":"hoge","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"waiting_review","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]","notes":[{"id":[ID],"ticket_id":[ID],"type":"outgoing","status":"waiting_review","author":"ramdos","content":"毎々お世話になっております。","reviews":[{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"Hokaze","type":"approve","weight":4,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"}],"review_requests":[],"require_requested_reviews":false,"submitted_at":"[TIME]","sent_at":null,"created_at":"[TIME]","updated_at":"[TIME]"}]}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -87,7 +87,7 @@ func TestReview(t *testing.T) {
 				rec := doRequest(t, "POST", reviewPath, "jupiter_68", `{"type": "approve","weight": 1,"comment": "LGTM"}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"note_id":[ID],"reviewer":"jupiter_68","type":"approve","weight":1,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"jupiter_68","type":"approve","weight":1,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 				reviewID = int(unmarshalResponse(t, rec)["id"].(float64))
@@ -97,7 +97,7 @@ func TestReview(t *testing.T) {
 				fmt.Println(ticketPath)
 
 				expectedStatus := `200 OK`
-				expectedBody := `{"id":[ID],"title":"タイトル","description":"説明","assignee":"hoge","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","tags":["タグ"],"due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]","notes":[{"id":[ID],"ticket_id":[ID],"type":"outgoing","status":"waiting_sent","author":"ramdos","content":"毎々お世話になっております。","reviews":[{"id":[ID],"note_id":[ID],"reviewer":"Hokaze","type":"approve","weight":4,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"note_id":[ID],"reviewer":"jupiter_68","type":"approve","weight":1,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"}],"created_at":"[TIME]","updated_at":"[TIME]"}]}`
+				expectedBody := `{"id":[ID],"title":"タイトル","description":"説明","assignee":"hoge","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"waiting_sent","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]","notes":[{"id":[ID],"ticket_id":[ID],"type":"outgoing","status":"waiting_sent","author":"ramdos","content":"毎々お世話になっております。","reviews":[{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"Hokaze","type":"approve","weight":4,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"jupiter_68","type":"approve","weight":1,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"}],"review_requests":[],"require_requested_reviews":false,"submitted_at":"[TIME]","sent_at":null,"created_at":"[TIME]","updated_at":"[TIME]"}]}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -129,7 +129,7 @@ func TestReview(t *testing.T) {
 				rec := doRequest(t, "POST", reviewPath, "gUuUnya", `{"type": "approve","weight": 0,"comment": "little LGTM"}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"note_id":[ID],"reviewer":"gUuUnya","type":"approve","weight":0,"status":"active","comment":"little LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"gUuUnya","type":"approve","weight":0,"status":"active","comment":"little LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -137,7 +137,7 @@ func TestReview(t *testing.T) {
 				rec := doRequest(t, "POST", reviewPath, "Akira_256", `{"type": "comment","weight": 0,"comment": "comment"}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"note_id":[ID],"reviewer":"Akira_256","type":"comment","weight":0,"status":"active","comment":"comment","created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"Akira_256","type":"comment","weight":0,"status":"active","comment":"comment","created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -145,7 +145,7 @@ func TestReview(t *testing.T) {
 				rec := doRequest(t, "POST", reviewPath, "Synori", `{"type": "change_request","weight": 0,"comment": "not LGTM"}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"note_id":[ID],"reviewer":"Synori","type":"change_request","weight":0,"status":"active","comment":"not LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"Synori","type":"change_request","weight":0,"status":"active","comment":"not LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -154,7 +154,7 @@ func TestReview(t *testing.T) {
 				fmt.Println(ticketPath)
 
 				expectedStatus := `200 OK`
-				expectedBody := `{"id":[ID],"title":"タイトル","description":"説明","assignee":"hoge","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","tags":["タグ"],"due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]","notes":[{"id":[ID],"ticket_id":[ID],"type":"outgoing","status":"draft","author":"ramdos","content":"毎々お世話になっております。","reviews":[{"id":[ID],"note_id":[ID],"reviewer":"Hokaze","type":"approve","weight":4,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"note_id":[ID],"reviewer":"jupiter_68","type":"approve","weight":3,"status":"active","comment":"updated comment","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"note_id":[ID],"reviewer":"gUuUnya","type":"approve","weight":0,"status":"active","comment":"little LGTM","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"note_id":[ID],"reviewer":"Akira_256","type":"comment","weight":0,"status":"active","comment":"comment","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"note_id":[ID],"reviewer":"Synori","type":"change_request","weight":0,"status":"active","comment":"not LGTM","created_at":"[TIME]","updated_at":"[TIME]"}],"created_at":"[TIME]","updated_at":"[TIME]"}]}`
+				expectedBody := `{"id":[ID],"title":"タイトル","description":"説明","assignee":"hoge","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_written","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]","notes":[{"id":[ID],"ticket_id":[ID],"type":"outgoing","status":"draft","author":"ramdos","content":"毎々お世話になっております。","reviews":[{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"Hokaze","type":"approve","weight":4,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"jupiter_68","type":"approve","weight":3,"status":"active","comment":"updated comment","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"gUuUnya","type":"approve","weight":0,"status":"active","comment":"little LGTM","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"Akira_256","type":"comment","weight":0,"status":"active","comment":"comment","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"Synori","type":"change_request","weight":0,"status":"active","comment":"not LGTM","created_at":"[TIME]","updated_at":"[TIME]"}],"review_requests":[],"require_requested_reviews":false,"submitted_at":null,"sent_at":null,"created_at":"[TIME]","updated_at":"[TIME]"}]}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -172,7 +172,7 @@ func TestReview(t *testing.T) {
 				rec := doRequest(t, "POST", reviewPath, "Pugma", `{"type": "approve","weight": 5,"comment": "LGTM"}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"note_id":[ID],"reviewer":"Pugma","type":"approve","weight":5,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"Pugma","type":"approve","weight":5,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -197,7 +197,7 @@ func TestReview(t *testing.T) {
 				rec := doRequest(t, "POST", reviewPath, "aruze_pino", `{"type": "approve","weight": 0,"comment": "little LGTM"}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"note_id":[ID],"reviewer":"aruze_pino","type":"approve","weight":0,"status":"active","comment":"little LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"aruze_pino","type":"approve","weight":0,"status":"active","comment":"little LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -217,7 +217,7 @@ func TestReview(t *testing.T) {
 				rec := doRequest(t, "POST", reviewPath, "kenken", `{"type": "approve","weight": 0,"comment": "LGTM"}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"note_id":[ID],"reviewer":"kenken","type":"approve","weight":0,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"kenken","type":"approve","weight":0,"status":"active","comment":"LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -227,7 +227,7 @@ func TestReview(t *testing.T) {
 				rec := doRequest(t, "POST", reviewPath, "ramdos", `{"type": "approve","weight": 0,"comment": "little LGTM"}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"note_id":[ID],"reviewer":"ramdos","type":"approve","weight":0,"status":"active","comment":"little LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"note_id":[ID],"revision":1,"reviewer":"ramdos","type":"approve","weight":0,"status":"active","comment":"little LGTM","created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -0,0 +1,119 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestSearch(t *testing.T) {
+	truncateAllTables(t)
+
+	globalTraQ.reset([]fakeTraQUser{
+		{Name: "Pugma", Bot: false, Suspended: false},
+		{Name: "ramdos", Bot: false, Suspended: false},
+		{Name: "kitsne", Bot: false, Suspended: false},
+	}, map[string][]string{})
+
+	search := func(t *testing.T, user string, q string) []map[string]any {
+		t.Helper()
+
+		rec := doRequest(t, "GET", "/search?q="+url.QueryEscape(q), user, ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		hits := []map[string]any{}
+		assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &hits))
+
+		return hits
+	}
+	hitTypes := func(hits []map[string]any) []string {
+		types := []string{}
+		for _, hit := range hits {
+			types = append(types, hit["type"].(string))
+		}
+		sort.Strings(types)
+
+		return types
+	}
+
+	var ticketPath string
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"},{"traq_id":"kitsne","role":"member"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "協賛のお願い","description": "ロゴ掲載枠を!!特別価格!!で提供します","status": "not_written","assignee": "ramdos","due": "2025-12-31"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath = "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "スポンサー様へ、ロゴ掲載枠をお約束しました","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		notePath := ticketPath + "/notes/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		rec = doRequest(t, "POST", notePath+"/reviews", "Pugma", `{"type": "comment","weight": 0,"comment": "Logo のサイズを確認してください"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+	})
+
+	t.Run("search tickets and notes", func(t *testing.T) {
+		hits := search(t, "ramdos", "ロゴ掲載")
+		assert.DeepEqual(t, hitTypes(hits), []string{"note", "ticket"})
+		for _, hit := range hits {
+			assert.Assert(t, strings.Contains(hit["snippet"].(string), "ロゴ掲載枠"))
+		}
+	})
+
+	t.Run("search review comments case-insensitively", func(t *testing.T) {
+		hits := search(t, "ramdos", "LOGO サイズ")
+		assert.DeepEqual(t, hitTypes(hits), []string{"review"})
+		assert.Equal(t, hits[0]["snippet"], "Logo のサイズを確認してください")
+	})
+
+	t.Run("censored text is searchable only by manager", func(t *testing.T) {
+		hits := search(t, "Pugma", "特別価格")
+		assert.DeepEqual(t, hitTypes(hits), []string{"ticket"})
+		assert.Assert(t, strings.Contains(hits[0]["snippet"].(string), "!!特別価格!!"))
+
+		assert.DeepEqual(t, hitTypes(search(t, "ramdos", "特別価格")), []string{})
+
+		hits = search(t, "ramdos", "協賛")
+		assert.DeepEqual(t, hitTypes(hits), []string{"ticket"})
+		assert.Assert(t, strings.Contains(hits[0]["snippet"].(string), "!!■■■!!"))
+		assert.Assert(t, !strings.Contains(hits[0]["snippet"].(string), "特別価格"))
+	})
+
+	t.Run("censored text is searchable by roles allowed its category", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/config/censor-policy", "Pugma", `[{"category":"contact","roles":["assistant"],"assignees":false}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "Pugma", `{"type": "other","content": "先方の窓口は!!contact:山田太郎!!さん","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		hits := search(t, "ramdos", "山田太郎 窓口")
+		assert.DeepEqual(t, hitTypes(hits), []string{"note"})
+		assert.Assert(t, strings.Contains(hits[0]["snippet"].(string), "!!contact:山田太郎!!"))
+
+		assert.DeepEqual(t, hitTypes(search(t, "kitsne", "山田太郎")), []string{})
+
+		hits = search(t, "kitsne", "窓口")
+		assert.DeepEqual(t, hitTypes(hits), []string{"note"})
+		assert.Assert(t, !strings.Contains(hits[0]["snippet"].(string), "山田太郎"))
+	})
+
+	t.Run("reject queries without words", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/search?q="+url.QueryEscape("!!"), "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+	})
+
+	t.Run("deleted tickets are not searchable", func(t *testing.T) {
+		rec := doRequest(t, "DELETE", ticketPath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `204 No Content`)
+
+		assert.DeepEqual(t, hitTypes(search(t, "Pugma", "ロゴ")), []string{})
+	})
+}
@@ -0,0 +1,80 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestTicketHistory(t *testing.T) {
+	truncateAllTables(t)
+
+	var ticketPath string
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "履歴","description": "説明!!伏せ字!!","status": "not_planned","assignee": "ramdos","tags": ["b","a"],"due": "2025-12-31"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath = "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		rec = doRequest(t, "PATCH", ticketPath, "ramdos", `{"title": "履歴 (更新)","status": "not_written"}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "本文!!秘密!!","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		notePath := ticketPath + "/notes/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		rec = doRequest(t, "POST", notePath+"/submit", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("get history by manager", func(t *testing.T) {
+		rec := doRequest(t, "GET", ticketPath+"/history", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		expectedBody := `[` +
+			`{"id":[ID],"ticket_id":[ID],"actor":"Pugma","action":"ticket_created","changes":[` +
+			`{"field":"title","before":null,"after":"履歴"},` +
+			`{"field":"description","before":null,"after":"説明!!伏せ字!!"},` +
+			`{"field":"status","before":null,"after":"not_planned"},` +
+			`{"field":"manual_status","before":null,"after":"false"},` +
+			`{"field":"assignee","before":null,"after":"ramdos"},` +
+			`{"field":"sub_assignees","before":null,"after":""},` +
+			`{"field":"stakeholders","before":null,"after":""},` +
+			`{"field":"due","before":null,"after":"2025-12-31"},` +
+			`{"field":"tags","before":null,"after":"a,b"},` +
+			`{"field":"visibility","before":null,"after":"public"}],"created_at":"[TIME]"},` +
+			`{"id":[ID],"ticket_id":[ID],"actor":"ramdos","action":"ticket_updated","changes":[` +
+			`{"field":"title","before":"履歴","after":"履歴 (更新)"},` +
+			`{"field":"status","before":"not_planned","after":"not_written"}],"created_at":"[TIME]"},` +
+			`{"id":[ID],"ticket_id":[ID],"actor":"ramdos","action":"note_created","note_id":[ID],"changes":[` +
+			`{"field":"type","before":null,"after":"outgoing"},` +
+			`{"field":"status","before":null,"after":"draft"},` +
+			`{"field":"content","before":null,"after":"本文!!秘密!!"}],"created_at":"[TIME]"},` +
+			`{"id":[ID],"ticket_id":[ID],"actor":"ramdos","action":"note_updated","note_id":[ID],"changes":[` +
+			`{"field":"status","before":"draft","after":"waiting_review"}],"created_at":"[TIME]"},` +
+			`{"id":[ID],"ticket_id":[ID],"actor":"ramdos","action":"status_derived","changes":[` +
+			`{"field":"status","before":"not_written","after":"waiting_review"}],"created_at":"[TIME]"}` +
+			`]`
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+
+	t.Run("censor history for assistant", func(t *testing.T) {
+		rec := doRequest(t, "GET", ticketPath+"/history", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Assert(t, strings.Contains(rec.Body.String(), `"after":"説明!!■■■!!"`))
+		assert.Assert(t, strings.Contains(rec.Body.String(), `"after":"本文!!■■■!!"`))
+		assert.Assert(t, !strings.Contains(rec.Body.String(), `伏せ字`))
+		assert.Assert(t, !strings.Contains(rec.Body.String(), `秘密`))
+	})
+
+	t.Run("get history of non-existent ticket", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/tickets/999999/history", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+	})
+}
@@ -3,6 +3,8 @@
 package integrationtests
 
 import (
+	"encoding/json"
+	"net/url"
 	"strconv"
 	"strings"
 	"testing"
@@ -32,7 +34,7 @@ func TestTicket(t *testing.T) {
 				rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "タイトル1","description": "説明","status": "completed","assignee": "hoge1","sub_assignees": ["fuga"],"stakeholders": ["piyo"],"due": "2025-12-17","tags": ["タグ"]}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","tags":["タグ"],"due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 				ticketID1 = int(unmarshalResponse(t, rec)["id"].(float64))
@@ -41,7 +43,7 @@ func TestTicket(t *testing.T) {
 				rec := doRequest(t, "POST", "/tickets", "ramdos", `{"title": "タイトル2","description": "説明","status": "not_planned","assignee": "hoge2","sub_assignees": ["fuga"],"stakeholders": ["piyo"],"due": "2025-12-18","tags": ["タグ"]}`)
 
 				expectedStatus := `201 Created`
-				expectedBody := `{"id":[ID],"title":"タイトル2","description":"説明","assignee":"hoge2","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_planned","tags":["タグ"],"due":"2025-12-18","created_at":"[TIME]","updated_at":"[TIME]"}`
+				expectedBody := `{"id":[ID],"title":"タイトル2","description":"説明","assignee":"hoge2","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_planned","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-18","created_at":"[TIME]","updated_at":"[TIME]"}`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 				ticketID2 = int(unmarshalResponse(t, rec)["id"].(float64))
@@ -60,7 +62,7 @@ func TestTicket(t *testing.T) {
 				rec := doRequest(t, "GET", "/tickets", "Pugma", ``)
 
 				expectedStatus := `200 OK`
-				expectedBody := `[{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","tags":["タグ"],"due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"title":"タイトル2","description":"説明","assignee":"hoge2","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_planned","tags":["タグ"],"due":"2025-12-18","created_at":"[TIME]","updated_at":"[TIME]"}]`
+				expectedBody := `[{"id":[ID],"title":"タイトル2","description":"説明","assignee":"hoge2","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_planned","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-18","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}]`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -68,7 +70,7 @@ func TestTicket(t *testing.T) {
 				rec := doRequest(t, "GET", "/tickets", "ramdos", ``)
 
 				expectedStatus := `200 OK`
-				expectedBody := `[{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","tags":["タグ"],"due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"title":"タイトル2","description":"説明","assignee":"hoge2","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_planned","tags":["タグ"],"due":"2025-12-18","created_at":"[TIME]","updated_at":"[TIME]"}]`
+				expectedBody := `[{"id":[ID],"title":"タイトル2","description":"説明","assignee":"hoge2","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_planned","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-18","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}]`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -76,7 +78,7 @@ func TestTicket(t *testing.T) {
 				rec := doRequest(t, "GET", "/tickets", "cp20", ``)
 
 				expectedStatus := `200 OK`
-				expectedBody := `[{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","tags":["タグ"],"due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"title":"タイトル2","description":"説明","assignee":"hoge2","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_planned","tags":["タグ"],"due":"2025-12-18","created_at":"[TIME]","updated_at":"[TIME]"}]`
+				expectedBody := `[{"id":[ID],"title":"タイトル2","description":"説明","assignee":"hoge2","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_planned","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-18","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}]`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
@@ -85,7 +87,7 @@ func TestTicket(t *testing.T) {
 			t.Run("get tickets filtered by status", func(t *testing.T) {
 				rec := doRequest(t, "GET", "/tickets?status=completed", "Pugma", ``)
 				expectedStatus := `200 OK`
-				expectedBody := `[{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","tags":["タグ"],"due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}]`
+				expectedBody := `[{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}]`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 				assert.Assert(t, !strings.Contains(rec.Body.String(), `"title":"タイトル2"`))
@@ -93,28 +95,84 @@ func TestTicket(t *testing.T) {
 			t.Run("get tickets filtered by assignee", func(t *testing.T) {
 				rec := doRequest(t, "GET", "/tickets?assignee=hoge1", "Pugma", ``)
 				expectedStatus := `200 OK`
-				expectedBody := `[{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","tags":["タグ"],"due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}]`
+				expectedBody := `[{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}]`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 				assert.Assert(t, !strings.Contains(rec.Body.String(), `"title":"タイトル2"`))
 			})
+			t.Run("get tickets with combined filters", func(t *testing.T) {
+				titles := func(t *testing.T, query string) []string {
+					t.Helper()
+					rec := doRequest(t, "GET", "/tickets?sort=due_asc&"+query, "Pugma", ``)
+					assert.Equal(t, rec.Result().Status, `200 OK`)
+
+					var tickets []map[string]any
+					assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &tickets))
+					res := []string{}
+					for _, ticket := range tickets {
+						res = append(res, ticket["title"].(string))
+					}
+					assert.Equal(t, rec.Header().Get("X-Total-Count"), strconv.Itoa(len(res)))
+
+					return res
+				}
+
+				assert.DeepEqual(t, titles(t, "status=completed&status=not_planned"), []string{"タイトル1", "タイトル2"})
+				assert.DeepEqual(t, titles(t, "tag="+url.QueryEscape("タグ")+"&tag=other"), []string{"タイトル1", "タイトル2"})
+				assert.DeepEqual(t, titles(t, "tag=other"), []string{})
+				assert.DeepEqual(t, titles(t, "sub_assignee=fuga&stakeholder=piyo&status=completed"), []string{"タイトル1"})
+				assert.DeepEqual(t, titles(t, "involves=hoge2"), []string{"タイトル2"})
+				assert.DeepEqual(t, titles(t, "involves=piyo"), []string{"タイトル1", "タイトル2"})
+				assert.DeepEqual(t, titles(t, "due_before=2025-12-17"), []string{"タイトル1"})
+				assert.DeepEqual(t, titles(t, "due_after=2025-12-18&due_before=2025-12-31"), []string{"タイトル2"})
+				assert.DeepEqual(t, titles(t, "overdue=true"), []string{"タイトル2"})
+				assert.DeepEqual(t, titles(t, "overdue=false"), []string{"タイトル1"})
+				assert.DeepEqual(t, titles(t, "updated_since=2000-01-01T00:00:00Z"), []string{"タイトル1", "タイトル2"})
+				assert.DeepEqual(t, titles(t, "updated_since=2999-01-01T00:00:00Z"), []string{})
+
+				rec := doRequest(t, "GET", "/tickets?status=unknown", "Pugma", ``)
+				assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+			})
+			t.Run("get tickets page by page", func(t *testing.T) {
+				rec := doRequest(t, "GET", "/tickets?sort=due_asc&limit=1", "Pugma", ``)
+				expectedStatus := `200 OK`
+				expectedBody := `[{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}]`
+				assert.Equal(t, rec.Result().Status, expectedStatus)
+				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+				assert.Equal(t, rec.Header().Get("X-Total-Count"), "2")
+				cursor := rec.Header().Get("X-Next-Cursor")
+				assert.Assert(t, cursor != "")
+
+				rec = doRequest(t, "GET", "/tickets?sort=due_asc&limit=1&cursor="+cursor, "Pugma", ``)
+				expectedBody = `[{"id":[ID],"title":"タイトル2","description":"説明","assignee":"hoge2","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_planned","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-18","created_at":"[TIME]","updated_at":"[TIME]"}]`
+				assert.Equal(t, rec.Result().Status, expectedStatus)
+				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+				assert.Equal(t, rec.Header().Get("X-Total-Count"), "2")
+				assert.Equal(t, rec.Header().Get("X-Next-Cursor"), "")
+
+				rec = doRequest(t, "GET", "/tickets?sort=due_desc&limit=1&cursor="+cursor, "Pugma", ``)
+				assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+
+				rec = doRequest(t, "GET", "/tickets?cursor=invalid", "Pugma", ``)
+				assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+			})
 			t.Run("get tickets sorted by due date", func(t *testing.T) {
 				rec := doRequest(t, "GET", "/tickets?sort=due_desc", "Pugma", ``)
 				expectedStatus := `200 OK`
-				expectedBody := `[{"id":[ID],"title":"タイトル2","description":"説明","assignee":"hoge2","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_planned","tags":["タグ"],"due":"2025-12-18","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","tags":["タグ"],"due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}]`
+				expectedBody := `[{"id":[ID],"title":"タイトル2","description":"説明","assignee":"hoge2","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"not_planned","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-18","created_at":"[TIME]","updated_at":"[TIME]"},{"id":[ID],"title":"タイトル1","description":"説明","assignee":"hoge1","sub_assignees":["fuga"],"stakeholders":["piyo"],"status":"completed","manual_status":false,"tags":["タグ"],"visibility":"public","due":"2025-12-17","created_at":"[TIME]","updated_at":"[TIME]"}]`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 			})
 		})
 		t.Run("update ticket", func (t *testing.T) {
 			t.Run("update a ticket by manager", func(t *testing.T) {
-				body := `{"title":"タイトル2","status":"waiting_review"}`
+				body := `{"title":"タイトル2","status":"not_written"}`
 				rec := doRequest(t, "PATCH", "/tickets/"+strconv.Itoa(ticketID1), "Pugma", body)
 				expectedStatus := `200 OK`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 			})
 			t.Run("update a ticket by assistant", func(t *testing.T) {
-				body := `{"title":"タイトル2","status":"waiting_review"}`
+				body := `{"title":"タイトル2","status":"not_written"}`
 				rec := doRequest(t, "PATCH", "/tickets/"+strconv.Itoa(ticketID2), "ramdos", body)
 				expectedStatus := `200 OK`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
@@ -125,6 +183,41 @@ func TestTicket(t *testing.T) {
 				expectedStatus := `403 Forbidden`
 				assert.Equal(t, rec.Result().Status, expectedStatus)
 			})
+			t.Run("cannot skip statuses", func(t *testing.T) {
+				body := `{"status":"sent"}`
+				rec := doRequest(t, "PATCH", "/tickets/"+strconv.Itoa(ticketID2), "ramdos", body)
+				expectedStatus := `409 Conflict`
+				expectedBody := `{"current":"not_written","allowed":["not_planned","waiting_review","milestone_scheduled"]}`
+				assert.Equal(t, rec.Result().Status, expectedStatus)
+				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+			})
+			t.Run("assistant cannot mark a ticket as forgotten", func(t *testing.T) {
+				body := `{"status":"forgotten"}`
+				rec := doRequest(t, "PATCH", "/tickets/"+strconv.Itoa(ticketID2), "ramdos", body)
+				expectedStatus := `409 Conflict`
+				assert.Equal(t, rec.Result().Status, expectedStatus)
+			})
+		})
+		t.Run("get ticket transitions", func(t *testing.T) {
+			t.Run("get transitions by manager", func(t *testing.T) {
+				rec := doRequest(t, "GET", "/tickets/"+strconv.Itoa(ticketID2)+"/transitions", "Pugma", ``)
+				expectedStatus := `200 OK`
+				expectedBody := `{"current":"not_written","allowed":["not_planned","waiting_review","milestone_scheduled","forgotten"]}`
+				assert.Equal(t, rec.Result().Status, expectedStatus)
+				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+			})
+			t.Run("get transitions by normal user", func(t *testing.T) {
+				rec := doRequest(t, "GET", "/tickets/"+strconv.Itoa(ticketID2)+"/transitions", "cp20", ``)
+				expectedStatus := `200 OK`
+				expectedBody := `{"current":"not_written","allowed":[]}`
+				assert.Equal(t, rec.Result().Status, expectedStatus)
+				assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+			})
+			t.Run("get transitions of non-existent ticket", func(t *testing.T) {
+				rec := doRequest(t, "GET", "/tickets/99999/transitions", "Pugma", ``)
+				expectedStatus := `404 Not Found`
+				assert.Equal(t, rec.Result().Status, expectedStatus)
+			})
 		})
 		t.Run("get ticket detail", func(t *testing.T) {
 			t.Run("get a ticket detail by manager", func(t *testing.T) {
@@ -198,4 +291,121 @@ func TestTicket(t *testing.T) {
 
 	})
 
+	t.Run("derive ticket status from notes", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "自動更新","status": "not_planned","assignee": "ramdos","due": "2025-12-17"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath := "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		currentStatus := func(t *testing.T) string {
+			t.Helper()
+			rec := doRequest(t, "GET", ticketPath+"/transitions", "Pugma", ``)
+			assert.Equal(t, rec.Result().Status, `200 OK`)
+
+			return unmarshalResponse(t, rec)["current"].(string)
+		}
+
+		t.Run("incoming note requires a reply", func(t *testing.T) {
+			rec := doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "incoming","content": "お問い合わせ","mention_notification": false}`)
+			assert.Equal(t, rec.Result().Status, `201 Created`)
+			assert.Equal(t, currentStatus(t), "not_written")
+		})
+
+		var notePath string
+		t.Run("outgoing note follows its review status", func(t *testing.T) {
+			rec := doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "返信","mention_notification": false}`)
+			assert.Equal(t, rec.Result().Status, `201 Created`)
+			notePath = ticketPath + "/notes/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+			assert.Equal(t, currentStatus(t), "not_written")
+
+			rec = doRequest(t, "POST", notePath+"/submit", "ramdos", ``)
+			assert.Equal(t, rec.Result().Status, `200 OK`)
+			assert.Equal(t, currentStatus(t), "waiting_review")
+
+			rec = doRequest(t, "POST", notePath+"/reviews", "Pugma", `{"type": "approve","weight": 5,"comment": "LGTM"}`)
+			assert.Equal(t, rec.Result().Status, `201 Created`)
+			assert.Equal(t, currentStatus(t), "waiting_sent")
+
+			rec = doRequest(t, "POST", notePath+"/send", "ramdos", ``)
+			assert.Equal(t, rec.Result().Status, `200 OK`)
+			assert.Equal(t, currentStatus(t), "sent")
+		})
+
+		t.Run("manual status is kept", func(t *testing.T) {
+			rec := doRequest(t, "PATCH", ticketPath, "Pugma", `{"status": "milestone_scheduled","manual_status": true}`)
+			assert.Equal(t, rec.Result().Status, `200 OK`)
+
+			rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "incoming","content": "追加のご連絡","mention_notification": false}`)
+			assert.Equal(t, rec.Result().Status, `201 Created`)
+			assert.Equal(t, currentStatus(t), "milestone_scheduled")
+		})
+	})
+
+	t.Run("only incoming notes reopen closed tickets", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "完了済み","status": "completed","assignee": "ramdos","due": "2025-12-17"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath := "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		currentTicket := func(t *testing.T) map[string]any {
+			t.Helper()
+			rec := doRequest(t, "GET", ticketPath, "Pugma", ``)
+			assert.Equal(t, rec.Result().Status, `200 OK`)
+
+			return unmarshalResponse(t, rec)
+		}
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "下書き","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "other","content": "メモ","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		ticket := currentTicket(t)
+		assert.Equal(t, ticket["status"], "completed")
+		assert.Equal(t, ticket["due"], "2025-12-17")
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "incoming","content": "追加のご連絡","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		assert.Equal(t, currentTicket(t)["status"], "not_written")
+	})
+}
+
+func TestTicketsWithoutPagination(t *testing.T) {
+	truncateAllTables(t)
+
+	rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"}]`)
+	assert.Equal(t, rec.Result().Status, `200 OK`)
+
+	count := 60
+	for i := range count {
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "チケット`+strconv.Itoa(i)+`","status": "not_written","assignee": "Pugma"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+	}
+
+	list := func(t *testing.T, body string) []any {
+		t.Helper()
+		var tickets []any
+		assert.NilError(t, json.Unmarshal([]byte(body), &tickets))
+
+		return tickets
+	}
+
+	t.Run("limit and cursor are omitted", func(t *testing.T) {
+		// ページ分割を指定しない場合はすべて返す
+		rec := doRequest(t, "GET", "/tickets", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, len(list(t, rec.Body.String())), count)
+		assert.Equal(t, rec.Header().Get("X-Total-Count"), strconv.Itoa(count))
+		assert.Equal(t, rec.Header().Get("X-Next-Cursor"), "")
+	})
+
+	t.Run("cursor without limit uses the default page size", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/tickets?limit=5", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		cursor := rec.Header().Get("X-Next-Cursor")
+		assert.Assert(t, cursor != "")
+
+		rec = doRequest(t, "GET", "/tickets?cursor="+url.QueryEscape(cursor), "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, len(list(t, rec.Body.String())), 50)
+		assert.Assert(t, rec.Header().Get("X-Next-Cursor") != "")
+	})
 }
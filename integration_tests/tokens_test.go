@@ -0,0 +1,133 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"gotest.tools/v3/assert"
+)
+
+func TestAPITokens(t *testing.T) {
+	truncateAllTables(t)
+
+	expiresAt := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	createToken := func(t *testing.T, name, scope string) (int, string) {
+		t.Helper()
+
+		rec := doRequest(t, "POST", "/me/tokens", "Pugma", `{"name":"`+name+`","scope":"`+scope+`","expires_at":"`+expiresAt+`"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		res := unmarshalResponse(t, rec)
+		assert.Equal(t, res["name"], name)
+		assert.Equal(t, res["scope"], scope)
+		token := res["token"].(string)
+		assert.Assert(t, strings.HasPrefix(token, "anshin_"))
+
+		return int(res["id"].(float64)), token
+	}
+
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	var readToken, writeToken string
+	var readTokenID int
+	t.Run("create tokens", func(t *testing.T) {
+		readTokenID, readToken = createToken(t, "weekly export", "read")
+		_, writeToken = createToken(t, "tag cleanup", "tickets:write")
+	})
+
+	t.Run("expiry in the past is rejected", func(t *testing.T) {
+		past := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+		rec := doRequest(t, "POST", "/me/tokens", "Pugma", `{"name":"old","scope":"read","expires_at":"`+past+`"}`)
+		assert.Equal(t, rec.Result().Status, `400 Bad Request`)
+	})
+
+	t.Run("read token can only read", func(t *testing.T) {
+		rec := doBearerRequest(t, "GET", "/me", readToken, ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `{"id":"Pugma"}`)
+
+		rec = doBearerRequest(t, "POST", "/tickets", readToken, `{"title": "協賛","status": "not_written","assignee": "Pugma"}`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+	})
+
+	t.Run("tickets:write token can write tickets but not admin settings", func(t *testing.T) {
+		rec := doBearerRequest(t, "POST", "/tickets", writeToken, `{"title": "協賛","status": "not_written","assignee": "Pugma"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		rec = doBearerRequest(t, "PUT", "/users", writeToken, `[{"traq_id":"Pugma","role":"manager"}]`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doBearerRequest(t, "POST", "/me/tokens", writeToken, `{"name":"escalate","scope":"admin","expires_at":"`+expiresAt+`"}`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+	})
+
+	t.Run("tickets:write token cannot restore or purge", func(t *testing.T) {
+		rec := doBearerRequest(t, "POST", "/tickets", writeToken, `{"title": "削除","status": "not_written","assignee": "Pugma"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketPath := "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		rec = doBearerRequest(t, "DELETE", ticketPath, writeToken, ``)
+		assert.Equal(t, rec.Result().Status, `204 No Content`)
+
+		rec = doBearerRequest(t, "POST", ticketPath+"/restore", writeToken, ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doBearerRequest(t, "DELETE", ticketPath+"/purge", writeToken, ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "POST", ticketPath+"/restore", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("list tokens", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/me/tokens", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		expectedBody := `[` +
+			`{"id":[ID],"name":"tag cleanup","scope":"tickets:write","expires_at":"[TIME]","last_used_at":"[TIME]","created_at":"[TIME]"},` +
+			`{"id":[ID],"name":"weekly export","scope":"read","expires_at":"[TIME]","last_used_at":"[TIME]","created_at":"[TIME]"}` +
+			`]`
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+
+		rec = doRequest(t, "GET", "/me/tokens", "kenken", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `[]`)
+	})
+
+	t.Run("invalid token is rejected", func(t *testing.T) {
+		rec := doBearerRequest(t, "GET", "/me", "anshin_invalid", ``)
+		assert.Equal(t, rec.Result().Status, `401 Unauthorized`)
+	})
+
+	t.Run("delete token", func(t *testing.T) {
+		rec := doRequest(t, "DELETE", "/me/tokens/"+strconv.Itoa(readTokenID), "kenken", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+
+		rec = doRequest(t, "DELETE", "/me/tokens/"+strconv.Itoa(readTokenID), "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `204 No Content`)
+
+		rec = doBearerRequest(t, "GET", "/me", readToken, ``)
+		assert.Equal(t, rec.Result().Status, `401 Unauthorized`)
+	})
+}
+
+func doBearerRequest(t *testing.T, method, path string, token string, bodystr string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, strings.NewReader(bodystr))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+	rec := httptest.NewRecorder()
+
+	globalServer.ServeHTTP(rec, req)
+
+	return rec
+}
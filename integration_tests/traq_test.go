@@ -0,0 +1,145 @@
+package integrationtests
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/traPtitech/go-traq"
+)
+
+// fakeTraQUser : 偽の traQ に登録するユーザー
+type fakeTraQUser struct {
+	Name      string
+	Bot       bool
+	Suspended bool
+}
+
+// fakeTraQ : ユーザー一覧・グループのメンバー取得だけを再現する traQ API のテスト用サーバー
+// ユーザーの UUID は "uuid-" + traQ ID とする
+type fakeTraQ struct {
+	mu     sync.Mutex
+	users  []fakeTraQUser
+	groups map[string][]string
+}
+
+func newFakeTraQ() *fakeTraQ {
+	return &fakeTraQ{
+		mu:     sync.Mutex{},
+		users:  []fakeTraQUser{},
+		groups: map[string][]string{},
+	}
+}
+
+// reset : 登録されたユーザーとグループの所属を置き換える (groups はグループの UUID から所属するユーザーの traQ ID への対応)
+func (f *fakeTraQ) reset(users []fakeTraQUser, groups map[string][]string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.users = users
+	f.groups = groups
+}
+
+// client : 偽の traQ に接続する traQ API クライアント
+func (f *fakeTraQ) client(server *httptest.Server) *traq.APIClient {
+	cfg := traq.NewConfiguration()
+	cfg.Servers = traq.ServerConfigurations{{URL: server.URL + "/api/v3", Description: "fake", Variables: nil}}
+	cfg.HTTPClient = server.Client()
+
+	return traq.NewAPIClient(cfg)
+}
+
+func (f *fakeTraQ) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	path := strings.TrimPrefix(r.URL.Path, "/api/v3")
+	switch {
+	case r.Method == http.MethodGet && path == "/users":
+		includeSuspended := r.URL.Query().Get("include-suspended") == "true"
+		users := []map[string]any{}
+		for _, user := range f.users {
+			if user.Suspended && !includeSuspended {
+				continue
+			}
+			state := 1
+			if user.Suspended {
+				state = 2
+			}
+			users = append(users, map[string]any{
+				"id":          "uuid-" + user.Name,
+				"name":        user.Name,
+				"displayName": user.Name,
+				"iconFileId":  "uuid-icon",
+				"bot":         user.Bot,
+				"state":       state,
+				"updatedAt":   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			})
+		}
+		writeJSON(w, users)
+
+	case r.Method == http.MethodGet && strings.HasPrefix(path, "/groups/") && strings.HasSuffix(path, "/members"):
+		groupID := strings.TrimSuffix(strings.TrimPrefix(path, "/groups/"), "/members")
+		names, ok := f.groups[groupID]
+		if !ok {
+			http.NotFound(w, r)
+
+			return
+		}
+		members := []map[string]any{}
+		for _, name := range names {
+			members = append(members, map[string]any{"id": "uuid-" + name, "role": ""})
+		}
+		writeJSON(w, members)
+
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+// directMessages : モックの Bot が送った DM の記録
+type directMessages struct {
+	mu      sync.Mutex
+	userIDs []string
+	// contents : ユーザー UUID ごとの最後に送った DM の本文
+	contents map[string]string
+}
+
+func (d *directMessages) record(_ context.Context, userID string, content string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.userIDs = append(d.userIDs, userID)
+	d.contents[userID] = content
+
+	return nil
+}
+
+// content : ユーザー UUID に最後に送った DM の本文を返す
+func (d *directMessages) content(userID string) string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.contents[userID]
+}
+
+// take : 記録された DM の宛先のユーザー UUID を返し、記録を消す
+func (d *directMessages) take() []string {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	userIDs := d.userIDs
+	d.userIDs = nil
+	d.contents = map[string]string{}
+
+	return userIDs
+}
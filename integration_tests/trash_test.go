@@ -0,0 +1,152 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/traP-jp/anshin-techo-backend/infrastructure/injector"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/bot"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/retention"
+	"gotest.tools/v3/assert"
+)
+
+func TestTrash(t *testing.T) {
+	truncateAllTables(t)
+
+	createDeletedTicket := func(t *testing.T, title string) (int, string) {
+		t.Helper()
+
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "`+title+`","status": "not_written","assignee": "ramdos","due": "2025-12-31"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		ticketID := int(unmarshalResponse(t, rec)["id"].(float64))
+		ticketPath := "/tickets/" + strconv.Itoa(ticketID)
+
+		rec = doRequest(t, "POST", ticketPath+"/notes", "ramdos", `{"type": "outgoing","content": "本文","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		rec = doRequest(t, "DELETE", ticketPath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `204 No Content`)
+
+		return ticketID, ticketPath
+	}
+
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"},{"traq_id":"kitsne","role":"member"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("non-managers cannot restore or purge", func(t *testing.T) {
+		ticketID, ticketPath := createDeletedTicket(t, "本職以外")
+
+		for _, user := range []string{"ramdos", "kitsne"} {
+			rec := doRequest(t, "POST", ticketPath+"/restore", user, ``)
+			assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+			rec = doRequest(t, "DELETE", ticketPath+"/purge", user, ``)
+			assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+		}
+
+		// ゴミ箱に残ったまま
+		var deleted int
+		assert.NilError(t, globalDB.Get(&deleted, "SELECT COUNT(*) FROM tickets WHERE id = ? AND deleted_at IS NOT NULL", ticketID))
+		assert.Equal(t, deleted, 1)
+
+		rec := doRequest(t, "DELETE", ticketPath+"/purge", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `204 No Content`)
+	})
+
+	t.Run("restore a deleted ticket", func(t *testing.T) {
+		_, ticketPath := createDeletedTicket(t, "復元")
+
+		rec := doRequest(t, "GET", "/tickets/trash", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		expectedBody := `[{"ticket":{"id":[ID],"title":"復元","description":"","assignee":"ramdos","sub_assignees":[],"stakeholders":[],"status":"not_written","manual_status":false,"tags":[],"visibility":"public","due":"2025-12-31","created_at":"[TIME]","updated_at":"[TIME]"},"deleted_at":"[TIME]","purge_at":"[TIME]"}]`
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+
+		rec = doRequest(t, "GET", "/tickets/trash", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "POST", ticketPath+"/restore", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "POST", ticketPath+"/restore", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", ticketPath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", ticketPath+"/restore", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+
+		rec = doRequest(t, "GET", "/tickets/trash", "Pugma", ``)
+		assert.Equal(t, rec.Body.String(), "[]")
+	})
+
+	t.Run("purge a deleted ticket", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "削除されていない","status": "not_written","assignee": "ramdos"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		activePath := "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		rec = doRequest(t, "DELETE", activePath+"/purge", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+
+		ticketID, ticketPath := createDeletedTicket(t, "完全削除")
+
+		rec = doRequest(t, "DELETE", ticketPath+"/purge", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "DELETE", ticketPath+"/purge", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `204 No Content`)
+
+		var notes int
+		assert.NilError(t, globalDB.Get(&notes, "SELECT COUNT(*) FROM notes WHERE ticket_id = ?", ticketID))
+		assert.Equal(t, notes, 0)
+
+		// 変更履歴は監査のため残すが、変更前後の値は消す
+		var changes []string
+		assert.NilError(t, globalDB.Select(&changes, "SELECT changes FROM ticket_events WHERE ticket_id = ?", ticketID))
+		assert.Assert(t, len(changes) > 0)
+		for _, c := range changes {
+			assert.Assert(t, !strings.Contains(c, "完全削除"), c)
+			assert.Assert(t, !strings.Contains(c, "本文"), c)
+		}
+
+		rec = doRequest(t, "POST", ticketPath+"/restore", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+	})
+
+	t.Run("purge tickets past the retention period", func(t *testing.T) {
+		purger := injector.InjectTrashPurger(injector.Dependencies{
+			DB:    globalDB,
+			Bot:   bot.NewMockService(),
+			Users: globalUsers,
+		}, retention.Config{Interval: 0})
+
+		expiredID, _ := createDeletedTicket(t, "保持期間切れ")
+		keptID, _ := createDeletedTicket(t, "保持期間内")
+		_, err := globalDB.Exec("UPDATE tickets SET deleted_at = CURRENT_TIMESTAMP - INTERVAL 31 DAY WHERE id = ?", expiredID)
+		assert.NilError(t, err)
+
+		rec := doRequest(t, "POST", "/config", "Pugma", `{"reminder_interval":{"overdue_day":[],"notesent_hour":0},"revise_prompt":"","trash_retention_days":0}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		purged, err := purger.RunOnce(context.Background())
+		assert.NilError(t, err)
+		assert.Equal(t, purged, int64(0))
+
+		rec = doRequest(t, "POST", "/config", "Pugma", `{"reminder_interval":{"overdue_day":[],"notesent_hour":0},"revise_prompt":"","trash_retention_days":30}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		purged, err = purger.RunOnce(context.Background())
+		assert.NilError(t, err)
+		assert.Equal(t, purged, int64(1))
+
+		var remaining []int
+		assert.NilError(t, globalDB.Select(&remaining, "SELECT id FROM tickets WHERE id IN (?, ?)", expiredID, keptID))
+		assert.DeepEqual(t, remaining, []int{keptID})
+	})
+}
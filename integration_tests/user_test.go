@@ -9,13 +9,15 @@ import (
 )
 
 func TestUser(t *testing.T) {
+	truncateAllTables(t)
+
 	t.Run("create an user", func(t *testing.T) {
 		t.Run("success", func(t *testing.T) {
 			t.Parallel()
 			rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"}]`)
 
 			expectedStatus := `200 OK`
-			expectedBody := ``
+			expectedBody := `[{"id":[ID],"traq_id":"Pugma","actor":"Pugma","before_role":null,"after_role":"manager","created_at":"[TIME]"}]`
 			assert.Equal(t, rec.Result().Status, expectedStatus)
 			assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
 		})
@@ -63,3 +65,146 @@ func TestUser(t *testing.T) {
 		})
 	})
 }
+
+func TestUserManagement(t *testing.T) {
+	truncateAllTables(t)
+
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"member"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("add an user", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/users", "Pugma", `{"traq_id":"kitsne","role":"member"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), `{"traq_id":"kitsne","role":"member"}`)
+
+		rec = doRequest(t, "POST", "/users", "Pugma", `{"traq_id":"kitsne","role":"assistant"}`)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+	})
+
+	t.Run("change a role", func(t *testing.T) {
+		rec := doRequest(t, "PATCH", "/users/ramdos", "Pugma", `{"role":"assistant"}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), `{"traq_id":"ramdos","role":"assistant"}`)
+
+		rec = doRequest(t, "PATCH", "/users/cp20", "Pugma", `{"role":"assistant"}`)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+	})
+
+	t.Run("only managers can manage users", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/users", "ramdos", `{"traq_id":"cp20","role":"member"}`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "PATCH", "/users/ramdos", "ramdos", `{"role":"manager"}`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "DELETE", "/users/kitsne", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "GET", "/audit/role-changes", "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+	})
+
+	t.Run("delete an user", func(t *testing.T) {
+		rec := doRequest(t, "DELETE", "/users/kitsne", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `204 No Content`)
+
+		rec = doRequest(t, "DELETE", "/users/kitsne", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+	})
+
+	t.Run("the last manager cannot be removed", func(t *testing.T) {
+		rec := doRequest(t, "PATCH", "/users/Pugma", "Pugma", `{"role":"member"}`)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+
+		rec = doRequest(t, "DELETE", "/users/Pugma", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+
+		rec = doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"ramdos","role":"assistant"}]`)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+
+		// 本職のままなので、ほかのユーザーは本職になれない
+		rec = doRequest(t, "PATCH", "/users/ramdos", "ramdos", `{"role":"manager"}`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+	})
+
+	t.Run("bulk sync reports the applied diff", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"},{"traq_id":"H1rono_K","role":"member"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), `[{"id":[ID],"traq_id":"H1rono_K","actor":"Pugma","before_role":null,"after_role":"member","created_at":"[TIME]"}]`)
+
+		rec = doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"member"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		expectedBody := `[` +
+			`{"id":[ID],"traq_id":"H1rono_K","actor":"Pugma","before_role":"member","after_role":null,"created_at":"[TIME]"},` +
+			`{"id":[ID],"traq_id":"ramdos","actor":"Pugma","before_role":"assistant","after_role":"member","created_at":"[TIME]"}` +
+			`]`
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+
+	t.Run("get role changes", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/audit/role-changes?traq_id=ramdos", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		expectedBody := `[` +
+			`{"id":[ID],"traq_id":"ramdos","actor":"Pugma","before_role":"assistant","after_role":"member","created_at":"[TIME]"},` +
+			`{"id":[ID],"traq_id":"ramdos","actor":"Pugma","before_role":"member","after_role":"assistant","created_at":"[TIME]"},` +
+			`{"id":[ID],"traq_id":"ramdos","actor":"Pugma","before_role":null,"after_role":"member","created_at":"[TIME]"}` +
+			`]`
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+	})
+}
+
+func TestUserProfile(t *testing.T) {
+	truncateAllTables(t)
+
+	globalTraQ.reset([]fakeTraQUser{
+		{Name: "Pugma", Bot: false, Suspended: false},
+		{Name: "ramdos", Bot: false, Suspended: false},
+		{Name: "kitsne", Bot: false, Suspended: false},
+		{Name: "retired", Bot: false, Suspended: true},
+	}, map[string][]string{})
+
+	rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"}]`)
+	assert.Equal(t, rec.Result().Status, `200 OK`)
+
+	t.Run("registered user", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/users/ramdos", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `{"traq_id":"ramdos","user_id":"uuid-ramdos","display_name":"ramdos","icon_file_id":"uuid-icon","bot":false,"suspended":false,"role":"assistant"}`)
+	})
+
+	t.Run("unregistered and suspended user", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/users/retired", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `{"traq_id":"retired","user_id":"uuid-retired","display_name":"retired","icon_file_id":"uuid-icon","bot":false,"suspended":true,"role":null}`)
+	})
+
+	t.Run("unknown user", func(t *testing.T) {
+		rec := doRequest(t, "GET", "/users/nobody", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+	})
+
+	t.Run("ticket creation notifies involved users by UUID", func(t *testing.T) {
+		globalDMs.take()
+
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "協賛","status": "not_written","assignee": "ramdos","sub_assignees": ["kitsne", "nobody"],"stakeholders": ["Pugma", "ramdos"]}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+
+		// 作成者と traQ に見つからないユーザーには送らない
+		assert.DeepEqual(t, globalDMs.take(), []string{"uuid-ramdos", "uuid-kitsne"})
+	})
+
+	t.Run("non-public ticket creation notifies users who can see it", func(t *testing.T) {
+		globalDMs.take()
+
+		rec := doRequest(t, "POST", "/tickets", "Pugma", `{"title": "非公開","status": "not_written","assignee": "ramdos","stakeholders": ["kitsne"],"visibility": "involved"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		assert.DeepEqual(t, globalDMs.take(), []string{"uuid-ramdos", "uuid-kitsne"})
+
+		// 本職のみのチケットは本職以外に知らせない
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "本職のみ","status": "not_written","assignee": "ramdos","stakeholders": ["kitsne"],"visibility": "managers"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		assert.Equal(t, len(globalDMs.take()), 0)
+	})
+}
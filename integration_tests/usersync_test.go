@@ -0,0 +1,100 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestUserSync(t *testing.T) {
+	truncateAllTables(t)
+
+	users := []fakeTraQUser{
+		{Name: "Pugma", Bot: false, Suspended: false},
+		{Name: "ramdos", Bot: false, Suspended: false},
+		{Name: "kitsne", Bot: false, Suspended: false},
+		{Name: "H1rono_K", Bot: false, Suspended: false},
+		{Name: "BOT_anshin", Bot: true, Suspended: false},
+		{Name: "retired", Bot: false, Suspended: true},
+	}
+	globalTraQ.reset(users, map[string][]string{
+		"managers":   {"Pugma"},
+		"assistants": {"ramdos", "Pugma"},
+		"members":    {"kitsne", "ramdos", "BOT_anshin", "retired"},
+	})
+
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"H1rono_K","role":"member"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("only managers can sync users", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/users/sync", "H1rono_K", ``)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+	})
+
+	t.Run("dry run does not change users", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/users/sync?dry_run=true", "Pugma", ``)
+
+		expectedStatus := `200 OK`
+		expectedBody := `{"dry_run":true,"changes":[{"traq_id":"H1rono_K","before_role":"member","after_role":null},{"traq_id":"kitsne","before_role":null,"after_role":"member"},{"traq_id":"ramdos","before_role":null,"after_role":"assistant"}]}`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+
+		assert.DeepEqual(t, userRoles(t), map[string]string{"Pugma": "manager", "H1rono_K": "member"})
+	})
+
+	t.Run("sync applies changes", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/users/sync", "Pugma", ``)
+
+		expectedStatus := `200 OK`
+		expectedBody := `{"dry_run":false,"changes":[{"traq_id":"H1rono_K","before_role":"member","after_role":null},{"traq_id":"kitsne","before_role":null,"after_role":"member"},{"traq_id":"ramdos","before_role":null,"after_role":"assistant"}]}`
+		assert.Equal(t, rec.Result().Status, expectedStatus)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), expectedBody)
+
+		rec = doRequest(t, "GET", "/audit/role-changes?traq_id=ramdos", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, escapeSnapshot(t, rec.Body.String()), `[{"id":[ID],"traq_id":"ramdos","actor":"Pugma","before_role":null,"after_role":"assistant","created_at":"[TIME]"}]`)
+
+		rec = doRequest(t, "POST", "/users/sync", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, rec.Body.String(), `{"dry_run":false,"changes":[]}`)
+	})
+
+	t.Run("refuse to remove all managers", func(t *testing.T) {
+		globalTraQ.reset(users, map[string][]string{
+			"managers":   {},
+			"assistants": {"ramdos"},
+			"members":    {"kitsne"},
+		})
+
+		rec := doRequest(t, "POST", "/users/sync", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `409 Conflict`)
+
+		assert.DeepEqual(t, userRoles(t), map[string]string{"Pugma": "manager", "ramdos": "assistant", "kitsne": "member"})
+	})
+}
+
+// userRoles : 登録されているユーザーの traQ ID からロールへの対応
+func userRoles(t *testing.T) map[string]string {
+	t.Helper()
+
+	rec := doRequest(t, "GET", "/users", "Pugma", ``)
+	assert.Equal(t, rec.Result().Status, `200 OK`)
+
+	users := []struct {
+		TraqID string `json:"traq_id"`
+		Role   string `json:"role"`
+	}{}
+	assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &users))
+
+	roles := map[string]string{}
+	for _, user := range users {
+		roles[user.TraqID] = user.Role
+	}
+
+	return roles
+}
@@ -0,0 +1,124 @@
+// NOTE: go test -updateを実行することで、スナップショットを更新することができる
+
+package integrationtests
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"testing"
+
+	"gotest.tools/v3/assert"
+)
+
+func TestTicketVisibility(t *testing.T) {
+	truncateAllTables(t)
+
+	globalTraQ.reset([]fakeTraQUser{
+		{Name: "Pugma", Bot: false, Suspended: false},
+		{Name: "ramdos", Bot: false, Suspended: false},
+		{Name: "kitsne", Bot: false, Suspended: false},
+		{Name: "H1rono_K", Bot: false, Suspended: false},
+	}, map[string][]string{})
+
+	var publicPath, involvedPath, managersPath string
+	t.Run("prepare", func(t *testing.T) {
+		rec := doRequest(t, "PUT", "/users", "Pugma", `[{"traq_id":"Pugma","role":"manager"},{"traq_id":"ramdos","role":"assistant"},{"traq_id":"kitsne","role":"member"},{"traq_id":"H1rono_K","role":"member"}]`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "POST", "/tickets", "ramdos", `{"title": "公開の協賛","status": "not_written","assignee": "ramdos"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		publicPath = "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		rec = doRequest(t, "POST", "/tickets", "ramdos", `{"title": "内密の協賛","status": "not_written","assignee": "ramdos","stakeholders": ["kitsne"],"visibility": "involved"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		assert.Equal(t, unmarshalResponse(t, rec)["visibility"], "involved")
+		involvedPath = "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+
+		rec = doRequest(t, "POST", "/tickets", "Pugma", `{"title": "極秘の協賛","status": "not_written","assignee": "ramdos","visibility": "managers"}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		managersPath = "/tickets/" + strconv.Itoa(int(unmarshalResponse(t, rec)["id"].(float64)))
+	})
+
+	t.Run("only managers can make tickets managers-only", func(t *testing.T) {
+		rec := doRequest(t, "POST", "/tickets", "ramdos", `{"title": "極秘","status": "not_written","assignee": "ramdos","visibility": "managers"}`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+
+		rec = doRequest(t, "PATCH", publicPath, "ramdos", `{"visibility": "managers"}`)
+		assert.Equal(t, rec.Result().Status, `403 Forbidden`)
+	})
+
+	t.Run("list only visible tickets", func(t *testing.T) {
+		tests := map[string]int{"Pugma": 3, "ramdos": 2, "kitsne": 2, "H1rono_K": 1}
+		for user, count := range tests {
+			rec := doRequest(t, "GET", "/tickets", user, ``)
+			assert.Equal(t, rec.Result().Status, `200 OK`)
+			assert.Equal(t, rec.Header().Get("X-Total-Count"), strconv.Itoa(count), user)
+
+			tickets := []map[string]any{}
+			assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &tickets))
+			assert.Equal(t, len(tickets), count, user)
+		}
+	})
+
+	t.Run("hidden tickets are not found", func(t *testing.T) {
+		rec := doRequest(t, "GET", involvedPath, "kitsne", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", involvedPath, "H1rono_K", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+
+		rec = doRequest(t, "GET", involvedPath+"/history", "H1rono_K", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+
+		rec = doRequest(t, "POST", involvedPath+"/notes", "H1rono_K", `{"type": "other","content": "メモ","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+
+		rec = doRequest(t, "POST", involvedPath+"/ai/generate", "H1rono_K", `{}`)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+
+		rec = doRequest(t, "GET", managersPath, "ramdos", ``)
+		assert.Equal(t, rec.Result().Status, `404 Not Found`)
+
+		rec = doRequest(t, "GET", managersPath, "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+
+	t.Run("notify only users who can see the ticket", func(t *testing.T) {
+		rec := doRequest(t, "POST", managersPath+"/notes", "Pugma", `{"type": "outgoing","content": "協賛をお願いします。","mention_notification": false}`)
+		assert.Equal(t, rec.Result().Status, `201 Created`)
+		notePath := fmt.Sprintf("%s/notes/%v", managersPath, unmarshalResponse(t, rec)["id"])
+
+		globalDMs.take()
+
+		// 担当者の ramdos は本職ではないので、本職のみのチケットの通知は届かない
+		rec = doRequest(t, "POST", notePath+"/submit", "Pugma", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+		assert.Equal(t, len(globalDMs.take()), 0)
+	})
+
+	t.Run("search only visible tickets", func(t *testing.T) {
+		search := func(user string) int {
+			rec := doRequest(t, "GET", "/search?q="+url.QueryEscape("協賛"), user, ``)
+			assert.Equal(t, rec.Result().Status, `200 OK`)
+
+			hits := []map[string]any{}
+			assert.NilError(t, json.Unmarshal(rec.Body.Bytes(), &hits))
+
+			return len(hits)
+		}
+
+		assert.Equal(t, search("Pugma"), 3)
+		assert.Equal(t, search("kitsne"), 2)
+		assert.Equal(t, search("H1rono_K"), 1)
+	})
+
+	t.Run("making a ticket public shows it to everyone", func(t *testing.T) {
+		rec := doRequest(t, "PATCH", involvedPath, "kitsne", `{"visibility": "public"}`)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+
+		rec = doRequest(t, "GET", involvedPath, "H1rono_K", ``)
+		assert.Equal(t, rec.Result().Status, `200 OK`)
+	})
+}
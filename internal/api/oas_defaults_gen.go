@@ -2,10 +2,18 @@
 
 package api
 
+// setDefaults set default value of fields.
+func (s *CreateTicketReq) setDefaults() {
+	{
+		val := bool(false)
+		s.ManualStatus.SetTo(val)
+	}
+}
+
 // setDefaults set default value of fields.
 func (s *TicketsTicketIdNotesNoteIdPutReq) setDefaults() {
 	{
 		val := bool(true)
-		s.ResetReviews = val
+		s.ResetReviews.SetTo(val)
 	}
 }
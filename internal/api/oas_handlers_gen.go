@@ -28,6 +28,268 @@ func (c *codeRecorder) Unwrap() http.ResponseWriter {
 
 func recordError(string, error) {}
 
+// handleAuthCallbackRequest handles authCallback operation.
+//
+// 認可コードをトークンに交換し、セッションを発行してトップページにリダイレクトする。traQ OAuth2が設定されていない場合は404を返す.
+//
+// GET /auth/callback
+func (s *Server) handleAuthCallbackRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: AuthCallbackOperation,
+			ID:   "authCallback",
+		}
+	)
+	params, err := decodeAuthCallbackParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response AuthCallbackRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    AuthCallbackOperation,
+			OperationSummary: "traQ OAuth2のコールバック",
+			OperationID:      "authCallback",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "code",
+					In:   "query",
+				}: params.Code,
+				{
+					Name: "state",
+					In:   "query",
+				}: params.State,
+				{
+					Name: "anshin_oauth_state",
+					In:   "cookie",
+				}: params.AnshinOAuthState,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = AuthCallbackParams
+			Response = AuthCallbackRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackAuthCallbackParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.AuthCallback(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.AuthCallback(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeAuthCallbackResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleCancelNoteRequest handles cancelNote operation.
+//
+// 送信済み・破棄済みでないノートを破棄(canceled)する。
+// チケットの担当者・副担当者とレビューを依頼したユーザーにBotから通知する。
+// Authorと本職のみ実行可能。.
+//
+// POST /tickets/{ticketId}/notes/{noteId}/cancel
+func (s *Server) handleCancelNoteRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: CancelNoteOperation,
+			ID:   "cancelNote",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, CancelNoteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, CancelNoteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, CancelNoteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeCancelNoteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response CancelNoteRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CancelNoteOperation,
+			OperationSummary: "ノートを破棄する",
+			OperationID:      "cancelNote",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = CancelNoteParams
+			Response = CancelNoteRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackCancelNoteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CancelNote(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CancelNote(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCancelNoteResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
 // handleConfigGetRequest handles GET /config operation.
 //
 // 設定情報の取得.
@@ -48,6 +310,40 @@ func (s *Server) handleConfigGetRequest(args [0]string, argsEscaped bool, w http
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, ConfigGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ConfigGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 		{
 			sctx, ok, err := s.securityTraQAuth(ctx, ConfigGetOperation, r)
 			if err != nil {
@@ -61,7 +357,7 @@ func (s *Server) handleConfigGetRequest(args [0]string, argsEscaped bool, w http
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -70,6 +366,8 @@ func (s *Server) handleConfigGetRequest(args [0]string, argsEscaped bool, w http
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -92,23 +390,4406 @@ func (s *Server) handleConfigGetRequest(args [0]string, argsEscaped bool, w http
 
 	var rawBody []byte
 
-	var response ConfigGetRes
+	var response ConfigGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ConfigGetOperation,
+			OperationSummary: "設定情報の取得",
+			OperationID:      "",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = ConfigGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ConfigGet(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ConfigGet(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeConfigGetResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleConfigPostRequest handles POST /config operation.
+//
+// 本職権限のみ実行可能.
+//
+// POST /config
+func (s *Server) handleConfigPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: ConfigPostOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, ConfigPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ConfigPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, ConfigPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeConfigPostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response ConfigPostRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    ConfigPostOperation,
+			OperationSummary: "設定情報の更新",
+			OperationID:      "",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *Config
+			Params   = struct{}
+			Response = ConfigPostRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.ConfigPost(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.ConfigPost(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeConfigPostResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleCreateMyTokenRequest handles createMyToken operation.
+//
+// トークンはハッシュ化して保存されるため、発行時のレスポンスでのみ確認できる.
+//
+// POST /me/tokens
+func (s *Server) handleCreateMyTokenRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: CreateMyTokenOperation,
+			ID:   "createMyToken",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, CreateMyTokenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateMyTokenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, CreateMyTokenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreateMyTokenRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CreateMyTokenRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CreateMyTokenOperation,
+			OperationSummary: "APIトークンの発行",
+			OperationID:      "createMyToken",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *APITokenCreate
+			Params   = struct{}
+			Response = CreateMyTokenRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CreateMyToken(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CreateMyToken(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCreateMyTokenResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleCreateReviewRequest handles createReview operation.
+//
+// 承認(approve)の場合、Weightの上限は承認ポリシー(設定のapproval_policy)のユーザー権限ごとの上限に基づく(既定は本職5/補佐4/他0)。
+// 承認ポリシーの条件(既定はWeight合計が5以上)を満たすと、Noteのstatusが`waiting_sent`になる。
+// Noteのrequire_requested_reviewsがtrueの場合は、レビューを依頼した全員の承認もそろう必要がある。
+// すでにレビュー済みの場合は失敗する。.
+//
+// POST /tickets/{ticketId}/notes/{noteId}/reviews
+func (s *Server) handleCreateReviewRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: CreateReviewOperation,
+			ID:   "createReview",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, CreateReviewOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateReviewOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, CreateReviewOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeCreateReviewParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreateReviewRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CreateReviewRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CreateReviewOperation,
+			OperationSummary: "レビュー追加",
+			OperationID:      "createReview",
+			Body:             request,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = *CreateReviewReq
+			Params   = CreateReviewParams
+			Response = CreateReviewRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackCreateReviewParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CreateReview(ctx, request, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CreateReview(ctx, request, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCreateReviewResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleCreateTicketRequest handles createTicket operation.
+//
+// 新規チケットを作成する。公開範囲(visibility)の省略時はpublicになる。managersは本職のみ指定できる。.
+//
+// POST /tickets
+func (s *Server) handleCreateTicketRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: CreateTicketOperation,
+			ID:   "createTicket",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, CreateTicketOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateTicketOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, CreateTicketOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreateTicketRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CreateTicketRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CreateTicketOperation,
+			OperationSummary: "チケット新規作成",
+			OperationID:      "createTicket",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *CreateTicketReq
+			Params   = struct{}
+			Response = CreateTicketRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CreateTicket(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CreateTicket(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCreateTicketResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleCreateUserRequest handles createUser operation.
+//
+// Manager(本職)権限のみ。
+// 本職が1人も登録されていない場合 (初期設定時) は誰でも実行できる。.
+//
+// POST /users
+func (s *Server) handleCreateUserRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: CreateUserOperation,
+			ID:   "createUser",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, CreateUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, CreateUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, CreateUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+	request, rawBody, close, err := s.decodeCreateUserRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
+
+	var response CreateUserRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    CreateUserOperation,
+			OperationSummary: "ユーザー追加",
+			OperationID:      "createUser",
+			Body:             request,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = *User
+			Params   = struct{}
+			Response = CreateUserRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.CreateUser(ctx, request)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.CreateUser(ctx, request)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeCreateUserResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteMyTokenRequest handles deleteMyToken operation.
+//
+// APIトークンの削除.
+//
+// DELETE /me/tokens/{tokenId}
+func (s *Server) handleDeleteMyTokenRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteMyTokenOperation,
+			ID:   "deleteMyToken",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, DeleteMyTokenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteMyTokenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, DeleteMyTokenOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteMyTokenParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response DeleteMyTokenRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteMyTokenOperation,
+			OperationSummary: "APIトークンの削除",
+			OperationID:      "deleteMyToken",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "tokenId",
+					In:   "path",
+				}: params.TokenId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteMyTokenParams
+			Response = DeleteMyTokenRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteMyTokenParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteMyToken(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteMyToken(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteMyTokenResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteReviewRequest handles deleteReview operation.
+//
+// レビュー取り消し.
+//
+// DELETE /tickets/{ticketId}/notes/{noteId}/reviews/{reviewId}
+func (s *Server) handleDeleteReviewRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteReviewOperation,
+			ID:   "deleteReview",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, DeleteReviewOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteReviewOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, DeleteReviewOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteReviewParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response DeleteReviewRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteReviewOperation,
+			OperationSummary: "レビュー取り消し",
+			OperationID:      "deleteReview",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
+				{
+					Name: "reviewId",
+					In:   "path",
+				}: params.ReviewId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteReviewParams
+			Response = DeleteReviewRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteReviewParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteReview(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteReview(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteReviewResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteTicketByIDRequest handles deleteTicketByID operation.
+//
+// 本職のみ実行可能。.
+//
+// DELETE /tickets/{ticketId}
+func (s *Server) handleDeleteTicketByIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteTicketByIDOperation,
+			ID:   "deleteTicketByID",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, DeleteTicketByIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteTicketByIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, DeleteTicketByIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteTicketByIDParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response DeleteTicketByIDRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteTicketByIDOperation,
+			OperationSummary: "チケット削除",
+			OperationID:      "deleteTicketByID",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteTicketByIDParams
+			Response = DeleteTicketByIDRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteTicketByIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteTicketByID(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteTicketByID(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteTicketByIDResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleDeleteUserRequest handles deleteUser operation.
+//
+// Manager(本職)権限のみ。.
+//
+// DELETE /users/{traqId}
+func (s *Server) handleDeleteUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: DeleteUserOperation,
+			ID:   "deleteUser",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, DeleteUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, DeleteUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, DeleteUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeDeleteUserParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response DeleteUserRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    DeleteUserOperation,
+			OperationSummary: "ユーザー削除",
+			OperationID:      "deleteUser",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "traqId",
+					In:   "path",
+				}: params.TraqId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = DeleteUserParams
+			Response = DeleteUserRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackDeleteUserParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.DeleteUser(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.DeleteUser(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeDeleteUserResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetCensorPolicyRequest handles getCensorPolicy operation.
+//
+// 伏字のカテゴリごとの閲覧ルールの取得.
+//
+// GET /config/censor-policy
+func (s *Server) handleGetCensorPolicyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetCensorPolicyOperation,
+			ID:   "getCensorPolicy",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetCensorPolicyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetCensorPolicyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetCensorPolicyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response GetCensorPolicyRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetCensorPolicyOperation,
+			OperationSummary: "伏字のカテゴリごとの閲覧ルールの取得",
+			OperationID:      "getCensorPolicy",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetCensorPolicyRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetCensorPolicy(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetCensorPolicy(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetCensorPolicyResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetMyTokensRequest handles getMyTokens operation.
+//
+// トークンそのものは返さない.
+//
+// GET /me/tokens
+func (s *Server) handleGetMyTokensRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetMyTokensOperation,
+			ID:   "getMyTokens",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetMyTokensOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetMyTokensOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetMyTokensOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response GetMyTokensRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetMyTokensOperation,
+			OperationSummary: "自分のAPIトークン一覧取得",
+			OperationID:      "getMyTokens",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetMyTokensRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetMyTokens(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetMyTokens(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetMyTokensResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetNoteRevisionDiffRequest handles getNoteRevisionDiff operation.
+//
+// FromRevisionの版からtoRevisionの版への行単位・文字単位の差分を返す。伏字は閲覧者の権限に応じて適用される。.
+//
+// GET /tickets/{ticketId}/notes/{noteId}/revisions/{fromRevision}/diff/{toRevision}
+func (s *Server) handleGetNoteRevisionDiffRequest(args [4]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetNoteRevisionDiffOperation,
+			ID:   "getNoteRevisionDiff",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetNoteRevisionDiffOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetNoteRevisionDiffOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetNoteRevisionDiffOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetNoteRevisionDiffParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetNoteRevisionDiffRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetNoteRevisionDiffOperation,
+			OperationSummary: "ノートの版の差分取得",
+			OperationID:      "getNoteRevisionDiff",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
+				{
+					Name: "fromRevision",
+					In:   "path",
+				}: params.FromRevision,
+				{
+					Name: "toRevision",
+					In:   "path",
+				}: params.ToRevision,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetNoteRevisionDiffParams
+			Response = GetNoteRevisionDiffRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetNoteRevisionDiffParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetNoteRevisionDiff(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetNoteRevisionDiff(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetNoteRevisionDiffResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetNoteRevisionsRequest handles getNoteRevisions operation.
+//
+// ノートの本文の版を古い順に返す。伏字は閲覧者の権限に応じて適用される。.
+//
+// GET /tickets/{ticketId}/notes/{noteId}/revisions
+func (s *Server) handleGetNoteRevisionsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetNoteRevisionsOperation,
+			ID:   "getNoteRevisions",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetNoteRevisionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetNoteRevisionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetNoteRevisionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetNoteRevisionsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetNoteRevisionsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetNoteRevisionsOperation,
+			OperationSummary: "ノートの版一覧取得",
+			OperationID:      "getNoteRevisions",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetNoteRevisionsParams
+			Response = GetNoteRevisionsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetNoteRevisionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetNoteRevisions(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetNoteRevisions(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetNoteRevisionsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetReviewDiffRequest handles getReviewDiff operation.
+//
+// レビューした版からノートの最新の版への差分を返す。伏字は閲覧者の権限に応じて適用される。.
+//
+// GET /tickets/{ticketId}/notes/{noteId}/reviews/{reviewId}/diff
+func (s *Server) handleGetReviewDiffRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetReviewDiffOperation,
+			ID:   "getReviewDiff",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetReviewDiffOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetReviewDiffOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetReviewDiffOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetReviewDiffParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetReviewDiffRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetReviewDiffOperation,
+			OperationSummary: "レビュー時からのノートの差分取得",
+			OperationID:      "getReviewDiff",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
+				{
+					Name: "reviewId",
+					In:   "path",
+				}: params.ReviewId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetReviewDiffParams
+			Response = GetReviewDiffRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetReviewDiffParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetReviewDiff(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetReviewDiff(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetReviewDiffResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetRoleChangesRequest handles getRoleChanges operation.
+//
+// 本職権限のみ実行可能。新しい順に返す.
+//
+// GET /audit/role-changes
+func (s *Server) handleGetRoleChangesRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetRoleChangesOperation,
+			ID:   "getRoleChanges",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetRoleChangesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetRoleChangesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetRoleChangesOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetRoleChangesParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetRoleChangesRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetRoleChangesOperation,
+			OperationSummary: "ユーザーの追加・ロールの変更・削除の記録の取得",
+			OperationID:      "getRoleChanges",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "traq_id",
+					In:   "query",
+				}: params.TraqID,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetRoleChangesParams
+			Response = GetRoleChangesRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetRoleChangesParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetRoleChanges(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetRoleChanges(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetRoleChangesResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTicketByIDRequest handles getTicketByID operation.
+//
+// チケットに紐づくノート一覧(notes)も同時に返却される。公開範囲外のチケットは404を返す。.
+//
+// GET /tickets/{ticketId}
+func (s *Server) handleGetTicketByIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTicketByIDOperation,
+			ID:   "getTicketByID",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetTicketByIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetTicketByIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetTicketByIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetTicketByIDParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetTicketByIDRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTicketByIDOperation,
+			OperationSummary: "チケット詳細取得",
+			OperationID:      "getTicketByID",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTicketByIDParams
+			Response = GetTicketByIDRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTicketByIDParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTicketByID(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTicketByID(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTicketByIDResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTicketHistoryRequest handles getTicketHistory operation.
+//
+// チケットとそのノート・レビューの変更履歴を古い順に返す。伏字は閲覧者の権限に応じて適用される。.
+//
+// GET /tickets/{ticketId}/history
+func (s *Server) handleGetTicketHistoryRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTicketHistoryOperation,
+			ID:   "getTicketHistory",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetTicketHistoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetTicketHistoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetTicketHistoryOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetTicketHistoryParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetTicketHistoryRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTicketHistoryOperation,
+			OperationSummary: "チケットの変更履歴取得",
+			OperationID:      "getTicketHistory",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTicketHistoryParams
+			Response = GetTicketHistoryRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTicketHistoryParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTicketHistory(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTicketHistory(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTicketHistoryResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTicketTransitionsRequest handles getTicketTransitions operation.
+//
+// リクエストしたユーザーが現在のステータスから遷移できるステータスを返す。.
+//
+// GET /tickets/{ticketId}/transitions
+func (s *Server) handleGetTicketTransitionsRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTicketTransitionsOperation,
+			ID:   "getTicketTransitions",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetTicketTransitionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetTicketTransitionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetTicketTransitionsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetTicketTransitionsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetTicketTransitionsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTicketTransitionsOperation,
+			OperationSummary: "チケットのステータス遷移先取得",
+			OperationID:      "getTicketTransitions",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTicketTransitionsParams
+			Response = GetTicketTransitionsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTicketTransitionsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTicketTransitions(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTicketTransitions(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTicketTransitionsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTicketsRequest handles getTickets operation.
+//
+// 公開範囲外のチケットは結果にもX-Total-Countにも含めない。
+// limitとcursorをどちらも省略した場合は、これまで通りすべてのチケットを返す。
+// limitまたはcursorを指定した場合はlimit件 (省略時は50件)
+// ずつ返すため、続きはX-Next-Cursorがなくなるまでcursorを指定して取得する。.
+//
+// GET /tickets
+func (s *Server) handleGetTicketsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTicketsOperation,
+			ID:   "getTickets",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetTicketsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetTicketsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetTicketsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetTicketsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetTicketsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTicketsOperation,
+			OperationSummary: "チケット一覧取得",
+			OperationID:      "getTickets",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "assignee",
+					In:   "query",
+				}: params.Assignee,
+				{
+					Name: "status",
+					In:   "query",
+				}: params.Status,
+				{
+					Name: "tag",
+					In:   "query",
+				}: params.Tag,
+				{
+					Name: "sub_assignee",
+					In:   "query",
+				}: params.SubAssignee,
+				{
+					Name: "stakeholder",
+					In:   "query",
+				}: params.Stakeholder,
+				{
+					Name: "involves",
+					In:   "query",
+				}: params.Involves,
+				{
+					Name: "due_before",
+					In:   "query",
+				}: params.DueBefore,
+				{
+					Name: "due_after",
+					In:   "query",
+				}: params.DueAfter,
+				{
+					Name: "updated_since",
+					In:   "query",
+				}: params.UpdatedSince,
+				{
+					Name: "overdue",
+					In:   "query",
+				}: params.Overdue,
+				{
+					Name: "sort",
+					In:   "query",
+				}: params.Sort,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+				{
+					Name: "cursor",
+					In:   "query",
+				}: params.Cursor,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetTicketsParams
+			Response = GetTicketsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetTicketsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTickets(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTickets(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTicketsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetTrashedTicketsRequest handles getTrashedTickets operation.
+//
+// 本職のみ実行可能。削除日時の新しい順に返す。.
+//
+// GET /tickets/trash
+func (s *Server) handleGetTrashedTicketsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetTrashedTicketsOperation,
+			ID:   "getTrashedTickets",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetTrashedTicketsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetTrashedTicketsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetTrashedTicketsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response GetTrashedTicketsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetTrashedTicketsOperation,
+			OperationSummary: "削除済みチケット一覧取得",
+			OperationID:      "getTrashedTickets",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = GetTrashedTicketsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetTrashedTickets(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetTrashedTickets(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetTrashedTicketsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUncensoredViewsRequest handles getUncensoredViews operation.
+//
+// 本職権限のみ実行可能。新しい順に返す.
+//
+// GET /audit/uncensored-views
+func (s *Server) handleGetUncensoredViewsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUncensoredViewsOperation,
+			ID:   "getUncensoredViews",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetUncensoredViewsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetUncensoredViewsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetUncensoredViewsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetUncensoredViewsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetUncensoredViewsRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUncensoredViewsOperation,
+			OperationSummary: "伏字を伏せずに返した記録の取得",
+			OperationID:      "getUncensoredViews",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "actor",
+					In:   "query",
+				}: params.Actor,
+				{
+					Name: "since",
+					In:   "query",
+				}: params.Since,
+				{
+					Name: "until",
+					In:   "query",
+				}: params.Until,
+				{
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUncensoredViewsParams
+			Response = GetUncensoredViewsRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUncensoredViewsParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUncensoredViews(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUncensoredViews(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUncensoredViewsResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleGetUserRequest handles getUser operation.
+//
+// TraQ の表示名・アイコンと登録されているロールを返す。traQ
+// のユーザー情報は一定時間キャッシュされる。.
+//
+// GET /users/{traqId}
+func (s *Server) handleGetUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: GetUserOperation,
+			ID:   "getUser",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, GetUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, GetUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, GetUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeGetUserParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response GetUserRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    GetUserOperation,
+			OperationSummary: "ユーザー情報取得",
+			OperationID:      "getUser",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "traqId",
+					In:   "path",
+				}: params.TraqId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = GetUserParams
+			Response = GetUserRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackGetUserParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.GetUser(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.GetUser(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeGetUserResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleLoginRequest handles login operation.
+//
+// TraQの認可画面にリダイレクトする。stateをログインを開始したブラウザに結び付けるCookieを発行する。traQ OAuth2が設定されていない場合は404を返す.
+//
+// GET /auth/login
+func (s *Server) handleLoginRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err error
+	)
+
+	var rawBody []byte
+
+	var response LoginRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    LoginOperation,
+			OperationSummary: "traQ OAuth2のログイン開始",
+			OperationID:      "login",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = LoginRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.Login(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.Login(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeLoginResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleLogoutRequest handles logout operation.
+//
+// セッションを破棄する.
+//
+// POST /auth/logout
+func (s *Server) handleLogoutRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: LogoutOperation,
+			ID:   "logout",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, LogoutOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, LogoutOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, LogoutOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response LogoutRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    LogoutOperation,
+			OperationSummary: "ログアウト",
+			OperationID:      "logout",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = LogoutRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.Logout(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.Logout(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeLogoutResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMarkNoteSentRequest handles markNoteSent operation.
+//
+// 送信待ち(waiting_sent)の発信ノートのみ送信済み(sent)にでき、sent_atを記録する。
+// チケットの担当者・副担当者・関係者にBotから通知する。
+// Authorと本職のみ実行可能。.
+//
+// POST /tickets/{ticketId}/notes/{noteId}/send
+func (s *Server) handleMarkNoteSentRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MarkNoteSentOperation,
+			ID:   "markNoteSent",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, MarkNoteSentOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MarkNoteSentOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, MarkNoteSentOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeMarkNoteSentParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response MarkNoteSentRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MarkNoteSentOperation,
+			OperationSummary: "ノートを送信済みにする",
+			OperationID:      "markNoteSent",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = MarkNoteSentParams
+			Response = MarkNoteSentRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackMarkNoteSentParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MarkNoteSent(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MarkNoteSent(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeMarkNoteSentResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handleMeGetRequest handles GET /me operation.
+//
+// 認証ヘッダーから自分のtraQ IDを返す。.
+//
+// GET /me
+func (s *Server) handleMeGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: MeGetOperation,
+			ID:   "",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, MeGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, MeGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, MeGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+
+	var rawBody []byte
+
+	var response MeGetRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    MeGetOperation,
+			OperationSummary: "現在のユーザー情報取得",
+			OperationID:      "",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params:           middleware.Parameters{},
+			Raw:              r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = struct{}
+			Response = MeGetRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			nil,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.MeGet(ctx)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.MeGet(ctx)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeMeGetResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
+
+// handlePurgeTicketRequest handles purgeTicket operation.
+//
+// 本職のみ実行可能。ゴミ箱にあるチケットのみ対象で、ノート・レビューも削除される (変更履歴は変更前後の値を消して監査のため残る)。元に戻すことはできない。.
+//
+// DELETE /tickets/{ticketId}/purge
+func (s *Server) handlePurgeTicketRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: PurgeTicketOperation,
+			ID:   "purgeTicket",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, PurgeTicketOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, PurgeTicketOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, PurgeTicketOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodePurgeTicketParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response PurgeTicketRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ConfigGetOperation,
-			OperationSummary: "設定情報の取得",
-			OperationID:      "",
+			OperationName:    PurgeTicketOperation,
+			OperationSummary: "削除済みチケットの完全削除",
+			OperationID:      "purgeTicket",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = ConfigGetRes
+			Params   = PurgeTicketParams
+			Response = PurgeTicketRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -117,14 +4798,14 @@ func (s *Server) handleConfigGetRequest(args [0]string, argsEscaped bool, w http
 		](
 			m,
 			mreq,
-			nil,
+			unpackPurgeTicketParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ConfigGet(ctx)
+				response, err = s.h.PurgeTicket(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ConfigGet(ctx)
+		response, err = s.h.PurgeTicket(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -132,7 +4813,7 @@ func (s *Server) handleConfigGetRequest(args [0]string, argsEscaped bool, w http
 		return
 	}
 
-	if err := encodeConfigGetResponse(response, w); err != nil {
+	if err := encodePurgeTicketResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -141,12 +4822,16 @@ func (s *Server) handleConfigGetRequest(args [0]string, argsEscaped bool, w http
 	}
 }
 
-// handleConfigPostRequest handles POST /config operation.
+// handleRequestReviewsRequest handles requestReviews operation.
 //
-// 本職権限のみ実行可能.
+// 送信するノート(outgoing)のレビューを指定したユーザーに依頼し、Botから通知する。
+// すでに依頼しているユーザーには再度通知しない。
+// require_requested_reviewsをtrueにすると、送信待ちになるにはWeightに加えて依頼した全員の承認が必要になる。
+// 送信待ちのノートで依頼した全員の承認がそろっていない場合、ステータスはwaiting_reviewに戻る。
+// Authorと本職のみ実行可能。.
 //
-// POST /config
-func (s *Server) handleConfigPostRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /tickets/{ticketId}/notes/{noteId}/review-requests
+func (s *Server) handleRequestReviewsRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -154,15 +4839,49 @@ func (s *Server) handleConfigPostRequest(args [0]string, argsEscaped bool, w htt
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: ConfigPostOperation,
-			ID:   "",
+			Name: RequestReviewsOperation,
+			ID:   "requestReviews",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, ConfigPostOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, RequestReviewsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RequestReviewsOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, RequestReviewsOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -174,7 +4893,7 @@ func (s *Server) handleConfigPostRequest(args [0]string, argsEscaped bool, w htt
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -183,6 +4902,8 @@ func (s *Server) handleConfigPostRequest(args [0]string, argsEscaped bool, w htt
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -202,9 +4923,19 @@ func (s *Server) handleConfigPostRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
+	params, err := decodeRequestReviewsParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeConfigPostRequest(r)
+	request, rawBody, close, err := s.decodeRequestReviewsRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -220,23 +4951,32 @@ func (s *Server) handleConfigPostRequest(args [0]string, argsEscaped bool, w htt
 		}
 	}()
 
-	var response ConfigPostRes
+	var response RequestReviewsRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    ConfigPostOperation,
-			OperationSummary: "設定情報の更新",
-			OperationID:      "",
+			OperationName:    RequestReviewsOperation,
+			OperationSummary: "レビュー依頼",
+			OperationID:      "requestReviews",
 			Body:             request,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
+			},
+			Raw: r,
 		}
 
 		type (
-			Request  = *Config
-			Params   = struct{}
-			Response = ConfigPostRes
+			Request  = *RequestReviewsReq
+			Params   = RequestReviewsParams
+			Response = RequestReviewsRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -245,14 +4985,14 @@ func (s *Server) handleConfigPostRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			nil,
+			unpackRequestReviewsParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.ConfigPost(ctx, request)
+				response, err = s.h.RequestReviews(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.ConfigPost(ctx, request)
+		response, err = s.h.RequestReviews(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -260,7 +5000,7 @@ func (s *Server) handleConfigPostRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeConfigPostResponse(response, w); err != nil {
+	if err := encodeRequestReviewsResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -269,14 +5009,12 @@ func (s *Server) handleConfigPostRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleCreateReviewRequest handles createReview operation.
+// handleRestoreTicketRequest handles restoreTicket operation.
 //
-// 承認(approve)の場合、Weightの上限はユーザー権限に基づく(本職5/補佐4/他0)。
-// Weight合計が5以上になると、Noteのstatusが`waiting_sent`になる。
-// すでにレビュー済みの場合は失敗する。.
+// 本職のみ実行可能。.
 //
-// POST /tickets/{ticketId}/notes/{noteId}/reviews
-func (s *Server) handleCreateReviewRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /tickets/{ticketId}/restore
+func (s *Server) handleRestoreTicketRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -284,15 +5022,49 @@ func (s *Server) handleCreateReviewRequest(args [2]string, argsEscaped bool, w h
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateReviewOperation,
-			ID:   "createReview",
+			Name: RestoreTicketOperation,
+			ID:   "restoreTicket",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, CreateReviewOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, RestoreTicketOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, RestoreTicketOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, RestoreTicketOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -304,7 +5076,7 @@ func (s *Server) handleCreateReviewRequest(args [2]string, argsEscaped bool, w h
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -313,6 +5085,8 @@ func (s *Server) handleCreateReviewRequest(args [2]string, argsEscaped bool, w h
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -332,7 +5106,7 @@ func (s *Server) handleCreateReviewRequest(args [2]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeCreateReviewParams(args, argsEscaped, r)
+	params, err := decodeRestoreTicketParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -344,48 +5118,29 @@ func (s *Server) handleCreateReviewRequest(args [2]string, argsEscaped bool, w h
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreateReviewRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response CreateReviewRes
+	var response RestoreTicketRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateReviewOperation,
-			OperationSummary: "レビュー追加",
-			OperationID:      "createReview",
-			Body:             request,
+			OperationName:    RestoreTicketOperation,
+			OperationSummary: "削除済みチケットの復元",
+			OperationID:      "restoreTicket",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
 					Name: "ticketId",
 					In:   "path",
 				}: params.TicketId,
-				{
-					Name: "noteId",
-					In:   "path",
-				}: params.NoteId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *CreateReviewReq
-			Params   = CreateReviewParams
-			Response = CreateReviewRes
+			Request  = struct{}
+			Params   = RestoreTicketParams
+			Response = RestoreTicketRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -394,14 +5149,14 @@ func (s *Server) handleCreateReviewRequest(args [2]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackCreateReviewParams,
+			unpackRestoreTicketParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateReview(ctx, request, params)
+				response, err = s.h.RestoreTicket(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateReview(ctx, request, params)
+		response, err = s.h.RestoreTicket(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -409,7 +5164,7 @@ func (s *Server) handleCreateReviewRequest(args [2]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeCreateReviewResponse(response, w); err != nil {
+	if err := encodeRestoreTicketResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -418,12 +5173,12 @@ func (s *Server) handleCreateReviewRequest(args [2]string, argsEscaped bool, w h
 	}
 }
 
-// handleCreateTicketRequest handles createTicket operation.
+// handleScanPIIRequest handles scanPII operation.
 //
-// 新規チケットを作成する。.
+// 本職権限のみ実行可能。削除済みのチケット・ノートは含めない.
 //
-// POST /tickets
-func (s *Server) handleCreateTicketRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /pii/scan
+func (s *Server) handleScanPIIRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -431,15 +5186,49 @@ func (s *Server) handleCreateTicketRequest(args [0]string, argsEscaped bool, w h
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: CreateTicketOperation,
-			ID:   "createTicket",
+			Name: ScanPIIOperation,
+			ID:   "scanPII",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, CreateTicketOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, ScanPIIOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, ScanPIIOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, ScanPIIOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -451,7 +5240,7 @@ func (s *Server) handleCreateTicketRequest(args [0]string, argsEscaped bool, w h
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -460,6 +5249,8 @@ func (s *Server) handleCreateTicketRequest(args [0]string, argsEscaped bool, w h
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -481,39 +5272,24 @@ func (s *Server) handleCreateTicketRequest(args [0]string, argsEscaped bool, w h
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeCreateTicketRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response CreateTicketRes
+	var response ScanPIIRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    CreateTicketOperation,
-			OperationSummary: "チケット新規作成",
-			OperationID:      "createTicket",
-			Body:             request,
+			OperationName:    ScanPIIOperation,
+			OperationSummary: "既存のチケット・ノートに含まれる伏字になっていない個人情報の検出",
+			OperationID:      "scanPII",
+			Body:             nil,
 			RawBody:          rawBody,
 			Params:           middleware.Parameters{},
 			Raw:              r,
 		}
 
 		type (
-			Request  = *CreateTicketReq
+			Request  = struct{}
 			Params   = struct{}
-			Response = CreateTicketRes
+			Response = ScanPIIRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -524,12 +5300,12 @@ func (s *Server) handleCreateTicketRequest(args [0]string, argsEscaped bool, w h
 			mreq,
 			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.CreateTicket(ctx, request)
+				response, err = s.h.ScanPII(ctx)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.CreateTicket(ctx, request)
+		response, err = s.h.ScanPII(ctx)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -537,7 +5313,7 @@ func (s *Server) handleCreateTicketRequest(args [0]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeCreateTicketResponse(response, w); err != nil {
+	if err := encodeScanPIIResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -546,12 +5322,15 @@ func (s *Server) handleCreateTicketRequest(args [0]string, argsEscaped bool, w h
 	}
 }
 
-// handleDeleteReviewRequest handles deleteReview operation.
+// handleSearchRequest handles search operation.
 //
-// レビュー取り消し.
+// チケットのタイトル・説明、ノートの本文、レビューのコメントを全文検索し、関連度の高い順に返す。
+// 空白で区切った語はすべて含むものに絞り込む。
+// 本職以外は閲覧できるカテゴリの伏字の中の文字列でのみ検索できる。
+// 公開範囲外のチケットとそのノート・レビューは結果に含めない。.
 //
-// DELETE /tickets/{ticketId}/notes/{noteId}/reviews/{reviewId}
-func (s *Server) handleDeleteReviewRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// GET /search
+func (s *Server) handleSearchRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -559,15 +5338,49 @@ func (s *Server) handleDeleteReviewRequest(args [3]string, argsEscaped bool, w h
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteReviewOperation,
-			ID:   "deleteReview",
+			Name: SearchOperation,
+			ID:   "search",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, SearchOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SearchOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
 		}
-	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, DeleteReviewOperation, r)
+			sctx, ok, err := s.securityTraQAuth(ctx, SearchOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -579,7 +5392,7 @@ func (s *Server) handleDeleteReviewRequest(args [3]string, argsEscaped bool, w h
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -588,6 +5401,8 @@ func (s *Server) handleDeleteReviewRequest(args [3]string, argsEscaped bool, w h
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -607,7 +5422,7 @@ func (s *Server) handleDeleteReviewRequest(args [3]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeDeleteReviewParams(args, argsEscaped, r)
+	params, err := decodeSearchParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -620,36 +5435,32 @@ func (s *Server) handleDeleteReviewRequest(args [3]string, argsEscaped bool, w h
 
 	var rawBody []byte
 
-	var response DeleteReviewRes
+	var response SearchRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteReviewOperation,
-			OperationSummary: "レビュー取り消し",
-			OperationID:      "deleteReview",
+			OperationName:    SearchOperation,
+			OperationSummary: "全文検索",
+			OperationID:      "search",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "ticketId",
-					In:   "path",
-				}: params.TicketId,
-				{
-					Name: "noteId",
-					In:   "path",
-				}: params.NoteId,
+					Name: "q",
+					In:   "query",
+				}: params.Q,
 				{
-					Name: "reviewId",
-					In:   "path",
-				}: params.ReviewId,
+					Name: "limit",
+					In:   "query",
+				}: params.Limit,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeleteReviewParams
-			Response = DeleteReviewRes
+			Params   = SearchParams
+			Response = SearchRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -658,14 +5469,14 @@ func (s *Server) handleDeleteReviewRequest(args [3]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackDeleteReviewParams,
+			unpackSearchParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteReview(ctx, params)
+				response, err = s.h.Search(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteReview(ctx, params)
+		response, err = s.h.Search(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -673,7 +5484,7 @@ func (s *Server) handleDeleteReviewRequest(args [3]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeDeleteReviewResponse(response, w); err != nil {
+	if err := encodeSearchResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -682,12 +5493,15 @@ func (s *Server) handleDeleteReviewRequest(args [3]string, argsEscaped bool, w h
 	}
 }
 
-// handleDeleteTicketByIDRequest handles deleteTicketByID operation.
+// handleSubmitNoteRequest handles submitNote operation.
 //
-// 本職のみ実行可能。.
+// 下書き(draft)の発信ノートを添削待ち(waiting_review)にし、submitted_atを記録する。
+// 承認ポリシーの条件をすでに満たしている場合は送信待ち(waiting_sent)になる。
+// チケットの担当者・副担当者とレビューを依頼したユーザーにBotから通知する。
+// Authorと本職のみ実行可能。.
 //
-// DELETE /tickets/{ticketId}
-func (s *Server) handleDeleteTicketByIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /tickets/{ticketId}/notes/{noteId}/submit
+func (s *Server) handleSubmitNoteRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -695,15 +5509,49 @@ func (s *Server) handleDeleteTicketByIDRequest(args [1]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: DeleteTicketByIDOperation,
-			ID:   "deleteTicketByID",
+			Name: SubmitNoteOperation,
+			ID:   "submitNote",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, DeleteTicketByIDOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, SubmitNoteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SubmitNoteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, SubmitNoteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -715,7 +5563,7 @@ func (s *Server) handleDeleteTicketByIDRequest(args [1]string, argsEscaped bool,
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -724,6 +5572,8 @@ func (s *Server) handleDeleteTicketByIDRequest(args [1]string, argsEscaped bool,
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -743,7 +5593,7 @@ func (s *Server) handleDeleteTicketByIDRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeDeleteTicketByIDParams(args, argsEscaped, r)
+	params, err := decodeSubmitNoteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -756,13 +5606,13 @@ func (s *Server) handleDeleteTicketByIDRequest(args [1]string, argsEscaped bool,
 
 	var rawBody []byte
 
-	var response DeleteTicketByIDRes
+	var response SubmitNoteRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    DeleteTicketByIDOperation,
-			OperationSummary: "チケット削除",
-			OperationID:      "deleteTicketByID",
+			OperationName:    SubmitNoteOperation,
+			OperationSummary: "ノートをレビューに出す",
+			OperationID:      "submitNote",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -770,14 +5620,18 @@ func (s *Server) handleDeleteTicketByIDRequest(args [1]string, argsEscaped bool,
 					Name: "ticketId",
 					In:   "path",
 				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = DeleteTicketByIDParams
-			Response = DeleteTicketByIDRes
+			Params   = SubmitNoteParams
+			Response = SubmitNoteRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -786,14 +5640,14 @@ func (s *Server) handleDeleteTicketByIDRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackDeleteTicketByIDParams,
+			unpackSubmitNoteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.DeleteTicketByID(ctx, params)
+				response, err = s.h.SubmitNote(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.DeleteTicketByID(ctx, params)
+		response, err = s.h.SubmitNote(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -801,7 +5655,7 @@ func (s *Server) handleDeleteTicketByIDRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeDeleteTicketByIDResponse(response, w); err != nil {
+	if err := encodeSubmitNoteResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -810,12 +5664,14 @@ func (s *Server) handleDeleteTicketByIDRequest(args [1]string, argsEscaped bool,
 	}
 }
 
-// handleGetTicketByIDRequest handles getTicketByID operation.
+// handleSyncUsersRequest handles syncUsers operation.
 //
-// チケットに紐づくノート一覧(notes)も同時に返却される。.
+// Manager(本職)権限のみ。設定されたtraQグループのメンバーをユーザーとロールに同期する。
+// 複数のグループに所属するユーザーは上位のロールになり、どのグループにも所属しないユーザーは削除される。
+// 定期的にも自動で同期される。.
 //
-// GET /tickets/{ticketId}
-func (s *Server) handleGetTicketByIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /users/sync
+func (s *Server) handleSyncUsersRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -823,15 +5679,49 @@ func (s *Server) handleGetTicketByIDRequest(args [1]string, argsEscaped bool, w
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTicketByIDOperation,
-			ID:   "getTicketByID",
+			Name: SyncUsersOperation,
+			ID:   "syncUsers",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, GetTicketByIDOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, SyncUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, SyncUsersOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, SyncUsersOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -843,7 +5733,7 @@ func (s *Server) handleGetTicketByIDRequest(args [1]string, argsEscaped bool, w
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -852,6 +5742,8 @@ func (s *Server) handleGetTicketByIDRequest(args [1]string, argsEscaped bool, w
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -871,7 +5763,7 @@ func (s *Server) handleGetTicketByIDRequest(args [1]string, argsEscaped bool, w
 			return
 		}
 	}
-	params, err := decodeGetTicketByIDParams(args, argsEscaped, r)
+	params, err := decodeSyncUsersParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -884,28 +5776,28 @@ func (s *Server) handleGetTicketByIDRequest(args [1]string, argsEscaped bool, w
 
 	var rawBody []byte
 
-	var response GetTicketByIDRes
+	var response SyncUsersRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTicketByIDOperation,
-			OperationSummary: "チケット詳細取得",
-			OperationID:      "getTicketByID",
+			OperationName:    SyncUsersOperation,
+			OperationSummary: "traQグループからユーザー情報を同期",
+			OperationID:      "syncUsers",
 			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "ticketId",
-					In:   "path",
-				}: params.TicketId,
+					Name: "dry_run",
+					In:   "query",
+				}: params.DryRun,
 			},
 			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = GetTicketByIDParams
-			Response = GetTicketByIDRes
+			Params   = SyncUsersParams
+			Response = SyncUsersRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -914,14 +5806,14 @@ func (s *Server) handleGetTicketByIDRequest(args [1]string, argsEscaped bool, w
 		](
 			m,
 			mreq,
-			unpackGetTicketByIDParams,
+			unpackSyncUsersParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTicketByID(ctx, params)
+				response, err = s.h.SyncUsers(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTicketByID(ctx, params)
+		response, err = s.h.SyncUsers(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -929,7 +5821,7 @@ func (s *Server) handleGetTicketByIDRequest(args [1]string, argsEscaped bool, w
 		return
 	}
 
-	if err := encodeGetTicketByIDResponse(response, w); err != nil {
+	if err := encodeSyncUsersResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -938,12 +5830,12 @@ func (s *Server) handleGetTicketByIDRequest(args [1]string, argsEscaped bool, w
 	}
 }
 
-// handleGetTicketsRequest handles getTickets operation.
+// handleTicketsTicketIdAiGeneratePostRequest handles POST /tickets/{ticketId}/ai/generate operation.
 //
-// チケット一覧取得.
+// AIによる返信ドラフト生成 (SSE).
 //
-// GET /tickets
-func (s *Server) handleGetTicketsRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /tickets/{ticketId}/ai/generate
+func (s *Server) handleTicketsTicketIdAiGeneratePostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -951,15 +5843,49 @@ func (s *Server) handleGetTicketsRequest(args [0]string, argsEscaped bool, w htt
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: GetTicketsOperation,
-			ID:   "getTickets",
+			Name: TicketsTicketIdAiGeneratePostOperation,
+			ID:   "",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, GetTicketsOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, TicketsTicketIdAiGeneratePostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, TicketsTicketIdAiGeneratePostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, TicketsTicketIdAiGeneratePostOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -971,7 +5897,7 @@ func (s *Server) handleGetTicketsRequest(args [0]string, argsEscaped bool, w htt
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -980,6 +5906,8 @@ func (s *Server) handleGetTicketsRequest(args [0]string, argsEscaped bool, w htt
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -999,7 +5927,7 @@ func (s *Server) handleGetTicketsRequest(args [0]string, argsEscaped bool, w htt
 			return
 		}
 	}
-	params, err := decodeGetTicketsParams(args, argsEscaped, r)
+	params, err := decodeTicketsTicketIdAiGeneratePostParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1011,37 +5939,44 @@ func (s *Server) handleGetTicketsRequest(args [0]string, argsEscaped bool, w htt
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeTicketsTicketIdAiGeneratePostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response GetTicketsRes
+	var response TicketsTicketIdAiGeneratePostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    GetTicketsOperation,
-			OperationSummary: "チケット一覧取得",
-			OperationID:      "getTickets",
-			Body:             nil,
+			OperationName:    TicketsTicketIdAiGeneratePostOperation,
+			OperationSummary: "AIによる返信ドラフト生成 (SSE)",
+			OperationID:      "",
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "assignee",
-					In:   "query",
-				}: params.Assignee,
-				{
-					Name: "status",
-					In:   "query",
-				}: params.Status,
-				{
-					Name: "sort",
-					In:   "query",
-				}: params.Sort,
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = GetTicketsParams
-			Response = GetTicketsRes
+			Request  = *TicketsTicketIdAiGeneratePostReq
+			Params   = TicketsTicketIdAiGeneratePostParams
+			Response = TicketsTicketIdAiGeneratePostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1050,14 +5985,14 @@ func (s *Server) handleGetTicketsRequest(args [0]string, argsEscaped bool, w htt
 		](
 			m,
 			mreq,
-			unpackGetTicketsParams,
+			unpackTicketsTicketIdAiGeneratePostParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.GetTickets(ctx, params)
+				response, err = s.h.TicketsTicketIdAiGeneratePost(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.GetTickets(ctx, params)
+		response, err = s.h.TicketsTicketIdAiGeneratePost(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1065,7 +6000,7 @@ func (s *Server) handleGetTicketsRequest(args [0]string, argsEscaped bool, w htt
 		return
 	}
 
-	if err := encodeGetTicketsResponse(response, w); err != nil {
+	if err := encodeTicketsTicketIdAiGeneratePostResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1074,12 +6009,12 @@ func (s *Server) handleGetTicketsRequest(args [0]string, argsEscaped bool, w htt
 	}
 }
 
-// handleMeGetRequest handles GET /me operation.
+// handleTicketsTicketIdNotesNoteIdAiReviewPostRequest handles POST /tickets/{ticketId}/notes/{noteId}/ai/review operation.
 //
-// 認証ヘッダーから自分のtraQ IDを返す。.
+// 指定されたノートの内容をAIが添削・レビューする.
 //
-// GET /me
-func (s *Server) handleMeGetRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /tickets/{ticketId}/notes/{noteId}/ai/review
+func (s *Server) handleTicketsTicketIdNotesNoteIdAiReviewPostRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1087,15 +6022,49 @@ func (s *Server) handleMeGetRequest(args [0]string, argsEscaped bool, w http.Res
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: MeGetOperation,
+			Name: TicketsTicketIdNotesNoteIdAiReviewPostOperation,
 			ID:   "",
 		}
-	)
-	{
-		type bitset = [1]uint8
-		var satisfied bitset
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, TicketsTicketIdNotesNoteIdAiReviewPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, TicketsTicketIdNotesNoteIdAiReviewPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, MeGetOperation, r)
+			sctx, ok, err := s.securityTraQAuth(ctx, TicketsTicketIdNotesNoteIdAiReviewPostOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1107,7 +6076,7 @@ func (s *Server) handleMeGetRequest(args [0]string, argsEscaped bool, w http.Res
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -1116,6 +6085,8 @@ func (s *Server) handleMeGetRequest(args [0]string, argsEscaped bool, w http.Res
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1135,26 +6106,45 @@ func (s *Server) handleMeGetRequest(args [0]string, argsEscaped bool, w http.Res
 			return
 		}
 	}
+	params, err := decodeTicketsTicketIdNotesNoteIdAiReviewPostParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
 
 	var rawBody []byte
 
-	var response MeGetRes
+	var response TicketsTicketIdNotesNoteIdAiReviewPostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    MeGetOperation,
-			OperationSummary: "現在のユーザー情報取得",
+			OperationName:    TicketsTicketIdNotesNoteIdAiReviewPostOperation,
+			OperationSummary: "AIによるノート（下書き）のレビュー (SSE)",
 			OperationID:      "",
 			Body:             nil,
 			RawBody:          rawBody,
-			Params:           middleware.Parameters{},
-			Raw:              r,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
+			},
+			Raw: r,
 		}
 
 		type (
 			Request  = struct{}
-			Params   = struct{}
-			Response = MeGetRes
+			Params   = TicketsTicketIdNotesNoteIdAiReviewPostParams
+			Response = TicketsTicketIdNotesNoteIdAiReviewPostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1163,14 +6153,14 @@ func (s *Server) handleMeGetRequest(args [0]string, argsEscaped bool, w http.Res
 		](
 			m,
 			mreq,
-			nil,
+			unpackTicketsTicketIdNotesNoteIdAiReviewPostParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.MeGet(ctx)
+				response, err = s.h.TicketsTicketIdNotesNoteIdAiReviewPost(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.MeGet(ctx)
+		response, err = s.h.TicketsTicketIdNotesNoteIdAiReviewPost(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1178,7 +6168,7 @@ func (s *Server) handleMeGetRequest(args [0]string, argsEscaped bool, w http.Res
 		return
 	}
 
-	if err := encodeMeGetResponse(response, w); err != nil {
+	if err := encodeTicketsTicketIdNotesNoteIdAiReviewPostResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1187,12 +6177,12 @@ func (s *Server) handleMeGetRequest(args [0]string, argsEscaped bool, w http.Res
 	}
 }
 
-// handleTicketsTicketIdAiGeneratePostRequest handles POST /tickets/{ticketId}/ai/generate operation.
+// handleTicketsTicketIdNotesNoteIdDeleteRequest handles DELETE /tickets/{ticketId}/notes/{noteId} operation.
 //
-// AIによる返信ドラフト生成 (SSE).
+// Authorと本職のみ実行可能。.
 //
-// POST /tickets/{ticketId}/ai/generate
-func (s *Server) handleTicketsTicketIdAiGeneratePostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// DELETE /tickets/{ticketId}/notes/{noteId}
+func (s *Server) handleTicketsTicketIdNotesNoteIdDeleteRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1200,7 +6190,7 @@ func (s *Server) handleTicketsTicketIdAiGeneratePostRequest(args [1]string, args
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TicketsTicketIdAiGeneratePostOperation,
+			Name: TicketsTicketIdNotesNoteIdDeleteOperation,
 			ID:   "",
 		}
 	)
@@ -1208,7 +6198,41 @@ func (s *Server) handleTicketsTicketIdAiGeneratePostRequest(args [1]string, args
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, TicketsTicketIdAiGeneratePostOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, TicketsTicketIdNotesNoteIdDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, TicketsTicketIdNotesNoteIdDeleteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, TicketsTicketIdNotesNoteIdDeleteOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1220,7 +6244,7 @@ func (s *Server) handleTicketsTicketIdAiGeneratePostRequest(args [1]string, args
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -1229,6 +6253,8 @@ func (s *Server) handleTicketsTicketIdAiGeneratePostRequest(args [1]string, args
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1248,7 +6274,7 @@ func (s *Server) handleTicketsTicketIdAiGeneratePostRequest(args [1]string, args
 			return
 		}
 	}
-	params, err := decodeTicketsTicketIdAiGeneratePostParams(args, argsEscaped, r)
+	params, err := decodeTicketsTicketIdNotesNoteIdDeleteParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1260,44 +6286,33 @@ func (s *Server) handleTicketsTicketIdAiGeneratePostRequest(args [1]string, args
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeTicketsTicketIdAiGeneratePostRequest(r)
-	if err != nil {
-		err = &ogenerrors.DecodeRequestError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeRequest", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
-	defer func() {
-		if err := close(); err != nil {
-			recordError("CloseRequest", err)
-		}
-	}()
 
-	var response TicketsTicketIdAiGeneratePostRes
+	var response TicketsTicketIdNotesNoteIdDeleteRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TicketsTicketIdAiGeneratePostOperation,
-			OperationSummary: "AIによる返信ドラフト生成 (SSE)",
+			OperationName:    TicketsTicketIdNotesNoteIdDeleteOperation,
+			OperationSummary: "ノート削除",
 			OperationID:      "",
-			Body:             request,
+			Body:             nil,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
 					Name: "ticketId",
 					In:   "path",
 				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *TicketsTicketIdAiGeneratePostReq
-			Params   = TicketsTicketIdAiGeneratePostParams
-			Response = TicketsTicketIdAiGeneratePostRes
+			Request  = struct{}
+			Params   = TicketsTicketIdNotesNoteIdDeleteParams
+			Response = TicketsTicketIdNotesNoteIdDeleteRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1306,14 +6321,14 @@ func (s *Server) handleTicketsTicketIdAiGeneratePostRequest(args [1]string, args
 		](
 			m,
 			mreq,
-			unpackTicketsTicketIdAiGeneratePostParams,
+			unpackTicketsTicketIdNotesNoteIdDeleteParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TicketsTicketIdAiGeneratePost(ctx, request, params)
+				response, err = s.h.TicketsTicketIdNotesNoteIdDelete(ctx, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TicketsTicketIdAiGeneratePost(ctx, request, params)
+		response, err = s.h.TicketsTicketIdNotesNoteIdDelete(ctx, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1321,7 +6336,7 @@ func (s *Server) handleTicketsTicketIdAiGeneratePostRequest(args [1]string, args
 		return
 	}
 
-	if err := encodeTicketsTicketIdAiGeneratePostResponse(response, w); err != nil {
+	if err := encodeTicketsTicketIdNotesNoteIdDeleteResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1330,12 +6345,21 @@ func (s *Server) handleTicketsTicketIdAiGeneratePostRequest(args [1]string, args
 	}
 }
 
-// handleTicketsTicketIdNotesNoteIdAiReviewPostRequest handles POST /tickets/{ticketId}/notes/{noteId}/ai/review operation.
+// handleTicketsTicketIdNotesNoteIdPutRequest handles PUT /tickets/{ticketId}/notes/{noteId} operation.
 //
-// 指定されたノートの内容をAIが添削・レビューする.
+// 本文を編集する。ステータスはsubmit/withdraw/send/cancelで変更する。
+// 本文を変更した場合、有効なReviewはすべて無効化(stale)され、Weightに数えられなくなる。
+// 無効化されたReviewのレビュワーにはBotから通知され、レビュー時からの差分を確認できる。
+// waiting_sentのノートの本文を変更した場合、ステータスはwaiting_reviewに戻る。
+// Authorと本職のみ実行可能。
+// 本職以外が伏字 (!!■■■!!)
+// を含む本文を送った場合、伏字は元の本文の伏字部分で順番に置き換えられる。
+// 伏字の数またはカテゴリが元の本文と合わない場合
+// (伏字をすべて消した場合を含む) は409を返す。
+// 送信済み(sent)・破棄済み(canceled)のノートは編集できず、409を返す。.
 //
-// POST /tickets/{ticketId}/notes/{noteId}/ai/review
-func (s *Server) handleTicketsTicketIdNotesNoteIdAiReviewPostRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /tickets/{ticketId}/notes/{noteId}
+func (s *Server) handleTicketsTicketIdNotesNoteIdPutRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1343,7 +6367,7 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdAiReviewPostRequest(args [2]str
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TicketsTicketIdNotesNoteIdAiReviewPostOperation,
+			Name: TicketsTicketIdNotesNoteIdPutOperation,
 			ID:   "",
 		}
 	)
@@ -1351,7 +6375,41 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdAiReviewPostRequest(args [2]str
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, TicketsTicketIdNotesNoteIdAiReviewPostOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, TicketsTicketIdNotesNoteIdPutOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, TicketsTicketIdNotesNoteIdPutOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, TicketsTicketIdNotesNoteIdPutOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1363,7 +6421,7 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdAiReviewPostRequest(args [2]str
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -1372,6 +6430,8 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdAiReviewPostRequest(args [2]str
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1391,7 +6451,7 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdAiReviewPostRequest(args [2]str
 			return
 		}
 	}
-	params, err := decodeTicketsTicketIdNotesNoteIdAiReviewPostParams(args, argsEscaped, r)
+	params, err := decodeTicketsTicketIdNotesNoteIdPutParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1403,15 +6463,30 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdAiReviewPostRequest(args [2]str
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeTicketsTicketIdNotesNoteIdPutRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response TicketsTicketIdNotesNoteIdAiReviewPostRes
+	var response TicketsTicketIdNotesNoteIdPutRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TicketsTicketIdNotesNoteIdAiReviewPostOperation,
-			OperationSummary: "AIによるノート（下書き）のレビュー (SSE)",
+			OperationName:    TicketsTicketIdNotesNoteIdPutOperation,
+			OperationSummary: "ノート編集",
 			OperationID:      "",
-			Body:             nil,
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
@@ -1427,9 +6502,9 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdAiReviewPostRequest(args [2]str
 		}
 
 		type (
-			Request  = struct{}
-			Params   = TicketsTicketIdNotesNoteIdAiReviewPostParams
-			Response = TicketsTicketIdNotesNoteIdAiReviewPostRes
+			Request  = *TicketsTicketIdNotesNoteIdPutReq
+			Params   = TicketsTicketIdNotesNoteIdPutParams
+			Response = TicketsTicketIdNotesNoteIdPutRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1438,14 +6513,14 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdAiReviewPostRequest(args [2]str
 		](
 			m,
 			mreq,
-			unpackTicketsTicketIdNotesNoteIdAiReviewPostParams,
+			unpackTicketsTicketIdNotesNoteIdPutParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TicketsTicketIdNotesNoteIdAiReviewPost(ctx, params)
+				response, err = s.h.TicketsTicketIdNotesNoteIdPut(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TicketsTicketIdNotesNoteIdAiReviewPost(ctx, params)
+		response, err = s.h.TicketsTicketIdNotesNoteIdPut(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1453,7 +6528,7 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdAiReviewPostRequest(args [2]str
 		return
 	}
 
-	if err := encodeTicketsTicketIdNotesNoteIdAiReviewPostResponse(response, w); err != nil {
+	if err := encodeTicketsTicketIdNotesNoteIdPutResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1462,12 +6537,12 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdAiReviewPostRequest(args [2]str
 	}
 }
 
-// handleTicketsTicketIdNotesNoteIdDeleteRequest handles DELETE /tickets/{ticketId}/notes/{noteId} operation.
+// handleTicketsTicketIdNotesPostRequest handles POST /tickets/{ticketId}/notes operation.
 //
-// ノート削除.
+// ノート追加.
 //
-// DELETE /tickets/{ticketId}/notes/{noteId}
-func (s *Server) handleTicketsTicketIdNotesNoteIdDeleteRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// POST /tickets/{ticketId}/notes
+func (s *Server) handleTicketsTicketIdNotesPostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1475,7 +6550,7 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdDeleteRequest(args [2]string, a
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TicketsTicketIdNotesNoteIdDeleteOperation,
+			Name: TicketsTicketIdNotesPostOperation,
 			ID:   "",
 		}
 	)
@@ -1483,7 +6558,41 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdDeleteRequest(args [2]string, a
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, TicketsTicketIdNotesNoteIdDeleteOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, TicketsTicketIdNotesPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, TicketsTicketIdNotesPostOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, TicketsTicketIdNotesPostOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1495,7 +6604,7 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdDeleteRequest(args [2]string, a
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -1504,6 +6613,8 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdDeleteRequest(args [2]string, a
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1523,7 +6634,7 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdDeleteRequest(args [2]string, a
 			return
 		}
 	}
-	params, err := decodeTicketsTicketIdNotesNoteIdDeleteParams(args, argsEscaped, r)
+	params, err := decodeTicketsTicketIdNotesPostParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1535,33 +6646,44 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdDeleteRequest(args [2]string, a
 	}
 
 	var rawBody []byte
+	request, rawBody, close, err := s.decodeTicketsTicketIdNotesPostRequest(r)
+	if err != nil {
+		err = &ogenerrors.DecodeRequestError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeRequest", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+	defer func() {
+		if err := close(); err != nil {
+			recordError("CloseRequest", err)
+		}
+	}()
 
-	var response TicketsTicketIdNotesNoteIdDeleteRes
+	var response TicketsTicketIdNotesPostRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TicketsTicketIdNotesNoteIdDeleteOperation,
-			OperationSummary: "ノート削除",
+			OperationName:    TicketsTicketIdNotesPostOperation,
+			OperationSummary: "ノート追加",
 			OperationID:      "",
-			Body:             nil,
+			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
 					Name: "ticketId",
 					In:   "path",
 				}: params.TicketId,
-				{
-					Name: "noteId",
-					In:   "path",
-				}: params.NoteId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = struct{}
-			Params   = TicketsTicketIdNotesNoteIdDeleteParams
-			Response = TicketsTicketIdNotesNoteIdDeleteRes
+			Request  = *TicketsTicketIdNotesPostReq
+			Params   = TicketsTicketIdNotesPostParams
+			Response = TicketsTicketIdNotesPostRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1570,14 +6692,14 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdDeleteRequest(args [2]string, a
 		](
 			m,
 			mreq,
-			unpackTicketsTicketIdNotesNoteIdDeleteParams,
+			unpackTicketsTicketIdNotesPostParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TicketsTicketIdNotesNoteIdDelete(ctx, params)
+				response, err = s.h.TicketsTicketIdNotesPost(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TicketsTicketIdNotesNoteIdDelete(ctx, params)
+		response, err = s.h.TicketsTicketIdNotesPost(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1585,7 +6707,7 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdDeleteRequest(args [2]string, a
 		return
 	}
 
-	if err := encodeTicketsTicketIdNotesNoteIdDeleteResponse(response, w); err != nil {
+	if err := encodeTicketsTicketIdNotesPostResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1594,13 +6716,12 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdDeleteRequest(args [2]string, a
 	}
 }
 
-// handleTicketsTicketIdNotesNoteIdPutRequest handles PUT /tickets/{ticketId}/notes/{noteId} operation.
+// handleUpdateCensorPolicyRequest handles updateCensorPolicy operation.
 //
-// 送信ノートの編集時、既存のReviewを無効化する(Weightリセット)オプションがある。
-// Authorのみ実行可能。.
+// 本職権限のみ実行可能。すべてのルールを置き換える.
 //
-// PUT /tickets/{ticketId}/notes/{noteId}
-func (s *Server) handleTicketsTicketIdNotesNoteIdPutRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /config/censor-policy
+func (s *Server) handleUpdateCensorPolicyRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1608,15 +6729,49 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdPutRequest(args [2]string, args
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TicketsTicketIdNotesNoteIdPutOperation,
-			ID:   "",
+			Name: UpdateCensorPolicyOperation,
+			ID:   "updateCensorPolicy",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, TicketsTicketIdNotesNoteIdPutOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, UpdateCensorPolicyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateCensorPolicyOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, UpdateCensorPolicyOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1628,7 +6783,7 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdPutRequest(args [2]string, args
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -1637,6 +6792,8 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdPutRequest(args [2]string, args
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1656,19 +6813,9 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdPutRequest(args [2]string, args
 			return
 		}
 	}
-	params, err := decodeTicketsTicketIdNotesNoteIdPutParams(args, argsEscaped, r)
-	if err != nil {
-		err = &ogenerrors.DecodeParamsError{
-			OperationContext: opErrContext,
-			Err:              err,
-		}
-		defer recordError("DecodeParams", err)
-		s.cfg.ErrorHandler(ctx, w, r, err)
-		return
-	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeTicketsTicketIdNotesNoteIdPutRequest(r)
+	request, rawBody, close, err := s.decodeUpdateCensorPolicyRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1684,32 +6831,23 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdPutRequest(args [2]string, args
 		}
 	}()
 
-	var response TicketsTicketIdNotesNoteIdPutRes
+	var response UpdateCensorPolicyRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TicketsTicketIdNotesNoteIdPutOperation,
-			OperationSummary: "ノート編集",
-			OperationID:      "",
+			OperationName:    UpdateCensorPolicyOperation,
+			OperationSummary: "伏字のカテゴリごとの閲覧ルールの更新",
+			OperationID:      "updateCensorPolicy",
 			Body:             request,
 			RawBody:          rawBody,
-			Params: middleware.Parameters{
-				{
-					Name: "ticketId",
-					In:   "path",
-				}: params.TicketId,
-				{
-					Name: "noteId",
-					In:   "path",
-				}: params.NoteId,
-			},
-			Raw: r,
+			Params:           middleware.Parameters{},
+			Raw:              r,
 		}
 
 		type (
-			Request  = *TicketsTicketIdNotesNoteIdPutReq
-			Params   = TicketsTicketIdNotesNoteIdPutParams
-			Response = TicketsTicketIdNotesNoteIdPutRes
+			Request  = []CensorPolicyRule
+			Params   = struct{}
+			Response = UpdateCensorPolicyRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1718,14 +6856,14 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdPutRequest(args [2]string, args
 		](
 			m,
 			mreq,
-			unpackTicketsTicketIdNotesNoteIdPutParams,
+			nil,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TicketsTicketIdNotesNoteIdPut(ctx, request, params)
+				response, err = s.h.UpdateCensorPolicy(ctx, request)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TicketsTicketIdNotesNoteIdPut(ctx, request, params)
+		response, err = s.h.UpdateCensorPolicy(ctx, request)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1733,7 +6871,7 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdPutRequest(args [2]string, args
 		return
 	}
 
-	if err := encodeTicketsTicketIdNotesNoteIdPutResponse(response, w); err != nil {
+	if err := encodeUpdateCensorPolicyResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1742,12 +6880,12 @@ func (s *Server) handleTicketsTicketIdNotesNoteIdPutRequest(args [2]string, args
 	}
 }
 
-// handleTicketsTicketIdNotesPostRequest handles POST /tickets/{ticketId}/notes operation.
+// handleUpdateReviewRequest handles updateReview operation.
 //
-// ノート追加.
+// ReviewのAuthorのみ実行可能。.
 //
-// POST /tickets/{ticketId}/notes
-func (s *Server) handleTicketsTicketIdNotesPostRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PUT /tickets/{ticketId}/notes/{noteId}/reviews/{reviewId}
+func (s *Server) handleUpdateReviewRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1755,15 +6893,49 @@ func (s *Server) handleTicketsTicketIdNotesPostRequest(args [1]string, argsEscap
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: TicketsTicketIdNotesPostOperation,
-			ID:   "",
+			Name: UpdateReviewOperation,
+			ID:   "updateReview",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, TicketsTicketIdNotesPostOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, UpdateReviewOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateReviewOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, UpdateReviewOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1775,7 +6947,7 @@ func (s *Server) handleTicketsTicketIdNotesPostRequest(args [1]string, argsEscap
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -1784,6 +6956,8 @@ func (s *Server) handleTicketsTicketIdNotesPostRequest(args [1]string, argsEscap
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1803,7 +6977,7 @@ func (s *Server) handleTicketsTicketIdNotesPostRequest(args [1]string, argsEscap
 			return
 		}
 	}
-	params, err := decodeTicketsTicketIdNotesPostParams(args, argsEscaped, r)
+	params, err := decodeUpdateReviewParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1815,7 +6989,7 @@ func (s *Server) handleTicketsTicketIdNotesPostRequest(args [1]string, argsEscap
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeTicketsTicketIdNotesPostRequest(r)
+	request, rawBody, close, err := s.decodeUpdateReviewRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1831,13 +7005,13 @@ func (s *Server) handleTicketsTicketIdNotesPostRequest(args [1]string, argsEscap
 		}
 	}()
 
-	var response TicketsTicketIdNotesPostRes
+	var response UpdateReviewRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    TicketsTicketIdNotesPostOperation,
-			OperationSummary: "ノート追加",
-			OperationID:      "",
+			OperationName:    UpdateReviewOperation,
+			OperationSummary: "レビュー修正",
+			OperationID:      "updateReview",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -1845,14 +7019,22 @@ func (s *Server) handleTicketsTicketIdNotesPostRequest(args [1]string, argsEscap
 					Name: "ticketId",
 					In:   "path",
 				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
+				{
+					Name: "reviewId",
+					In:   "path",
+				}: params.ReviewId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = *TicketsTicketIdNotesPostReq
-			Params   = TicketsTicketIdNotesPostParams
-			Response = TicketsTicketIdNotesPostRes
+			Request  = OptUpdateReviewReq
+			Params   = UpdateReviewParams
+			Response = UpdateReviewRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -1861,14 +7043,14 @@ func (s *Server) handleTicketsTicketIdNotesPostRequest(args [1]string, argsEscap
 		](
 			m,
 			mreq,
-			unpackTicketsTicketIdNotesPostParams,
+			unpackUpdateReviewParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.TicketsTicketIdNotesPost(ctx, request, params)
+				response, err = s.h.UpdateReview(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.TicketsTicketIdNotesPost(ctx, request, params)
+		response, err = s.h.UpdateReview(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -1876,7 +7058,7 @@ func (s *Server) handleTicketsTicketIdNotesPostRequest(args [1]string, argsEscap
 		return
 	}
 
-	if err := encodeTicketsTicketIdNotesPostResponse(response, w); err != nil {
+	if err := encodeUpdateReviewResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -1885,12 +7067,18 @@ func (s *Server) handleTicketsTicketIdNotesPostRequest(args [1]string, argsEscap
 	}
 }
 
-// handleUpdateReviewRequest handles updateReview operation.
+// handleUpdateTicketByIDRequest handles updateTicketByID operation.
 //
-// ReviewのAuthorのみ実行可能。.
+// 関係者と渉外のみ実行可能。
+// 公開範囲(visibility)をmanagersに変更できるのは本職のみ。
+// 本職以外が伏字 (!!■■■!!)
+// を含むタイトル・説明を送った場合、伏字は元のテキストの伏字部分で順番に置き換えられる。
+// 伏字の数またはカテゴリが元のテキストと合わない場合
+// (伏字をすべて消した場合を含む) は409を返す。
+// manual_statusがfalseのチケットは、ノートやレビューの変更に応じてステータスが自動で更新される。.
 //
-// PUT /tickets/{ticketId}/notes/{noteId}/reviews/{reviewId}
-func (s *Server) handleUpdateReviewRequest(args [3]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /tickets/{ticketId}
+func (s *Server) handleUpdateTicketByIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -1898,15 +7086,49 @@ func (s *Server) handleUpdateReviewRequest(args [3]string, argsEscaped bool, w h
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateReviewOperation,
-			ID:   "updateReview",
+			Name: UpdateTicketByIDOperation,
+			ID:   "updateTicketByID",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, UpdateReviewOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, UpdateTicketByIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateTicketByIDOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, UpdateTicketByIDOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -1918,7 +7140,7 @@ func (s *Server) handleUpdateReviewRequest(args [3]string, argsEscaped bool, w h
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -1927,6 +7149,8 @@ func (s *Server) handleUpdateReviewRequest(args [3]string, argsEscaped bool, w h
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -1946,7 +7170,7 @@ func (s *Server) handleUpdateReviewRequest(args [3]string, argsEscaped bool, w h
 			return
 		}
 	}
-	params, err := decodeUpdateReviewParams(args, argsEscaped, r)
+	params, err := decodeUpdateTicketByIDParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -1958,7 +7182,7 @@ func (s *Server) handleUpdateReviewRequest(args [3]string, argsEscaped bool, w h
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateReviewRequest(r)
+	request, rawBody, close, err := s.decodeUpdateTicketByIDRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -1974,13 +7198,13 @@ func (s *Server) handleUpdateReviewRequest(args [3]string, argsEscaped bool, w h
 		}
 	}()
 
-	var response UpdateReviewRes
+	var response UpdateTicketByIDRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateReviewOperation,
-			OperationSummary: "レビュー修正",
-			OperationID:      "updateReview",
+			OperationName:    UpdateTicketByIDOperation,
+			OperationSummary: "チケット情報更新",
+			OperationID:      "updateTicketByID",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
@@ -1988,22 +7212,14 @@ func (s *Server) handleUpdateReviewRequest(args [3]string, argsEscaped bool, w h
 					Name: "ticketId",
 					In:   "path",
 				}: params.TicketId,
-				{
-					Name: "noteId",
-					In:   "path",
-				}: params.NoteId,
-				{
-					Name: "reviewId",
-					In:   "path",
-				}: params.ReviewId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = OptUpdateReviewReq
-			Params   = UpdateReviewParams
-			Response = UpdateReviewRes
+			Request  = OptUpdateTicketByIDReq
+			Params   = UpdateTicketByIDParams
+			Response = UpdateTicketByIDRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2012,14 +7228,14 @@ func (s *Server) handleUpdateReviewRequest(args [3]string, argsEscaped bool, w h
 		](
 			m,
 			mreq,
-			unpackUpdateReviewParams,
+			unpackUpdateTicketByIDParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateReview(ctx, request, params)
+				response, err = s.h.UpdateTicketByID(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateReview(ctx, request, params)
+		response, err = s.h.UpdateTicketByID(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2027,7 +7243,7 @@ func (s *Server) handleUpdateReviewRequest(args [3]string, argsEscaped bool, w h
 		return
 	}
 
-	if err := encodeUpdateReviewResponse(response, w); err != nil {
+	if err := encodeUpdateTicketByIDResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2036,12 +7252,12 @@ func (s *Server) handleUpdateReviewRequest(args [3]string, argsEscaped bool, w h
 	}
 }
 
-// handleUpdateTicketByIDRequest handles updateTicketByID operation.
+// handleUpdateUserRequest handles updateUser operation.
 //
-// 関係者と渉外のみ実行可能。.
+// Manager(本職)権限のみ。.
 //
-// PATCH /tickets/{ticketId}
-func (s *Server) handleUpdateTicketByIDRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+// PATCH /users/{traqId}
+func (s *Server) handleUpdateUserRequest(args [1]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
 	statusWriter := &codeRecorder{ResponseWriter: w}
 	w = statusWriter
 	ctx := r.Context()
@@ -2049,15 +7265,49 @@ func (s *Server) handleUpdateTicketByIDRequest(args [1]string, argsEscaped bool,
 	var (
 		err          error
 		opErrContext = ogenerrors.OperationContext{
-			Name: UpdateTicketByIDOperation,
-			ID:   "updateTicketByID",
+			Name: UpdateUserOperation,
+			ID:   "updateUser",
 		}
 	)
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
 		{
-			sctx, ok, err := s.securityTraQAuth(ctx, UpdateTicketByIDOperation, r)
+			sctx, ok, err := s.securitySessionAuth(ctx, UpdateUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UpdateUserOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, UpdateUserOperation, r)
 			if err != nil {
 				err = &ogenerrors.SecurityError{
 					OperationContext: opErrContext,
@@ -2069,7 +7319,7 @@ func (s *Server) handleUpdateTicketByIDRequest(args [1]string, argsEscaped bool,
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -2078,6 +7328,8 @@ func (s *Server) handleUpdateTicketByIDRequest(args [1]string, argsEscaped bool,
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -2097,7 +7349,7 @@ func (s *Server) handleUpdateTicketByIDRequest(args [1]string, argsEscaped bool,
 			return
 		}
 	}
-	params, err := decodeUpdateTicketByIDParams(args, argsEscaped, r)
+	params, err := decodeUpdateUserParams(args, argsEscaped, r)
 	if err != nil {
 		err = &ogenerrors.DecodeParamsError{
 			OperationContext: opErrContext,
@@ -2109,7 +7361,7 @@ func (s *Server) handleUpdateTicketByIDRequest(args [1]string, argsEscaped bool,
 	}
 
 	var rawBody []byte
-	request, rawBody, close, err := s.decodeUpdateTicketByIDRequest(r)
+	request, rawBody, close, err := s.decodeUpdateUserRequest(r)
 	if err != nil {
 		err = &ogenerrors.DecodeRequestError{
 			OperationContext: opErrContext,
@@ -2125,28 +7377,28 @@ func (s *Server) handleUpdateTicketByIDRequest(args [1]string, argsEscaped bool,
 		}
 	}()
 
-	var response UpdateTicketByIDRes
+	var response UpdateUserRes
 	if m := s.cfg.Middleware; m != nil {
 		mreq := middleware.Request{
 			Context:          ctx,
-			OperationName:    UpdateTicketByIDOperation,
-			OperationSummary: "チケット情報更新",
-			OperationID:      "updateTicketByID",
+			OperationName:    UpdateUserOperation,
+			OperationSummary: "ユーザーのロール変更",
+			OperationID:      "updateUser",
 			Body:             request,
 			RawBody:          rawBody,
 			Params: middleware.Parameters{
 				{
-					Name: "ticketId",
+					Name: "traqId",
 					In:   "path",
-				}: params.TicketId,
+				}: params.TraqId,
 			},
 			Raw: r,
 		}
 
 		type (
-			Request  = OptUpdateTicketByIDReq
-			Params   = UpdateTicketByIDParams
-			Response = UpdateTicketByIDRes
+			Request  = *UpdateUserReq
+			Params   = UpdateUserParams
+			Response = UpdateUserRes
 		)
 		response, err = middleware.HookMiddleware[
 			Request,
@@ -2155,14 +7407,14 @@ func (s *Server) handleUpdateTicketByIDRequest(args [1]string, argsEscaped bool,
 		](
 			m,
 			mreq,
-			unpackUpdateTicketByIDParams,
+			unpackUpdateUserParams,
 			func(ctx context.Context, request Request, params Params) (response Response, err error) {
-				response, err = s.h.UpdateTicketByID(ctx, request, params)
+				response, err = s.h.UpdateUser(ctx, request, params)
 				return response, err
 			},
 		)
 	} else {
-		response, err = s.h.UpdateTicketByID(ctx, request, params)
+		response, err = s.h.UpdateUser(ctx, request, params)
 	}
 	if err != nil {
 		defer recordError("Internal", err)
@@ -2170,7 +7422,7 @@ func (s *Server) handleUpdateTicketByIDRequest(args [1]string, argsEscaped bool,
 		return
 	}
 
-	if err := encodeUpdateTicketByIDResponse(response, w); err != nil {
+	if err := encodeUpdateUserResponse(response, w); err != nil {
 		defer recordError("EncodeResponse", err)
 		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
 			s.cfg.ErrorHandler(ctx, w, r, err)
@@ -2199,6 +7451,40 @@ func (s *Server) handleUsersGetRequest(args [0]string, argsEscaped bool, w http.
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, UsersGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UsersGetOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 		{
 			sctx, ok, err := s.securityTraQAuth(ctx, UsersGetOperation, r)
 			if err != nil {
@@ -2212,7 +7498,7 @@ func (s *Server) handleUsersGetRequest(args [0]string, argsEscaped bool, w http.
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -2221,6 +7507,8 @@ func (s *Server) handleUsersGetRequest(args [0]string, argsEscaped bool, w http.
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -2294,7 +7582,10 @@ func (s *Server) handleUsersGetRequest(args [0]string, argsEscaped bool, w http.
 
 // handleUsersPutRequest handles PUT /users operation.
 //
-// Manager(本職)権限のみ。リクエストボディの内容でユーザー情報を一括更新・同期する。.
+// Manager(本職)権限のみ。リクエストボディの内容でユーザー情報を一括更新・同期する。
+// リクエストボディにないユーザーは削除される。適用した変更をtraQ
+// ID順に返す。
+// 本職が1人も登録されていない場合 (初期設定時) は誰でも実行できる。.
 //
 // PUT /users
 func (s *Server) handleUsersPutRequest(args [0]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
@@ -2312,6 +7603,40 @@ func (s *Server) handleUsersPutRequest(args [0]string, argsEscaped bool, w http.
 	{
 		type bitset = [1]uint8
 		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, UsersPutOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, UsersPutOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
 		{
 			sctx, ok, err := s.securityTraQAuth(ctx, UsersPutOperation, r)
 			if err != nil {
@@ -2325,7 +7650,7 @@ func (s *Server) handleUsersPutRequest(args [0]string, argsEscaped bool, w http.
 				return
 			}
 			if ok {
-				satisfied[0] |= 1 << 0
+				satisfied[0] |= 1 << 2
 				ctx = sctx
 			}
 		}
@@ -2334,6 +7659,8 @@ func (s *Server) handleUsersPutRequest(args [0]string, argsEscaped bool, w http.
 		nextRequirement:
 			for _, requirement := range []bitset{
 				{0b00000001},
+				{0b00000010},
+				{0b00000100},
 			} {
 				for i, mask := range requirement {
 					if satisfied[i]&mask != mask {
@@ -2419,3 +7746,173 @@ func (s *Server) handleUsersPutRequest(args [0]string, argsEscaped bool, w http.
 		return
 	}
 }
+
+// handleWithdrawNoteRequest handles withdrawNote operation.
+//
+// 添削待ち・送信待ちの発信ノートを下書き(draft)に戻し、submitted_atをnullにする。
+// チケットの担当者・副担当者とレビューを依頼したユーザーにBotから通知する。
+// Authorと本職のみ実行可能。.
+//
+// POST /tickets/{ticketId}/notes/{noteId}/withdraw
+func (s *Server) handleWithdrawNoteRequest(args [2]string, argsEscaped bool, w http.ResponseWriter, r *http.Request) {
+	statusWriter := &codeRecorder{ResponseWriter: w}
+	w = statusWriter
+	ctx := r.Context()
+
+	var (
+		err          error
+		opErrContext = ogenerrors.OperationContext{
+			Name: WithdrawNoteOperation,
+			ID:   "withdrawNote",
+		}
+	)
+	{
+		type bitset = [1]uint8
+		var satisfied bitset
+		{
+			sctx, ok, err := s.securitySessionAuth(ctx, WithdrawNoteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "SessionAuth",
+					Err:              err,
+				}
+				defer recordError("Security:SessionAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 0
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityBearerAuth(ctx, WithdrawNoteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "BearerAuth",
+					Err:              err,
+				}
+				defer recordError("Security:BearerAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 1
+				ctx = sctx
+			}
+		}
+		{
+			sctx, ok, err := s.securityTraQAuth(ctx, WithdrawNoteOperation, r)
+			if err != nil {
+				err = &ogenerrors.SecurityError{
+					OperationContext: opErrContext,
+					Security:         "TraQAuth",
+					Err:              err,
+				}
+				defer recordError("Security:TraQAuth", err)
+				s.cfg.ErrorHandler(ctx, w, r, err)
+				return
+			}
+			if ok {
+				satisfied[0] |= 1 << 2
+				ctx = sctx
+			}
+		}
+
+		if ok := func() bool {
+		nextRequirement:
+			for _, requirement := range []bitset{
+				{0b00000001},
+				{0b00000010},
+				{0b00000100},
+			} {
+				for i, mask := range requirement {
+					if satisfied[i]&mask != mask {
+						continue nextRequirement
+					}
+				}
+				return true
+			}
+			return false
+		}(); !ok {
+			err = &ogenerrors.SecurityError{
+				OperationContext: opErrContext,
+				Err:              ogenerrors.ErrSecurityRequirementIsNotSatisfied,
+			}
+			defer recordError("Security", err)
+			s.cfg.ErrorHandler(ctx, w, r, err)
+			return
+		}
+	}
+	params, err := decodeWithdrawNoteParams(args, argsEscaped, r)
+	if err != nil {
+		err = &ogenerrors.DecodeParamsError{
+			OperationContext: opErrContext,
+			Err:              err,
+		}
+		defer recordError("DecodeParams", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	var rawBody []byte
+
+	var response WithdrawNoteRes
+	if m := s.cfg.Middleware; m != nil {
+		mreq := middleware.Request{
+			Context:          ctx,
+			OperationName:    WithdrawNoteOperation,
+			OperationSummary: "ノートを下書きに戻す",
+			OperationID:      "withdrawNote",
+			Body:             nil,
+			RawBody:          rawBody,
+			Params: middleware.Parameters{
+				{
+					Name: "ticketId",
+					In:   "path",
+				}: params.TicketId,
+				{
+					Name: "noteId",
+					In:   "path",
+				}: params.NoteId,
+			},
+			Raw: r,
+		}
+
+		type (
+			Request  = struct{}
+			Params   = WithdrawNoteParams
+			Response = WithdrawNoteRes
+		)
+		response, err = middleware.HookMiddleware[
+			Request,
+			Params,
+			Response,
+		](
+			m,
+			mreq,
+			unpackWithdrawNoteParams,
+			func(ctx context.Context, request Request, params Params) (response Response, err error) {
+				response, err = s.h.WithdrawNote(ctx, params)
+				return response, err
+			},
+		)
+	} else {
+		response, err = s.h.WithdrawNote(ctx, params)
+	}
+	if err != nil {
+		defer recordError("Internal", err)
+		s.cfg.ErrorHandler(ctx, w, r, err)
+		return
+	}
+
+	if err := encodeWithdrawNoteResponse(response, w); err != nil {
+		defer recordError("EncodeResponse", err)
+		if !errors.Is(err, ht.ErrInternalServerErrorResponse) {
+			s.cfg.ErrorHandler(ctx, w, r, err)
+		}
+		return
+	}
+}
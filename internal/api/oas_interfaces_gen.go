@@ -1,6 +1,14 @@
 // Code generated by ogen, DO NOT EDIT.
 package api
 
+type AuthCallbackRes interface {
+	authCallbackRes()
+}
+
+type CancelNoteRes interface {
+	cancelNoteRes()
+}
+
 type ConfigGetRes interface {
 	configGetRes()
 }
@@ -9,6 +17,10 @@ type ConfigPostRes interface {
 	configPostRes()
 }
 
+type CreateMyTokenRes interface {
+	createMyTokenRes()
+}
+
 type CreateReviewRes interface {
 	createReviewRes()
 }
@@ -17,6 +29,14 @@ type CreateTicketRes interface {
 	createTicketRes()
 }
 
+type CreateUserRes interface {
+	createUserRes()
+}
+
+type DeleteMyTokenRes interface {
+	deleteMyTokenRes()
+}
+
 type DeleteReviewRes interface {
 	deleteReviewRes()
 }
@@ -25,18 +45,106 @@ type DeleteTicketByIDRes interface {
 	deleteTicketByIDRes()
 }
 
+type DeleteUserRes interface {
+	deleteUserRes()
+}
+
+type GetCensorPolicyRes interface {
+	getCensorPolicyRes()
+}
+
+type GetMyTokensRes interface {
+	getMyTokensRes()
+}
+
+type GetNoteRevisionDiffRes interface {
+	getNoteRevisionDiffRes()
+}
+
+type GetNoteRevisionsRes interface {
+	getNoteRevisionsRes()
+}
+
+type GetReviewDiffRes interface {
+	getReviewDiffRes()
+}
+
+type GetRoleChangesRes interface {
+	getRoleChangesRes()
+}
+
 type GetTicketByIDRes interface {
 	getTicketByIDRes()
 }
 
+type GetTicketHistoryRes interface {
+	getTicketHistoryRes()
+}
+
+type GetTicketTransitionsRes interface {
+	getTicketTransitionsRes()
+}
+
 type GetTicketsRes interface {
 	getTicketsRes()
 }
 
+type GetTrashedTicketsRes interface {
+	getTrashedTicketsRes()
+}
+
+type GetUncensoredViewsRes interface {
+	getUncensoredViewsRes()
+}
+
+type GetUserRes interface {
+	getUserRes()
+}
+
+type LoginRes interface {
+	loginRes()
+}
+
+type LogoutRes interface {
+	logoutRes()
+}
+
+type MarkNoteSentRes interface {
+	markNoteSentRes()
+}
+
 type MeGetRes interface {
 	meGetRes()
 }
 
+type PurgeTicketRes interface {
+	purgeTicketRes()
+}
+
+type RequestReviewsRes interface {
+	requestReviewsRes()
+}
+
+type RestoreTicketRes interface {
+	restoreTicketRes()
+}
+
+type ScanPIIRes interface {
+	scanPIIRes()
+}
+
+type SearchRes interface {
+	searchRes()
+}
+
+type SubmitNoteRes interface {
+	submitNoteRes()
+}
+
+type SyncUsersRes interface {
+	syncUsersRes()
+}
+
 type TicketsTicketIdAiGeneratePostRes interface {
 	ticketsTicketIdAiGeneratePostRes()
 }
@@ -57,6 +165,10 @@ type TicketsTicketIdNotesPostRes interface {
 	ticketsTicketIdNotesPostRes()
 }
 
+type UpdateCensorPolicyRes interface {
+	updateCensorPolicyRes()
+}
+
 type UpdateReviewRes interface {
 	updateReviewRes()
 }
@@ -65,6 +177,10 @@ type UpdateTicketByIDRes interface {
 	updateTicketByIDRes()
 }
 
+type UpdateUserRes interface {
+	updateUserRes()
+}
+
 type UsersGetRes interface {
 	usersGetRes()
 }
@@ -72,3 +188,7 @@ type UsersGetRes interface {
 type UsersPutRes interface {
 	usersPutRes()
 }
+
+type WithdrawNoteRes interface {
+	withdrawNoteRes()
+}
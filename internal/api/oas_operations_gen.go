@@ -6,22 +6,52 @@ package api
 type OperationName = string
 
 const (
+	AuthCallbackOperation                           OperationName = "AuthCallback"
+	CancelNoteOperation                             OperationName = "CancelNote"
 	ConfigGetOperation                              OperationName = "ConfigGet"
 	ConfigPostOperation                             OperationName = "ConfigPost"
+	CreateMyTokenOperation                          OperationName = "CreateMyToken"
 	CreateReviewOperation                           OperationName = "CreateReview"
 	CreateTicketOperation                           OperationName = "CreateTicket"
+	CreateUserOperation                             OperationName = "CreateUser"
+	DeleteMyTokenOperation                          OperationName = "DeleteMyToken"
 	DeleteReviewOperation                           OperationName = "DeleteReview"
 	DeleteTicketByIDOperation                       OperationName = "DeleteTicketByID"
+	DeleteUserOperation                             OperationName = "DeleteUser"
+	GetCensorPolicyOperation                        OperationName = "GetCensorPolicy"
+	GetMyTokensOperation                            OperationName = "GetMyTokens"
+	GetNoteRevisionDiffOperation                    OperationName = "GetNoteRevisionDiff"
+	GetNoteRevisionsOperation                       OperationName = "GetNoteRevisions"
+	GetReviewDiffOperation                          OperationName = "GetReviewDiff"
+	GetRoleChangesOperation                         OperationName = "GetRoleChanges"
 	GetTicketByIDOperation                          OperationName = "GetTicketByID"
+	GetTicketHistoryOperation                       OperationName = "GetTicketHistory"
+	GetTicketTransitionsOperation                   OperationName = "GetTicketTransitions"
 	GetTicketsOperation                             OperationName = "GetTickets"
+	GetTrashedTicketsOperation                      OperationName = "GetTrashedTickets"
+	GetUncensoredViewsOperation                     OperationName = "GetUncensoredViews"
+	GetUserOperation                                OperationName = "GetUser"
+	LoginOperation                                  OperationName = "Login"
+	LogoutOperation                                 OperationName = "Logout"
+	MarkNoteSentOperation                           OperationName = "MarkNoteSent"
 	MeGetOperation                                  OperationName = "MeGet"
+	PurgeTicketOperation                            OperationName = "PurgeTicket"
+	RequestReviewsOperation                         OperationName = "RequestReviews"
+	RestoreTicketOperation                          OperationName = "RestoreTicket"
+	ScanPIIOperation                                OperationName = "ScanPII"
+	SearchOperation                                 OperationName = "Search"
+	SubmitNoteOperation                             OperationName = "SubmitNote"
+	SyncUsersOperation                              OperationName = "SyncUsers"
 	TicketsTicketIdAiGeneratePostOperation          OperationName = "TicketsTicketIdAiGeneratePost"
 	TicketsTicketIdNotesNoteIdAiReviewPostOperation OperationName = "TicketsTicketIdNotesNoteIdAiReviewPost"
 	TicketsTicketIdNotesNoteIdDeleteOperation       OperationName = "TicketsTicketIdNotesNoteIdDelete"
 	TicketsTicketIdNotesNoteIdPutOperation          OperationName = "TicketsTicketIdNotesNoteIdPut"
 	TicketsTicketIdNotesPostOperation               OperationName = "TicketsTicketIdNotesPost"
+	UpdateCensorPolicyOperation                     OperationName = "UpdateCensorPolicy"
 	UpdateReviewOperation                           OperationName = "UpdateReview"
 	UpdateTicketByIDOperation                       OperationName = "UpdateTicketByID"
+	UpdateUserOperation                             OperationName = "UpdateUser"
 	UsersGetOperation                               OperationName = "UsersGet"
 	UsersPutOperation                               OperationName = "UsersPut"
+	WithdrawNoteOperation                           OperationName = "WithdrawNote"
 )
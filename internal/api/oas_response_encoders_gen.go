@@ -8,33 +8,60 @@ import (
 
 	"github.com/go-faster/errors"
 	"github.com/go-faster/jx"
+	"github.com/ogen-go/ogen/conv"
 	ht "github.com/ogen-go/ogen/http"
+	"github.com/ogen-go/ogen/uri"
 )
 
-func encodeConfigGetResponse(response ConfigGetRes, w http.ResponseWriter) error {
+func encodeAuthCallbackResponse(response AuthCallbackRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *Config:
-		if err := func() error {
-			if err := response.Validate(); err != nil {
-				return err
+	case *AuthCallbackFound:
+		w.Header().Set("Access-Control-Expose-Headers", "Location,Set-Cookie")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Location" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Location",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.Location.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Location header")
+				}
+			}
+			// Encode "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.SetCookie.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Set-Cookie header")
+				}
 			}
-			return nil
-		}(); err != nil {
-			return errors.Wrap(err, "validate")
 		}
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
+		w.WriteHeader(302)
 
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
-		}
+		return nil
+
+	case *AuthCallbackBadRequest:
+		w.WriteHeader(400)
 
 		return nil
 
-	case *ConfigGetForbidden:
-		w.WriteHeader(403)
+	case *AuthCallbackUnauthorized:
+		w.WriteHeader(401)
 
 		return nil
 
@@ -63,9 +90,9 @@ func encodeConfigGetResponse(response ConfigGetRes, w http.ResponseWriter) error
 	}
 }
 
-func encodeConfigPostResponse(response ConfigPostRes, w http.ResponseWriter) error {
+func encodeCancelNoteResponse(response CancelNoteRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *Config:
+	case *Note:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -85,11 +112,21 @@ func encodeConfigPostResponse(response ConfigPostRes, w http.ResponseWriter) err
 
 		return nil
 
-	case *ConfigPostForbidden:
+	case *CancelNoteForbidden:
 		w.WriteHeader(403)
 
 		return nil
 
+	case *CancelNoteNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *CancelNoteConflict:
+		w.WriteHeader(409)
+
+		return nil
+
 	case *ErrorResponseStatusCode:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		code := response.StatusCode
@@ -115,9 +152,9 @@ func encodeConfigPostResponse(response ConfigPostRes, w http.ResponseWriter) err
 	}
 }
 
-func encodeCreateReviewResponse(response CreateReviewRes, w http.ResponseWriter) error {
+func encodeConfigGetResponse(response ConfigGetRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *Review:
+	case *Config:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -127,7 +164,7 @@ func encodeCreateReviewResponse(response CreateReviewRes, w http.ResponseWriter)
 			return errors.Wrap(err, "validate")
 		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(201)
+		w.WriteHeader(200)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -137,18 +174,8 @@ func encodeCreateReviewResponse(response CreateReviewRes, w http.ResponseWriter)
 
 		return nil
 
-	case *CreateReviewBadRequest:
-		w.WriteHeader(400)
-
-		return nil
-
-	case *CreateReviewNotFound:
-		w.WriteHeader(404)
-
-		return nil
-
-	case *CreateReviewConflict:
-		w.WriteHeader(409)
+	case *ConfigGetForbidden:
+		w.WriteHeader(403)
 
 		return nil
 
@@ -177,9 +204,9 @@ func encodeCreateReviewResponse(response CreateReviewRes, w http.ResponseWriter)
 	}
 }
 
-func encodeCreateTicketResponse(response CreateTicketRes, w http.ResponseWriter) error {
+func encodeConfigPostResponse(response ConfigPostRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *Ticket:
+	case *Config:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -189,7 +216,7 @@ func encodeCreateTicketResponse(response CreateTicketRes, w http.ResponseWriter)
 			return errors.Wrap(err, "validate")
 		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(201)
+		w.WriteHeader(200)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -199,17 +226,12 @@ func encodeCreateTicketResponse(response CreateTicketRes, w http.ResponseWriter)
 
 		return nil
 
-	case *CreateTicketBadRequest:
+	case *ConfigPostBadRequest:
 		w.WriteHeader(400)
 
 		return nil
 
-	case *CreateTicketUnauthorized:
-		w.WriteHeader(401)
-
-		return nil
-
-	case *CreateTicketForbidden:
+	case *ConfigPostForbidden:
 		w.WriteHeader(403)
 
 		return nil
@@ -239,20 +261,30 @@ func encodeCreateTicketResponse(response CreateTicketRes, w http.ResponseWriter)
 	}
 }
 
-func encodeDeleteReviewResponse(response DeleteReviewRes, w http.ResponseWriter) error {
+func encodeCreateMyTokenResponse(response CreateMyTokenRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *DeleteReviewNoContent:
-		w.WriteHeader(204)
-
-		return nil
+	case *CreatedAPIToken:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(201)
 
-	case *DeleteReviewForbidden:
-		w.WriteHeader(403)
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
 		return nil
 
-	case *DeleteReviewNotFound:
-		w.WriteHeader(404)
+	case *CreateMyTokenBadRequest:
+		w.WriteHeader(400)
 
 		return nil
 
@@ -281,28 +313,48 @@ func encodeDeleteReviewResponse(response DeleteReviewRes, w http.ResponseWriter)
 	}
 }
 
-func encodeDeleteTicketByIDResponse(response DeleteTicketByIDRes, w http.ResponseWriter) error {
+func encodeCreateReviewResponse(response CreateReviewRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *DeleteTicketByIDNoContent:
-		w.WriteHeader(204)
+	case *Review:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(201)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
 		return nil
 
-	case *DeleteTicketByIDUnauthorized:
-		w.WriteHeader(401)
+	case *CreateReviewBadRequest:
+		w.WriteHeader(400)
 
 		return nil
 
-	case *DeleteTicketByIDForbidden:
+	case *CreateReviewForbidden:
 		w.WriteHeader(403)
 
 		return nil
 
-	case *DeleteTicketByIDNotFound:
+	case *CreateReviewNotFound:
 		w.WriteHeader(404)
 
 		return nil
 
+	case *CreateReviewConflict:
+		w.WriteHeader(409)
+
+		return nil
+
 	case *ErrorResponseStatusCode:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		code := response.StatusCode
@@ -328,9 +380,9 @@ func encodeDeleteTicketByIDResponse(response DeleteTicketByIDRes, w http.Respons
 	}
 }
 
-func encodeGetTicketByIDResponse(response GetTicketByIDRes, w http.ResponseWriter) error {
+func encodeCreateTicketResponse(response CreateTicketRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *GetTicketByIDOK:
+	case *Ticket:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -340,7 +392,7 @@ func encodeGetTicketByIDResponse(response GetTicketByIDRes, w http.ResponseWrite
 			return errors.Wrap(err, "validate")
 		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
+		w.WriteHeader(201)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -350,13 +402,18 @@ func encodeGetTicketByIDResponse(response GetTicketByIDRes, w http.ResponseWrite
 
 		return nil
 
-	case *GetTicketByIDUnauthorized:
+	case *CreateTicketBadRequest:
+		w.WriteHeader(400)
+
+		return nil
+
+	case *CreateTicketUnauthorized:
 		w.WriteHeader(401)
 
 		return nil
 
-	case *GetTicketByIDNotFound:
-		w.WriteHeader(404)
+	case *CreateTicketForbidden:
+		w.WriteHeader(403)
 
 		return nil
 
@@ -385,9 +442,9 @@ func encodeGetTicketByIDResponse(response GetTicketByIDRes, w http.ResponseWrite
 	}
 }
 
-func encodeGetTicketsResponse(response GetTicketsRes, w http.ResponseWriter) error {
+func encodeCreateUserResponse(response CreateUserRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *GetTicketsOKApplicationJSON:
+	case *User:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -397,7 +454,7 @@ func encodeGetTicketsResponse(response GetTicketsRes, w http.ResponseWriter) err
 			return errors.Wrap(err, "validate")
 		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
+		w.WriteHeader(201)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -407,13 +464,13 @@ func encodeGetTicketsResponse(response GetTicketsRes, w http.ResponseWriter) err
 
 		return nil
 
-	case *GetTicketsBadRequest:
-		w.WriteHeader(400)
+	case *CreateUserForbidden:
+		w.WriteHeader(403)
 
 		return nil
 
-	case *GetTicketsUnauthorized:
-		w.WriteHeader(401)
+	case *CreateUserConflict:
+		w.WriteHeader(409)
 
 		return nil
 
@@ -442,22 +499,15 @@ func encodeGetTicketsResponse(response GetTicketsRes, w http.ResponseWriter) err
 	}
 }
 
-func encodeMeGetResponse(response MeGetRes, w http.ResponseWriter) error {
+func encodeDeleteMyTokenResponse(response DeleteMyTokenRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *MeGetOK:
-		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(200)
-
-		e := new(jx.Encoder)
-		response.Encode(e)
-		if _, err := e.WriteTo(w); err != nil {
-			return errors.Wrap(err, "write")
-		}
+	case *DeleteMyTokenNoContent:
+		w.WriteHeader(204)
 
 		return nil
 
-	case *MeGetUnauthorized:
-		w.WriteHeader(401)
+	case *DeleteMyTokenNotFound:
+		w.WriteHeader(404)
 
 		return nil
 
@@ -486,30 +536,41 @@ func encodeMeGetResponse(response MeGetRes, w http.ResponseWriter) error {
 	}
 }
 
-func encodeTicketsTicketIdAiGeneratePostResponse(response TicketsTicketIdAiGeneratePostRes, w http.ResponseWriter) error {
+func encodeDeleteReviewResponse(response DeleteReviewRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *TicketsTicketIdAiGeneratePostOK:
-		w.Header().Set("Content-Type", "text/event-stream")
-		w.WriteHeader(200)
+	case *DeleteReviewNoContent:
+		w.WriteHeader(204)
 
-		writer := w
-		if closer, ok := response.Data.(io.Closer); ok {
-			defer closer.Close()
-		}
-		if _, err := io.Copy(writer, response); err != nil {
-			return errors.Wrap(err, "write")
-		}
+		return nil
+
+	case *DeleteReviewForbidden:
+		w.WriteHeader(403)
 
 		return nil
 
-	case *TicketsTicketIdAiGeneratePostNotFound:
+	case *DeleteReviewNotFound:
 		w.WriteHeader(404)
 
 		return nil
 
-	case *TicketsTicketIdAiGeneratePostInternalServerError:
-		w.WriteHeader(500)
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
 
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
 		return nil
 
 	default:
@@ -517,41 +578,25 @@ func encodeTicketsTicketIdAiGeneratePostResponse(response TicketsTicketIdAiGener
 	}
 }
 
-func encodeTicketsTicketIdNotesNoteIdAiReviewPostResponse(response TicketsTicketIdNotesNoteIdAiReviewPostRes, w http.ResponseWriter) error {
+func encodeDeleteTicketByIDResponse(response DeleteTicketByIDRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *TicketsTicketIdNotesNoteIdAiReviewPostOK:
-		w.Header().Set("Content-Type", "text/event-stream")
-		w.WriteHeader(200)
-
-		writer := w
-		if closer, ok := response.Data.(io.Closer); ok {
-			defer closer.Close()
-		}
-		if _, err := io.Copy(writer, response); err != nil {
-			return errors.Wrap(err, "write")
-		}
+	case *DeleteTicketByIDNoContent:
+		w.WriteHeader(204)
 
 		return nil
 
-	case *TicketsTicketIdNotesNoteIdAiReviewPostNotFound:
-		w.WriteHeader(404)
+	case *DeleteTicketByIDUnauthorized:
+		w.WriteHeader(401)
 
 		return nil
 
-	case *TicketsTicketIdNotesNoteIdAiReviewPostInternalServerError:
-		w.WriteHeader(500)
+	case *DeleteTicketByIDForbidden:
+		w.WriteHeader(403)
 
 		return nil
 
-	default:
-		return errors.Errorf("unexpected response type: %T", response)
-	}
-}
-
-func encodeTicketsTicketIdNotesNoteIdDeleteResponse(response TicketsTicketIdNotesNoteIdDeleteRes, w http.ResponseWriter) error {
-	switch response := response.(type) {
-	case *TicketsTicketIdNotesNoteIdDeleteNoContent:
-		w.WriteHeader(204)
+	case *DeleteTicketByIDNotFound:
+		w.WriteHeader(404)
 
 		return nil
 
@@ -580,18 +625,28 @@ func encodeTicketsTicketIdNotesNoteIdDeleteResponse(response TicketsTicketIdNote
 	}
 }
 
-func encodeTicketsTicketIdNotesNoteIdPutResponse(response TicketsTicketIdNotesNoteIdPutRes, w http.ResponseWriter) error {
+func encodeDeleteUserResponse(response DeleteUserRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *TicketsTicketIdNotesNoteIdPutOK:
-		w.WriteHeader(200)
+	case *DeleteUserNoContent:
+		w.WriteHeader(204)
 
 		return nil
 
-	case *TicketsTicketIdNotesNoteIdPutForbidden:
+	case *DeleteUserForbidden:
 		w.WriteHeader(403)
 
 		return nil
 
+	case *DeleteUserNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *DeleteUserConflict:
+		w.WriteHeader(409)
+
+		return nil
+
 	case *ErrorResponseStatusCode:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		code := response.StatusCode
@@ -617,9 +672,9 @@ func encodeTicketsTicketIdNotesNoteIdPutResponse(response TicketsTicketIdNotesNo
 	}
 }
 
-func encodeTicketsTicketIdNotesPostResponse(response TicketsTicketIdNotesPostRes, w http.ResponseWriter) error {
+func encodeGetCensorPolicyResponse(response GetCensorPolicyRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *Note:
+	case *GetCensorPolicyOKApplicationJSON:
 		if err := func() error {
 			if err := response.Validate(); err != nil {
 				return err
@@ -629,7 +684,7 @@ func encodeTicketsTicketIdNotesPostResponse(response TicketsTicketIdNotesPostRes
 			return errors.Wrap(err, "validate")
 		}
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
-		w.WriteHeader(201)
+		w.WriteHeader(200)
 
 		e := new(jx.Encoder)
 		response.Encode(e)
@@ -639,7 +694,1623 @@ func encodeTicketsTicketIdNotesPostResponse(response TicketsTicketIdNotesPostRes
 
 		return nil
 
-	case *ErrorResponseStatusCode:
+	case *GetCensorPolicyForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetMyTokensResponse(response GetMyTokensRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *GetMyTokensOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetNoteRevisionDiffResponse(response GetNoteRevisionDiffRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *NoteRevisionDiff:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetNoteRevisionDiffNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetNoteRevisionsResponse(response GetNoteRevisionsRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *GetNoteRevisionsOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetNoteRevisionsNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetReviewDiffResponse(response GetReviewDiffRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *ReviewDiff:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetReviewDiffNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetRoleChangesResponse(response GetRoleChangesRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *GetRoleChangesOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetRoleChangesForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetTicketByIDResponse(response GetTicketByIDRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *GetTicketByIDOK:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetTicketByIDUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetTicketByIDNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetTicketHistoryResponse(response GetTicketHistoryRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *GetTicketHistoryOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetTicketHistoryUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetTicketHistoryNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetTicketTransitionsResponse(response GetTicketTransitionsRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *TicketTransitions:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetTicketTransitionsUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetTicketTransitionsNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetTicketsResponse(response GetTicketsRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *GetTicketsOKHeaders:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("Access-Control-Expose-Headers", "X-Next-Cursor,X-Total-Count")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "X-Next-Cursor" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Next-Cursor",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.XNextCursor.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Next-Cursor header")
+				}
+			}
+			// Encode "X-Total-Count" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "X-Total-Count",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					return e.EncodeValue(conv.IntToString(response.XTotalCount))
+				}); err != nil {
+					return errors.Wrap(err, "encode X-Total-Count header")
+				}
+			}
+		}
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		e.ArrStart()
+		for _, elem := range response.Response {
+			elem.Encode(e)
+		}
+		e.ArrEnd()
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetTicketsBadRequest:
+		w.WriteHeader(400)
+
+		return nil
+
+	case *GetTicketsUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetTrashedTicketsResponse(response GetTrashedTicketsRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *GetTrashedTicketsOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetTrashedTicketsUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *GetTrashedTicketsForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetUncensoredViewsResponse(response GetUncensoredViewsRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *GetUncensoredViewsOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetUncensoredViewsForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeGetUserResponse(response GetUserRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *UserProfile:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *GetUserNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeLoginResponse(response LoginRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *LoginFound:
+		w.Header().Set("Access-Control-Expose-Headers", "Location,Set-Cookie")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Location" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Location",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.Location.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Location header")
+				}
+			}
+			// Encode "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.SetCookie.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Set-Cookie header")
+				}
+			}
+		}
+		w.WriteHeader(302)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeLogoutResponse(response LogoutRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *LogoutNoContent:
+		w.Header().Set("Access-Control-Expose-Headers", "Set-Cookie")
+		// Encoding response headers.
+		{
+			h := uri.NewHeaderEncoder(w.Header())
+			// Encode "Set-Cookie" header.
+			{
+				cfg := uri.HeaderParameterEncodingConfig{
+					Name:    "Set-Cookie",
+					Explode: false,
+				}
+				if err := h.EncodeParam(cfg, func(e uri.Encoder) error {
+					if val, ok := response.SetCookie.Get(); ok {
+						return e.EncodeValue(conv.StringToString(val))
+					}
+					return nil
+				}); err != nil {
+					return errors.Wrap(err, "encode Set-Cookie header")
+				}
+			}
+		}
+		w.WriteHeader(204)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeMarkNoteSentResponse(response MarkNoteSentRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *Note:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *MarkNoteSentForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *MarkNoteSentNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *MarkNoteSentConflict:
+		w.WriteHeader(409)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeMeGetResponse(response MeGetRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *MeGetOK:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *MeGetUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodePurgeTicketResponse(response PurgeTicketRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *PurgeTicketNoContent:
+		w.WriteHeader(204)
+
+		return nil
+
+	case *PurgeTicketUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *PurgeTicketForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *PurgeTicketNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeRequestReviewsResponse(response RequestReviewsRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *RequestReviewsOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *RequestReviewsBadRequest:
+		w.WriteHeader(400)
+
+		return nil
+
+	case *RequestReviewsForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *RequestReviewsNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeRestoreTicketResponse(response RestoreTicketRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *Ticket:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *RestoreTicketUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *RestoreTicketForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *RestoreTicketNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeScanPIIResponse(response ScanPIIRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *ScanPIIOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ScanPIIForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeSearchResponse(response SearchRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *SearchOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *SearchBadRequest:
+		w.WriteHeader(400)
+
+		return nil
+
+	case *SearchUnauthorized:
+		w.WriteHeader(401)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeSubmitNoteResponse(response SubmitNoteRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *Note:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *SubmitNoteForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *SubmitNoteNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *SubmitNoteConflict:
+		w.WriteHeader(409)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeSyncUsersResponse(response SyncUsersRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *UserSyncResult:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *SyncUsersBadRequest:
+		w.WriteHeader(400)
+
+		return nil
+
+	case *SyncUsersForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *SyncUsersConflict:
+		w.WriteHeader(409)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeTicketsTicketIdAiGeneratePostResponse(response TicketsTicketIdAiGeneratePostRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *TicketsTicketIdAiGeneratePostOK:
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(200)
+
+		writer := w
+		if closer, ok := response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *TicketsTicketIdAiGeneratePostForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *TicketsTicketIdAiGeneratePostNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *TicketsTicketIdAiGeneratePostInternalServerError:
+		w.WriteHeader(500)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeTicketsTicketIdNotesNoteIdAiReviewPostResponse(response TicketsTicketIdNotesNoteIdAiReviewPostRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *TicketsTicketIdNotesNoteIdAiReviewPostOK:
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(200)
+
+		writer := w
+		if closer, ok := response.Data.(io.Closer); ok {
+			defer closer.Close()
+		}
+		if _, err := io.Copy(writer, response); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *TicketsTicketIdNotesNoteIdAiReviewPostForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *TicketsTicketIdNotesNoteIdAiReviewPostNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *TicketsTicketIdNotesNoteIdAiReviewPostInternalServerError:
+		w.WriteHeader(500)
+
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeTicketsTicketIdNotesNoteIdDeleteResponse(response TicketsTicketIdNotesNoteIdDeleteRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *TicketsTicketIdNotesNoteIdDeleteNoContent:
+		w.WriteHeader(204)
+
+		return nil
+
+	case *TicketsTicketIdNotesNoteIdDeleteForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *TicketsTicketIdNotesNoteIdDeleteNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeTicketsTicketIdNotesNoteIdPutResponse(response TicketsTicketIdNotesNoteIdPutRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *PIIReport:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *TicketsTicketIdNotesNoteIdPutBadRequest:
+		w.WriteHeader(400)
+
+		return nil
+
+	case *TicketsTicketIdNotesNoteIdPutForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *TicketsTicketIdNotesNoteIdPutNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *TicketsTicketIdNotesNoteIdPutConflict:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(409)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeTicketsTicketIdNotesPostResponse(response TicketsTicketIdNotesPostRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *Note:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(201)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *TicketsTicketIdNotesPostForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *TicketsTicketIdNotesPostNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeUpdateCensorPolicyResponse(response UpdateCensorPolicyRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *UpdateCensorPolicyOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateCensorPolicyBadRequest:
+		w.WriteHeader(400)
+
+		return nil
+
+	case *UpdateCensorPolicyForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		code := response.StatusCode
 		if code == 0 {
@@ -708,9 +2379,24 @@ func encodeUpdateReviewResponse(response UpdateReviewRes, w http.ResponseWriter)
 
 func encodeUpdateTicketByIDResponse(response UpdateTicketByIDRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *UpdateTicketByIDOK:
+	case *PIIReport:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
 		return nil
 
 	case *UpdateTicketByIDBadRequest:
@@ -733,6 +2419,88 @@ func encodeUpdateTicketByIDResponse(response UpdateTicketByIDRes, w http.Respons
 
 		return nil
 
+	case *UpdateTicketByIDConflict:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(409)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeUpdateUserResponse(response UpdateUserRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *User:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *UpdateUserForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *UpdateUserNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *UpdateUserConflict:
+		w.WriteHeader(409)
+
+		return nil
+
 	case *ErrorResponseStatusCode:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		code := response.StatusCode
@@ -807,9 +2575,24 @@ func encodeUsersGetResponse(response UsersGetRes, w http.ResponseWriter) error {
 
 func encodeUsersPutResponse(response UsersPutRes, w http.ResponseWriter) error {
 	switch response := response.(type) {
-	case *UsersPutOK:
+	case *UsersPutOKApplicationJSON:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		w.WriteHeader(200)
 
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
 		return nil
 
 	case *UsersPutForbidden:
@@ -817,6 +2600,73 @@ func encodeUsersPutResponse(response UsersPutRes, w http.ResponseWriter) error {
 
 		return nil
 
+	case *UsersPutConflict:
+		w.WriteHeader(409)
+
+		return nil
+
+	case *ErrorResponseStatusCode:
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		code := response.StatusCode
+		if code == 0 {
+			// Set default status code.
+			code = http.StatusOK
+		}
+		w.WriteHeader(code)
+
+		e := new(jx.Encoder)
+		response.Response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		if code >= http.StatusInternalServerError {
+			return errors.Wrapf(ht.ErrInternalServerErrorResponse, "code: %d, message: %s", code, http.StatusText(code))
+		}
+		return nil
+
+	default:
+		return errors.Errorf("unexpected response type: %T", response)
+	}
+}
+
+func encodeWithdrawNoteResponse(response WithdrawNoteRes, w http.ResponseWriter) error {
+	switch response := response.(type) {
+	case *Note:
+		if err := func() error {
+			if err := response.Validate(); err != nil {
+				return err
+			}
+			return nil
+		}(); err != nil {
+			return errors.Wrap(err, "validate")
+		}
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.WriteHeader(200)
+
+		e := new(jx.Encoder)
+		response.Encode(e)
+		if _, err := e.WriteTo(w); err != nil {
+			return errors.Wrap(err, "write")
+		}
+
+		return nil
+
+	case *WithdrawNoteForbidden:
+		w.WriteHeader(403)
+
+		return nil
+
+	case *WithdrawNoteNotFound:
+		w.WriteHeader(404)
+
+		return nil
+
+	case *WithdrawNoteConflict:
+		w.WriteHeader(409)
+
+		return nil
+
 	case *ErrorResponseStatusCode:
 		w.Header().Set("Content-Type", "application/json; charset=utf-8")
 		code := response.StatusCode
@@ -10,6 +10,123 @@ import (
 	"github.com/ogen-go/ogen/uri"
 )
 
+var (
+	rn29AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Forwarded-User",
+	}
+	rn35AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Forwarded-User",
+	}
+	rn39AllowedHeaders = map[string]string{
+		"POST": "Authorization,X-Forwarded-User",
+	}
+	rn8AllowedHeaders = map[string]string{
+		"GET":  "Authorization,X-Forwarded-User",
+		"POST": "Authorization,Content-Type,X-Forwarded-User",
+	}
+	rn20AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Forwarded-User",
+		"PUT": "Authorization,Content-Type,X-Forwarded-User",
+	}
+	rn41AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Forwarded-User",
+	}
+	rn9AllowedHeaders = map[string]string{
+		"GET":  "Authorization,X-Forwarded-User",
+		"POST": "Authorization,Content-Type,X-Forwarded-User",
+	}
+	rn15AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Forwarded-User",
+	}
+	rn46AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Forwarded-User",
+	}
+	rn47AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Forwarded-User",
+	}
+	rn12AllowedHeaders = map[string]string{
+		"GET":  "Authorization,X-Forwarded-User",
+		"POST": "Authorization,Content-Type,X-Forwarded-User",
+	}
+	rn33AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Forwarded-User",
+	}
+	rn4AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Forwarded-User",
+		"GET":    "Authorization,X-Forwarded-User",
+		"PATCH":  "Authorization,Content-Type,X-Forwarded-User",
+	}
+	rn51AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type,X-Forwarded-User",
+	}
+	rn31AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Forwarded-User",
+	}
+	rn53AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type,X-Forwarded-User",
+	}
+	rn6AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Forwarded-User",
+		"PUT":    "Authorization,Content-Type,X-Forwarded-User",
+	}
+	rn52AllowedHeaders = map[string]string{
+		"POST": "Authorization,X-Forwarded-User",
+	}
+	rn7AllowedHeaders = map[string]string{
+		"POST": "Authorization,X-Forwarded-User",
+	}
+	rn44AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type,X-Forwarded-User",
+	}
+	rn11AllowedHeaders = map[string]string{
+		"POST": "Authorization,Content-Type,X-Forwarded-User",
+	}
+	rn17AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Forwarded-User",
+		"PUT":    "Authorization,Content-Type,X-Forwarded-User",
+	}
+	rn27AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Forwarded-User",
+	}
+	rn26AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Forwarded-User",
+	}
+	rn25AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Forwarded-User",
+	}
+	rn40AllowedHeaders = map[string]string{
+		"POST": "Authorization,X-Forwarded-User",
+	}
+	rn49AllowedHeaders = map[string]string{
+		"POST": "Authorization,X-Forwarded-User",
+	}
+	rn54AllowedHeaders = map[string]string{
+		"POST": "Authorization,X-Forwarded-User",
+	}
+	rn42AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Forwarded-User",
+	}
+	rn45AllowedHeaders = map[string]string{
+		"POST": "Authorization,X-Forwarded-User",
+	}
+	rn32AllowedHeaders = map[string]string{
+		"GET": "Authorization,X-Forwarded-User",
+	}
+	rn13AllowedHeaders = map[string]string{
+		"GET":  "Authorization,X-Forwarded-User",
+		"POST": "Authorization,Content-Type,X-Forwarded-User",
+		"PUT":  "Authorization,Content-Type,X-Forwarded-User",
+	}
+	rn50AllowedHeaders = map[string]string{
+		"POST": "Authorization,X-Forwarded-User",
+	}
+	rn19AllowedHeaders = map[string]string{
+		"DELETE": "Authorization,X-Forwarded-User",
+		"GET":    "Authorization,X-Forwarded-User",
+		"PATCH":  "Authorization,Content-Type,X-Forwarded-User",
+	}
+)
+
 func (s *Server) cutPrefix(path string) (string, bool) {
 	prefix := s.cfg.Prefix
 	if prefix == "" {
@@ -40,7 +157,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [3]string{}
+	args := [4]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -61,6 +178,187 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
+			case 'a': // Prefix: "au"
+
+				if l := len("au"); len(elem) >= l && elem[0:l] == "au" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dit/"
+
+					if l := len("dit/"); len(elem) >= l && elem[0:l] == "dit/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'r': // Prefix: "role-changes"
+
+						if l := len("role-changes"); len(elem) >= l && elem[0:l] == "role-changes" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetRoleChangesRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn29AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					case 'u': // Prefix: "uncensored-views"
+
+						if l := len("uncensored-views"); len(elem) >= l && elem[0:l] == "uncensored-views" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetUncensoredViewsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn35AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					}
+
+				case 't': // Prefix: "th/"
+
+					if l := len("th/"); len(elem) >= l && elem[0:l] == "th/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "callback"
+
+						if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleAuthCallbackRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: nil,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					case 'l': // Prefix: "log"
+
+						if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'i': // Prefix: "in"
+
+							if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleLoginRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: nil,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 'o': // Prefix: "out"
+
+							if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleLogoutRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "POST",
+										allowedHeaders: rn39AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						}
+
+					}
+
+				}
+
 			case 'c': // Prefix: "config"
 
 				if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
@@ -70,18 +368,51 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
 					case "GET":
 						s.handleConfigGetRequest([0]string{}, elemIsEscaped, w, r)
 					case "POST":
 						s.handleConfigPostRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET,POST")
+						s.notAllowed(w, r, notAllowedParams{
+							allowedMethods: "GET,POST",
+							allowedHeaders: rn8AllowedHeaders,
+							acceptPost:     "application/json",
+							acceptPatch:    "",
+						})
 					}
 
 					return
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/censor-policy"
+
+					if l := len("/censor-policy"); len(elem) >= l && elem[0:l] == "/censor-policy" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetCensorPolicyRequest([0]string{}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleUpdateCensorPolicyRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET,PUT",
+								allowedHeaders: rn20AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+
+				}
 
 			case 'm': // Prefix: "me"
 
@@ -92,12 +423,132 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
 					case "GET":
 						s.handleMeGetRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET")
+						s.notAllowed(w, r, notAllowedParams{
+							allowedMethods: "GET",
+							allowedHeaders: rn41AllowedHeaders,
+							acceptPost:     "",
+							acceptPatch:    "",
+						})
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/tokens"
+
+					if l := len("/tokens"); len(elem) >= l && elem[0:l] == "/tokens" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetMyTokensRequest([0]string{}, elemIsEscaped, w, r)
+						case "POST":
+							s.handleCreateMyTokenRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "GET,POST",
+								allowedHeaders: rn9AllowedHeaders,
+								acceptPost:     "application/json",
+								acceptPatch:    "",
+							})
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "tokenId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleDeleteMyTokenRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "DELETE",
+									allowedHeaders: rn15AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+					}
+
+				}
+
+			case 'p': // Prefix: "pii/scan"
+
+				if l := len("pii/scan"); len(elem) >= l && elem[0:l] == "pii/scan" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleScanPIIRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, notAllowedParams{
+							allowedMethods: "GET",
+							allowedHeaders: rn46AllowedHeaders,
+							acceptPost:     "",
+							acceptPatch:    "",
+						})
+					}
+
+					return
+				}
+
+			case 's': // Prefix: "search"
+
+				if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch r.Method {
+					case "GET":
+						s.handleSearchRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, notAllowedParams{
+							allowedMethods: "GET",
+							allowedHeaders: rn47AllowedHeaders,
+							acceptPost:     "",
+							acceptPatch:    "",
+						})
 					}
 
 					return
@@ -118,7 +569,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					case "POST":
 						s.handleCreateTicketRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET,POST")
+						s.notAllowed(w, r, notAllowedParams{
+							allowedMethods: "GET,POST",
+							allowedHeaders: rn12AllowedHeaders,
+							acceptPost:     "application/json",
+							acceptPatch:    "",
+						})
 					}
 
 					return
@@ -132,6 +588,37 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 't': // Prefix: "trash"
+						origElem := elem
+						if l := len("trash"); len(elem) >= l && elem[0:l] == "trash" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetTrashedTicketsRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "GET",
+									allowedHeaders: rn33AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
 					// Param: "ticketId"
 					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
@@ -156,7 +643,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 								args[0],
 							}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "DELETE,GET,PATCH")
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "DELETE,GET,PATCH",
+								allowedHeaders: rn4AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "application/json",
+							})
 						}
 
 						return
@@ -190,15 +682,47 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "POST")
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "POST",
+										allowedHeaders: rn51AllowedHeaders,
+										acceptPost:     "application/json",
+										acceptPatch:    "",
+									})
 								}
 
 								return
 							}
 
-						case 'n': // Prefix: "notes"
+						case 'h': // Prefix: "history"
 
-							if l := len("notes"); len(elem) >= l && elem[0:l] == "notes" {
+							if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetTicketHistoryRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn31AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 'n': // Prefix: "notes"
+
+							if l := len("notes"); len(elem) >= l && elem[0:l] == "notes" {
 								elem = elem[l:]
 							} else {
 								break
@@ -211,7 +735,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 										args[0],
 									}, elemIsEscaped, w, r)
 								default:
-									s.notAllowed(w, r, "POST")
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "POST",
+										allowedHeaders: rn53AllowedHeaders,
+										acceptPost:     "application/json",
+										acceptPatch:    "",
+									})
 								}
 
 								return
@@ -247,7 +776,12 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 											args[1],
 										}, elemIsEscaped, w, r)
 									default:
-										s.notAllowed(w, r, "DELETE,PUT")
+										s.notAllowed(w, r, notAllowedParams{
+											allowedMethods: "DELETE,PUT",
+											allowedHeaders: rn6AllowedHeaders,
+											acceptPost:     "",
+											acceptPatch:    "",
+										})
 									}
 
 									return
@@ -282,68 +816,358 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 													args[1],
 												}, elemIsEscaped, w, r)
 											default:
-												s.notAllowed(w, r, "POST")
+												s.notAllowed(w, r, notAllowedParams{
+													allowedMethods: "POST",
+													allowedHeaders: rn52AllowedHeaders,
+													acceptPost:     "",
+													acceptPatch:    "",
+												})
 											}
 
 											return
 										}
 
-									case 'r': // Prefix: "reviews"
+									case 'c': // Prefix: "cancel"
 
-										if l := len("reviews"); len(elem) >= l && elem[0:l] == "reviews" {
+										if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
 										if len(elem) == 0 {
+											// Leaf node.
 											switch r.Method {
 											case "POST":
-												s.handleCreateReviewRequest([2]string{
+												s.handleCancelNoteRequest([2]string{
 													args[0],
 													args[1],
 												}, elemIsEscaped, w, r)
 											default:
-												s.notAllowed(w, r, "POST")
+												s.notAllowed(w, r, notAllowedParams{
+													allowedMethods: "POST",
+													allowedHeaders: rn7AllowedHeaders,
+													acceptPost:     "",
+													acceptPatch:    "",
+												})
 											}
 
 											return
 										}
+
+									case 'r': // Prefix: "revi"
+
+										if l := len("revi"); len(elem) >= l && elem[0:l] == "revi" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
 										switch elem[0] {
-										case '/': // Prefix: "/"
+										case 'e': // Prefix: "ew"
 
-											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											if l := len("ew"); len(elem) >= l && elem[0:l] == "ew" {
 												elem = elem[l:]
 											} else {
 												break
 											}
 
-											// Param: "reviewId"
-											// Leaf parameter, slashes are prohibited
-											idx := strings.IndexByte(elem, '/')
-											if idx >= 0 {
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case '-': // Prefix: "-requests"
+
+												if l := len("-requests"); len(elem) >= l && elem[0:l] == "-requests" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "POST":
+														s.handleRequestReviewsRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, notAllowedParams{
+															allowedMethods: "POST",
+															allowedHeaders: rn44AllowedHeaders,
+															acceptPost:     "application/json",
+															acceptPatch:    "",
+														})
+													}
+
+													return
+												}
+
+											case 's': // Prefix: "s"
+
+												if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													switch r.Method {
+													case "POST":
+														s.handleCreateReviewRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, notAllowedParams{
+															allowedMethods: "POST",
+															allowedHeaders: rn11AllowedHeaders,
+															acceptPost:     "application/json",
+															acceptPatch:    "",
+														})
+													}
+
+													return
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/"
+
+													if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													// Param: "reviewId"
+													// Match until "/"
+													idx := strings.IndexByte(elem, '/')
+													if idx < 0 {
+														idx = len(elem)
+													}
+													args[2] = elem[:idx]
+													elem = elem[idx:]
+
+													if len(elem) == 0 {
+														switch r.Method {
+														case "DELETE":
+															s.handleDeleteReviewRequest([3]string{
+																args[0],
+																args[1],
+																args[2],
+															}, elemIsEscaped, w, r)
+														case "PUT":
+															s.handleUpdateReviewRequest([3]string{
+																args[0],
+																args[1],
+																args[2],
+															}, elemIsEscaped, w, r)
+														default:
+															s.notAllowed(w, r, notAllowedParams{
+																allowedMethods: "DELETE,PUT",
+																allowedHeaders: rn17AllowedHeaders,
+																acceptPost:     "",
+																acceptPatch:    "",
+															})
+														}
+
+														return
+													}
+													switch elem[0] {
+													case '/': // Prefix: "/diff"
+
+														if l := len("/diff"); len(elem) >= l && elem[0:l] == "/diff" {
+															elem = elem[l:]
+														} else {
+															break
+														}
+
+														if len(elem) == 0 {
+															// Leaf node.
+															switch r.Method {
+															case "GET":
+																s.handleGetReviewDiffRequest([3]string{
+																	args[0],
+																	args[1],
+																	args[2],
+																}, elemIsEscaped, w, r)
+															default:
+																s.notAllowed(w, r, notAllowedParams{
+																	allowedMethods: "GET",
+																	allowedHeaders: rn27AllowedHeaders,
+																	acceptPost:     "",
+																	acceptPatch:    "",
+																})
+															}
+
+															return
+														}
+
+													}
+
+												}
+
+											}
+
+										case 's': // Prefix: "sions"
+
+											if l := len("sions"); len(elem) >= l && elem[0:l] == "sions" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "GET":
+													s.handleGetNoteRevisionsRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, notAllowedParams{
+														allowedMethods: "GET",
+														allowedHeaders: rn26AllowedHeaders,
+														acceptPost:     "",
+														acceptPatch:    "",
+													})
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "fromRevision"
+												// Match until "/"
+												idx := strings.IndexByte(elem, '/')
+												if idx < 0 {
+													idx = len(elem)
+												}
+												args[2] = elem[:idx]
+												elem = elem[idx:]
+
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/diff/"
+
+													if l := len("/diff/"); len(elem) >= l && elem[0:l] == "/diff/" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													// Param: "toRevision"
+													// Leaf parameter, slashes are prohibited
+													idx := strings.IndexByte(elem, '/')
+													if idx >= 0 {
+														break
+													}
+													args[3] = elem
+													elem = ""
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch r.Method {
+														case "GET":
+															s.handleGetNoteRevisionDiffRequest([4]string{
+																args[0],
+																args[1],
+																args[2],
+																args[3],
+															}, elemIsEscaped, w, r)
+														default:
+															s.notAllowed(w, r, notAllowedParams{
+																allowedMethods: "GET",
+																allowedHeaders: rn25AllowedHeaders,
+																acceptPost:     "",
+																acceptPatch:    "",
+															})
+														}
+
+														return
+													}
+
+												}
+
+											}
+
+										}
+
+									case 's': // Prefix: "s"
+
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'e': // Prefix: "end"
+
+											if l := len("end"); len(elem) >= l && elem[0:l] == "end" {
+												elem = elem[l:]
+											} else {
 												break
 											}
-											args[2] = elem
-											elem = ""
 
 											if len(elem) == 0 {
 												// Leaf node.
 												switch r.Method {
-												case "DELETE":
-													s.handleDeleteReviewRequest([3]string{
+												case "POST":
+													s.handleMarkNoteSentRequest([2]string{
 														args[0],
 														args[1],
-														args[2],
 													}, elemIsEscaped, w, r)
-												case "PUT":
-													s.handleUpdateReviewRequest([3]string{
+												default:
+													s.notAllowed(w, r, notAllowedParams{
+														allowedMethods: "POST",
+														allowedHeaders: rn40AllowedHeaders,
+														acceptPost:     "",
+														acceptPatch:    "",
+													})
+												}
+
+												return
+											}
+
+										case 'u': // Prefix: "ubmit"
+
+											if l := len("ubmit"); len(elem) >= l && elem[0:l] == "ubmit" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "POST":
+													s.handleSubmitNoteRequest([2]string{
 														args[0],
 														args[1],
-														args[2],
 													}, elemIsEscaped, w, r)
 												default:
-													s.notAllowed(w, r, "DELETE,PUT")
+													s.notAllowed(w, r, notAllowedParams{
+														allowedMethods: "POST",
+														allowedHeaders: rn49AllowedHeaders,
+														acceptPost:     "",
+														acceptPatch:    "",
+													})
 												}
 
 												return
@@ -351,12 +1175,121 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 										}
 
+									case 'w': // Prefix: "withdraw"
+
+										if l := len("withdraw"); len(elem) >= l && elem[0:l] == "withdraw" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleWithdrawNoteRequest([2]string{
+													args[0],
+													args[1],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, notAllowedParams{
+													allowedMethods: "POST",
+													allowedHeaders: rn54AllowedHeaders,
+													acceptPost:     "",
+													acceptPatch:    "",
+												})
+											}
+
+											return
+										}
+
 									}
 
 								}
 
 							}
 
+						case 'p': // Prefix: "purge"
+
+							if l := len("purge"); len(elem) >= l && elem[0:l] == "purge" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handlePurgeTicketRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "DELETE",
+										allowedHeaders: rn42AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 'r': // Prefix: "restore"
+
+							if l := len("restore"); len(elem) >= l && elem[0:l] == "restore" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleRestoreTicketRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "POST",
+										allowedHeaders: rn45AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
+						case 't': // Prefix: "transitions"
+
+							if l := len("transitions"); len(elem) >= l && elem[0:l] == "transitions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "GET":
+									s.handleGetTicketTransitionsRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, notAllowedParams{
+										allowedMethods: "GET",
+										allowedHeaders: rn32AllowedHeaders,
+										acceptPost:     "",
+										acceptPatch:    "",
+									})
+								}
+
+								return
+							}
+
 						}
 
 					}
@@ -372,18 +1305,101 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch r.Method {
 					case "GET":
 						s.handleUsersGetRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleCreateUserRequest([0]string{}, elemIsEscaped, w, r)
 					case "PUT":
 						s.handleUsersPutRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						s.notAllowed(w, r, "GET,PUT")
+						s.notAllowed(w, r, notAllowedParams{
+							allowedMethods: "GET,POST,PUT",
+							allowedHeaders: rn13AllowedHeaders,
+							acceptPost:     "application/json",
+							acceptPatch:    "",
+						})
 					}
 
 					return
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 's': // Prefix: "sync"
+						origElem := elem
+						if l := len("sync"); len(elem) >= l && elem[0:l] == "sync" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleSyncUsersRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, notAllowedParams{
+									allowedMethods: "POST",
+									allowedHeaders: rn50AllowedHeaders,
+									acceptPost:     "",
+									acceptPatch:    "",
+								})
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+					// Param: "traqId"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteUserRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleGetUserRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PATCH":
+							s.handleUpdateUserRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, notAllowedParams{
+								allowedMethods: "DELETE,GET,PATCH",
+								allowedHeaders: rn19AllowedHeaders,
+								acceptPost:     "",
+								acceptPatch:    "application/json",
+							})
+						}
+
+						return
+					}
+
+				}
 
 			}
 
@@ -400,7 +1416,7 @@ type Route struct {
 	operationGroup string
 	pathPattern    string
 	count          int
-	args           [3]string
+	args           [4]string
 }
 
 // Name returns ogen operation name.
@@ -485,6 +1501,187 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				break
 			}
 			switch elem[0] {
+			case 'a': // Prefix: "au"
+
+				if l := len("au"); len(elem) >= l && elem[0:l] == "au" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dit/"
+
+					if l := len("dit/"); len(elem) >= l && elem[0:l] == "dit/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'r': // Prefix: "role-changes"
+
+						if l := len("role-changes"); len(elem) >= l && elem[0:l] == "role-changes" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetRoleChangesOperation
+								r.summary = "ユーザーの追加・ロールの変更・削除の記録の取得"
+								r.operationID = "getRoleChanges"
+								r.operationGroup = ""
+								r.pathPattern = "/audit/role-changes"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'u': // Prefix: "uncensored-views"
+
+						if l := len("uncensored-views"); len(elem) >= l && elem[0:l] == "uncensored-views" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetUncensoredViewsOperation
+								r.summary = "伏字を伏せずに返した記録の取得"
+								r.operationID = "getUncensoredViews"
+								r.operationGroup = ""
+								r.pathPattern = "/audit/uncensored-views"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				case 't': // Prefix: "th/"
+
+					if l := len("th/"); len(elem) >= l && elem[0:l] == "th/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'c': // Prefix: "callback"
+
+						if l := len("callback"); len(elem) >= l && elem[0:l] == "callback" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = AuthCallbackOperation
+								r.summary = "traQ OAuth2のコールバック"
+								r.operationID = "authCallback"
+								r.operationGroup = ""
+								r.pathPattern = "/auth/callback"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+					case 'l': // Prefix: "log"
+
+						if l := len("log"); len(elem) >= l && elem[0:l] == "log" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'i': // Prefix: "in"
+
+							if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = LoginOperation
+									r.summary = "traQ OAuth2のログイン開始"
+									r.operationID = "login"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/login"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'o': // Prefix: "out"
+
+							if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = LogoutOperation
+									r.summary = "ログアウト"
+									r.operationID = "logout"
+									r.operationGroup = ""
+									r.pathPattern = "/auth/logout"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+						}
+
+					}
+
+				}
+
 			case 'c': // Prefix: "config"
 
 				if l := len("config"); len(elem) >= l && elem[0:l] == "config" {
@@ -494,7 +1691,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch method {
 					case "GET":
 						r.name = ConfigGetOperation
@@ -510,7 +1706,163 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						r.summary = "設定情報の更新"
 						r.operationID = ""
 						r.operationGroup = ""
-						r.pathPattern = "/config"
+						r.pathPattern = "/config"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/censor-policy"
+
+					if l := len("/censor-policy"); len(elem) >= l && elem[0:l] == "/censor-policy" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "GET":
+							r.name = GetCensorPolicyOperation
+							r.summary = "伏字のカテゴリごとの閲覧ルールの取得"
+							r.operationID = "getCensorPolicy"
+							r.operationGroup = ""
+							r.pathPattern = "/config/censor-policy"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "PUT":
+							r.name = UpdateCensorPolicyOperation
+							r.summary = "伏字のカテゴリごとの閲覧ルールの更新"
+							r.operationID = "updateCensorPolicy"
+							r.operationGroup = ""
+							r.pathPattern = "/config/censor-policy"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
+
+			case 'm': // Prefix: "me"
+
+				if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						r.name = MeGetOperation
+						r.summary = "現在のユーザー情報取得"
+						r.operationID = ""
+						r.operationGroup = ""
+						r.pathPattern = "/me"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/tokens"
+
+					if l := len("/tokens"); len(elem) >= l && elem[0:l] == "/tokens" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = GetMyTokensOperation
+							r.summary = "自分のAPIトークン一覧取得"
+							r.operationID = "getMyTokens"
+							r.operationGroup = ""
+							r.pathPattern = "/me/tokens"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = CreateMyTokenOperation
+							r.summary = "APIトークンの発行"
+							r.operationID = "createMyToken"
+							r.operationGroup = ""
+							r.pathPattern = "/me/tokens"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "tokenId"
+						// Leaf parameter, slashes are prohibited
+						idx := strings.IndexByte(elem, '/')
+						if idx >= 0 {
+							break
+						}
+						args[0] = elem
+						elem = ""
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "DELETE":
+								r.name = DeleteMyTokenOperation
+								r.summary = "APIトークンの削除"
+								r.operationID = "deleteMyToken"
+								r.operationGroup = ""
+								r.pathPattern = "/me/tokens/{tokenId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+					}
+
+				}
+
+			case 'p': // Prefix: "pii/scan"
+
+				if l := len("pii/scan"); len(elem) >= l && elem[0:l] == "pii/scan" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					// Leaf node.
+					switch method {
+					case "GET":
+						r.name = ScanPIIOperation
+						r.summary = "既存のチケット・ノートに含まれる伏字になっていない個人情報の検出"
+						r.operationID = "scanPII"
+						r.operationGroup = ""
+						r.pathPattern = "/pii/scan"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -519,9 +1871,9 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					}
 				}
 
-			case 'm': // Prefix: "me"
+			case 's': // Prefix: "search"
 
-				if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+				if l := len("search"); len(elem) >= l && elem[0:l] == "search" {
 					elem = elem[l:]
 				} else {
 					break
@@ -531,11 +1883,11 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					// Leaf node.
 					switch method {
 					case "GET":
-						r.name = MeGetOperation
-						r.summary = "現在のユーザー情報取得"
-						r.operationID = ""
+						r.name = SearchOperation
+						r.summary = "全文検索"
+						r.operationID = "search"
 						r.operationGroup = ""
-						r.pathPattern = "/me"
+						r.pathPattern = "/search"
 						r.args = args
 						r.count = 0
 						return r, true
@@ -585,6 +1937,37 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 't': // Prefix: "trash"
+						origElem := elem
+						if l := len("trash"); len(elem) >= l && elem[0:l] == "trash" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "GET":
+								r.name = GetTrashedTicketsOperation
+								r.summary = "削除済みチケット一覧取得"
+								r.operationID = "getTrashedTickets"
+								r.operationGroup = ""
+								r.pathPattern = "/tickets/trash"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
 					// Param: "ticketId"
 					// Match until "/"
 					idx := strings.IndexByte(elem, '/')
@@ -665,6 +2048,31 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 								}
 							}
 
+						case 'h': // Prefix: "history"
+
+							if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetTicketHistoryOperation
+									r.summary = "チケットの変更履歴取得"
+									r.operationID = "getTicketHistory"
+									r.operationGroup = ""
+									r.pathPattern = "/tickets/{ticketId}/history"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
 						case 'n': // Prefix: "notes"
 
 							if l := len("notes"); len(elem) >= l && elem[0:l] == "notes" {
@@ -768,22 +2176,23 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 											}
 										}
 
-									case 'r': // Prefix: "reviews"
+									case 'c': // Prefix: "cancel"
 
-										if l := len("reviews"); len(elem) >= l && elem[0:l] == "reviews" {
+										if l := len("cancel"); len(elem) >= l && elem[0:l] == "cancel" {
 											elem = elem[l:]
 										} else {
 											break
 										}
 
 										if len(elem) == 0 {
+											// Leaf node.
 											switch method {
 											case "POST":
-												r.name = CreateReviewOperation
-												r.summary = "レビュー追加"
-												r.operationID = "createReview"
+												r.name = CancelNoteOperation
+												r.summary = "ノートを破棄する"
+												r.operationID = "cancelNote"
 												r.operationGroup = ""
-												r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/reviews"
+												r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/cancel"
 												r.args = args
 												r.count = 2
 												return r, true
@@ -791,44 +2200,294 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 												return
 											}
 										}
+
+									case 'r': // Prefix: "revi"
+
+										if l := len("revi"); len(elem) >= l && elem[0:l] == "revi" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
 										switch elem[0] {
-										case '/': // Prefix: "/"
+										case 'e': // Prefix: "ew"
+
+											if l := len("ew"); len(elem) >= l && elem[0:l] == "ew" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												break
+											}
+											switch elem[0] {
+											case '-': // Prefix: "-requests"
+
+												if l := len("-requests"); len(elem) >= l && elem[0:l] == "-requests" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch method {
+													case "POST":
+														r.name = RequestReviewsOperation
+														r.summary = "レビュー依頼"
+														r.operationID = "requestReviews"
+														r.operationGroup = ""
+														r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/review-requests"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+											case 's': // Prefix: "s"
+
+												if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												if len(elem) == 0 {
+													switch method {
+													case "POST":
+														r.name = CreateReviewOperation
+														r.summary = "レビュー追加"
+														r.operationID = "createReview"
+														r.operationGroup = ""
+														r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/reviews"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/"
+
+													if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													// Param: "reviewId"
+													// Match until "/"
+													idx := strings.IndexByte(elem, '/')
+													if idx < 0 {
+														idx = len(elem)
+													}
+													args[2] = elem[:idx]
+													elem = elem[idx:]
+
+													if len(elem) == 0 {
+														switch method {
+														case "DELETE":
+															r.name = DeleteReviewOperation
+															r.summary = "レビュー取り消し"
+															r.operationID = "deleteReview"
+															r.operationGroup = ""
+															r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/reviews/{reviewId}"
+															r.args = args
+															r.count = 3
+															return r, true
+														case "PUT":
+															r.name = UpdateReviewOperation
+															r.summary = "レビュー修正"
+															r.operationID = "updateReview"
+															r.operationGroup = ""
+															r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/reviews/{reviewId}"
+															r.args = args
+															r.count = 3
+															return r, true
+														default:
+															return
+														}
+													}
+													switch elem[0] {
+													case '/': // Prefix: "/diff"
+
+														if l := len("/diff"); len(elem) >= l && elem[0:l] == "/diff" {
+															elem = elem[l:]
+														} else {
+															break
+														}
+
+														if len(elem) == 0 {
+															// Leaf node.
+															switch method {
+															case "GET":
+																r.name = GetReviewDiffOperation
+																r.summary = "レビュー時からのノートの差分取得"
+																r.operationID = "getReviewDiff"
+																r.operationGroup = ""
+																r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/reviews/{reviewId}/diff"
+																r.args = args
+																r.count = 3
+																return r, true
+															default:
+																return
+															}
+														}
+
+													}
+
+												}
 
-											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+											}
+
+										case 's': // Prefix: "sions"
+
+											if l := len("sions"); len(elem) >= l && elem[0:l] == "sions" {
 												elem = elem[l:]
 											} else {
 												break
 											}
 
-											// Param: "reviewId"
-											// Leaf parameter, slashes are prohibited
-											idx := strings.IndexByte(elem, '/')
-											if idx >= 0 {
+											if len(elem) == 0 {
+												switch method {
+												case "GET":
+													r.name = GetNoteRevisionsOperation
+													r.summary = "ノートの版一覧取得"
+													r.operationID = "getNoteRevisions"
+													r.operationGroup = ""
+													r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/revisions"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "fromRevision"
+												// Match until "/"
+												idx := strings.IndexByte(elem, '/')
+												if idx < 0 {
+													idx = len(elem)
+												}
+												args[2] = elem[:idx]
+												elem = elem[idx:]
+
+												if len(elem) == 0 {
+													break
+												}
+												switch elem[0] {
+												case '/': // Prefix: "/diff/"
+
+													if l := len("/diff/"); len(elem) >= l && elem[0:l] == "/diff/" {
+														elem = elem[l:]
+													} else {
+														break
+													}
+
+													// Param: "toRevision"
+													// Leaf parameter, slashes are prohibited
+													idx := strings.IndexByte(elem, '/')
+													if idx >= 0 {
+														break
+													}
+													args[3] = elem
+													elem = ""
+
+													if len(elem) == 0 {
+														// Leaf node.
+														switch method {
+														case "GET":
+															r.name = GetNoteRevisionDiffOperation
+															r.summary = "ノートの版の差分取得"
+															r.operationID = "getNoteRevisionDiff"
+															r.operationGroup = ""
+															r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/revisions/{fromRevision}/diff/{toRevision}"
+															r.args = args
+															r.count = 4
+															return r, true
+														default:
+															return
+														}
+													}
+
+												}
+
+											}
+
+										}
+
+									case 's': // Prefix: "s"
+
+										if l := len("s"); len(elem) >= l && elem[0:l] == "s" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'e': // Prefix: "end"
+
+											if l := len("end"); len(elem) >= l && elem[0:l] == "end" {
+												elem = elem[l:]
+											} else {
 												break
 											}
-											args[2] = elem
-											elem = ""
 
 											if len(elem) == 0 {
 												// Leaf node.
 												switch method {
-												case "DELETE":
-													r.name = DeleteReviewOperation
-													r.summary = "レビュー取り消し"
-													r.operationID = "deleteReview"
+												case "POST":
+													r.name = MarkNoteSentOperation
+													r.summary = "ノートを送信済みにする"
+													r.operationID = "markNoteSent"
 													r.operationGroup = ""
-													r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/reviews/{reviewId}"
+													r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/send"
 													r.args = args
-													r.count = 3
+													r.count = 2
 													return r, true
-												case "PUT":
-													r.name = UpdateReviewOperation
-													r.summary = "レビュー修正"
-													r.operationID = "updateReview"
+												default:
+													return
+												}
+											}
+
+										case 'u': // Prefix: "ubmit"
+
+											if l := len("ubmit"); len(elem) >= l && elem[0:l] == "ubmit" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch method {
+												case "POST":
+													r.name = SubmitNoteOperation
+													r.summary = "ノートをレビューに出す"
+													r.operationID = "submitNote"
 													r.operationGroup = ""
-													r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/reviews/{reviewId}"
+													r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/submit"
 													r.args = args
-													r.count = 3
+													r.count = 2
 													return r, true
 												default:
 													return
@@ -837,12 +2496,112 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 										}
 
+									case 'w': // Prefix: "withdraw"
+
+										if l := len("withdraw"); len(elem) >= l && elem[0:l] == "withdraw" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch method {
+											case "POST":
+												r.name = WithdrawNoteOperation
+												r.summary = "ノートを下書きに戻す"
+												r.operationID = "withdrawNote"
+												r.operationGroup = ""
+												r.pathPattern = "/tickets/{ticketId}/notes/{noteId}/withdraw"
+												r.args = args
+												r.count = 2
+												return r, true
+											default:
+												return
+											}
+										}
+
 									}
 
 								}
 
 							}
 
+						case 'p': // Prefix: "purge"
+
+							if l := len("purge"); len(elem) >= l && elem[0:l] == "purge" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "DELETE":
+									r.name = PurgeTicketOperation
+									r.summary = "削除済みチケットの完全削除"
+									r.operationID = "purgeTicket"
+									r.operationGroup = ""
+									r.pathPattern = "/tickets/{ticketId}/purge"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 'r': // Prefix: "restore"
+
+							if l := len("restore"); len(elem) >= l && elem[0:l] == "restore" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "POST":
+									r.name = RestoreTicketOperation
+									r.summary = "削除済みチケットの復元"
+									r.operationID = "restoreTicket"
+									r.operationGroup = ""
+									r.pathPattern = "/tickets/{ticketId}/restore"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+						case 't': // Prefix: "transitions"
+
+							if l := len("transitions"); len(elem) >= l && elem[0:l] == "transitions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch method {
+								case "GET":
+									r.name = GetTicketTransitionsOperation
+									r.summary = "チケットのステータス遷移先取得"
+									r.operationID = "getTicketTransitions"
+									r.operationGroup = ""
+									r.pathPattern = "/tickets/{ticketId}/transitions"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
 						}
 
 					}
@@ -858,7 +2617,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				if len(elem) == 0 {
-					// Leaf node.
 					switch method {
 					case "GET":
 						r.name = UsersGetOperation
@@ -869,6 +2627,15 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						r.args = args
 						r.count = 0
 						return r, true
+					case "POST":
+						r.name = CreateUserOperation
+						r.summary = "ユーザー追加"
+						r.operationID = "createUser"
+						r.operationGroup = ""
+						r.pathPattern = "/users"
+						r.args = args
+						r.count = 0
+						return r, true
 					case "PUT":
 						r.name = UsersPutOperation
 						r.summary = "ユーザー情報の同期・更新"
@@ -882,6 +2649,91 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						return
 					}
 				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 's': // Prefix: "sync"
+						origElem := elem
+						if l := len("sync"); len(elem) >= l && elem[0:l] == "sync" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch method {
+							case "POST":
+								r.name = SyncUsersOperation
+								r.summary = "traQグループからユーザー情報を同期"
+								r.operationID = "syncUsers"
+								r.operationGroup = ""
+								r.pathPattern = "/users/sync"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+					// Param: "traqId"
+					// Leaf parameter, slashes are prohibited
+					idx := strings.IndexByte(elem, '/')
+					if idx >= 0 {
+						break
+					}
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch method {
+						case "DELETE":
+							r.name = DeleteUserOperation
+							r.summary = "ユーザー削除"
+							r.operationID = "deleteUser"
+							r.operationGroup = ""
+							r.pathPattern = "/users/{traqId}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "GET":
+							r.name = GetUserOperation
+							r.summary = "ユーザー情報取得"
+							r.operationID = "getUser"
+							r.operationGroup = ""
+							r.pathPattern = "/users/{traqId}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PATCH":
+							r.name = UpdateUserOperation
+							r.summary = "ユーザーのロール変更"
+							r.operationID = "updateUser"
+							r.operationGroup = ""
+							r.pathPattern = "/users/{traqId}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+				}
 
 			}
 
@@ -9,12 +9,555 @@ import (
 	"github.com/go-faster/errors"
 )
 
+// 個人用APIトークン.
+// Ref: #/components/schemas/APIToken
+type APIToken struct {
+	ID        int64         `json:"id"`
+	Name      string        `json:"name"`
+	Scope     APITokenScope `json:"scope"`
+	ExpiresAt time.Time     `json:"expires_at"`
+	// 最後に使われた日時 (未使用の場合はなし).
+	LastUsedAt OptDateTime `json:"last_used_at"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *APIToken) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *APIToken) GetName() string {
+	return s.Name
+}
+
+// GetScope returns the value of Scope.
+func (s *APIToken) GetScope() APITokenScope {
+	return s.Scope
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *APIToken) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *APIToken) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *APIToken) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *APIToken) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *APIToken) SetName(val string) {
+	s.Name = val
+}
+
+// SetScope sets the value of Scope.
+func (s *APIToken) SetScope(val APITokenScope) {
+	s.Scope = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *APIToken) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *APIToken) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *APIToken) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/APITokenCreate
+type APITokenCreate struct {
+	// 用途がわかる名前 (例: weekly export).
+	Name  string        `json:"name"`
+	Scope APITokenScope `json:"scope"`
+	// 有効期限 (現在より後であること).
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// GetName returns the value of Name.
+func (s *APITokenCreate) GetName() string {
+	return s.Name
+}
+
+// GetScope returns the value of Scope.
+func (s *APITokenCreate) GetScope() APITokenScope {
+	return s.Scope
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *APITokenCreate) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// SetName sets the value of Name.
+func (s *APITokenCreate) SetName(val string) {
+	s.Name = val
+}
+
+// SetScope sets the value of Scope.
+func (s *APITokenCreate) SetScope(val APITokenScope) {
+	s.Scope = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *APITokenCreate) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// APIトークンのスコープ。
+// - read: 読み取りのみ
+// - tickets:write: チケット・ノート・レビューの作成・編集・削除
+// (ゴミ箱からの復元・完全削除を除く)
+// - admin: すべての操作
+// (設定・ユーザーの変更、ゴミ箱からの復元・完全削除、トークンの発行・削除を含む)
+// いずれのスコープでも、トークンを発行したユーザーの権限を超える操作はできない。.
+// Ref: #/components/schemas/APITokenScope
+type APITokenScope string
+
+const (
+	APITokenScopeRead         APITokenScope = "read"
+	APITokenScopeTicketsWrite APITokenScope = "tickets:write"
+	APITokenScopeAdmin        APITokenScope = "admin"
+)
+
+// AllValues returns all APITokenScope values.
+func (APITokenScope) AllValues() []APITokenScope {
+	return []APITokenScope{
+		APITokenScopeRead,
+		APITokenScopeTicketsWrite,
+		APITokenScopeAdmin,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s APITokenScope) MarshalText() ([]byte, error) {
+	switch s {
+	case APITokenScopeRead:
+		return []byte(s), nil
+	case APITokenScopeTicketsWrite:
+		return []byte(s), nil
+	case APITokenScopeAdmin:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *APITokenScope) UnmarshalText(data []byte) error {
+	switch APITokenScope(data) {
+	case APITokenScopeRead:
+		*s = APITokenScopeRead
+		return nil
+	case APITokenScopeTicketsWrite:
+		*s = APITokenScopeTicketsWrite
+		return nil
+	case APITokenScopeAdmin:
+		*s = APITokenScopeAdmin
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// ノートが送信待ちになる条件と、ロールごとの承認の重みの上限。
+// チケットのタグに一致するルールがあればdefaultの代わりに使う。複数のタグが該当する場合は先に書かれたものを優先する。
+// 更新時に省略した場合は現在の設定が維持される。.
+// Ref: #/components/schemas/ApprovalPolicy
+type ApprovalPolicy struct {
+	Default ApprovalRule `json:"default"`
+	// タグごとのルール。同じタグを複数回指定することはできない.
+	TagRules []ApprovalPolicyTagRulesItem `json:"tag_rules"`
+}
+
+// GetDefault returns the value of Default.
+func (s *ApprovalPolicy) GetDefault() ApprovalRule {
+	return s.Default
+}
+
+// GetTagRules returns the value of TagRules.
+func (s *ApprovalPolicy) GetTagRules() []ApprovalPolicyTagRulesItem {
+	return s.TagRules
+}
+
+// SetDefault sets the value of Default.
+func (s *ApprovalPolicy) SetDefault(val ApprovalRule) {
+	s.Default = val
+}
+
+// SetTagRules sets the value of TagRules.
+func (s *ApprovalPolicy) SetTagRules(val []ApprovalPolicyTagRulesItem) {
+	s.TagRules = val
+}
+
+type ApprovalPolicyTagRulesItem struct {
+	Tag  string       `json:"tag"`
+	Rule ApprovalRule `json:"rule"`
+}
+
+// GetTag returns the value of Tag.
+func (s *ApprovalPolicyTagRulesItem) GetTag() string {
+	return s.Tag
+}
+
+// GetRule returns the value of Rule.
+func (s *ApprovalPolicyTagRulesItem) GetRule() ApprovalRule {
+	return s.Rule
+}
+
+// SetTag sets the value of Tag.
+func (s *ApprovalPolicyTagRulesItem) SetTag(val string) {
+	s.Tag = val
+}
+
+// SetRule sets the value of Rule.
+func (s *ApprovalPolicyTagRulesItem) SetRule(val ApprovalRule) {
+	s.Rule = val
+}
+
+// Ref: #/components/schemas/ApprovalRule
+type ApprovalRule struct {
+	// 送信待ちになるために必要な承認の重みの合計.
+	Threshold int `json:"threshold"`
+	// ロールごとの承認の重みの上限。少なくとも1つのロールは1以上にする.
+	MaxWeights ApprovalRuleMaxWeights `json:"max_weights"`
+	// Trueの場合、本職の承認が1件以上必要 (本職の重みの上限は1以上にする).
+	RequireManagerApproval bool `json:"require_manager_approval"`
+	// Trueの場合、有効な修正依頼(change_request)が残っている間は送信待ちにならない.
+	BlockOnChangeRequests bool `json:"block_on_change_requests"`
+}
+
+// GetThreshold returns the value of Threshold.
+func (s *ApprovalRule) GetThreshold() int {
+	return s.Threshold
+}
+
+// GetMaxWeights returns the value of MaxWeights.
+func (s *ApprovalRule) GetMaxWeights() ApprovalRuleMaxWeights {
+	return s.MaxWeights
+}
+
+// GetRequireManagerApproval returns the value of RequireManagerApproval.
+func (s *ApprovalRule) GetRequireManagerApproval() bool {
+	return s.RequireManagerApproval
+}
+
+// GetBlockOnChangeRequests returns the value of BlockOnChangeRequests.
+func (s *ApprovalRule) GetBlockOnChangeRequests() bool {
+	return s.BlockOnChangeRequests
+}
+
+// SetThreshold sets the value of Threshold.
+func (s *ApprovalRule) SetThreshold(val int) {
+	s.Threshold = val
+}
+
+// SetMaxWeights sets the value of MaxWeights.
+func (s *ApprovalRule) SetMaxWeights(val ApprovalRuleMaxWeights) {
+	s.MaxWeights = val
+}
+
+// SetRequireManagerApproval sets the value of RequireManagerApproval.
+func (s *ApprovalRule) SetRequireManagerApproval(val bool) {
+	s.RequireManagerApproval = val
+}
+
+// SetBlockOnChangeRequests sets the value of BlockOnChangeRequests.
+func (s *ApprovalRule) SetBlockOnChangeRequests(val bool) {
+	s.BlockOnChangeRequests = val
+}
+
+// ロールごとの承認の重みの上限。少なくとも1つのロールは1以上にする.
+type ApprovalRuleMaxWeights struct {
+	Manager   int `json:"manager"`
+	Assistant int `json:"assistant"`
+	Member    int `json:"member"`
+}
+
+// GetManager returns the value of Manager.
+func (s *ApprovalRuleMaxWeights) GetManager() int {
+	return s.Manager
+}
+
+// GetAssistant returns the value of Assistant.
+func (s *ApprovalRuleMaxWeights) GetAssistant() int {
+	return s.Assistant
+}
+
+// GetMember returns the value of Member.
+func (s *ApprovalRuleMaxWeights) GetMember() int {
+	return s.Member
+}
+
+// SetManager sets the value of Manager.
+func (s *ApprovalRuleMaxWeights) SetManager(val int) {
+	s.Manager = val
+}
+
+// SetAssistant sets the value of Assistant.
+func (s *ApprovalRuleMaxWeights) SetAssistant(val int) {
+	s.Assistant = val
+}
+
+// SetMember sets the value of Member.
+func (s *ApprovalRuleMaxWeights) SetMember(val int) {
+	s.Member = val
+}
+
+// AuthCallbackBadRequest is response for AuthCallback operation.
+type AuthCallbackBadRequest struct{}
+
+func (*AuthCallbackBadRequest) authCallbackRes() {}
+
+// AuthCallbackFound is response for AuthCallback operation.
+type AuthCallbackFound struct {
+	Location  OptString
+	SetCookie OptString
+}
+
+// GetLocation returns the value of Location.
+func (s *AuthCallbackFound) GetLocation() OptString {
+	return s.Location
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *AuthCallbackFound) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// SetLocation sets the value of Location.
+func (s *AuthCallbackFound) SetLocation(val OptString) {
+	s.Location = val
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *AuthCallbackFound) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+func (*AuthCallbackFound) authCallbackRes() {}
+
+// AuthCallbackUnauthorized is response for AuthCallback operation.
+type AuthCallbackUnauthorized struct{}
+
+func (*AuthCallbackUnauthorized) authCallbackRes() {}
+
+type BearerAuth struct {
+	Token string
+	Roles []string
+}
+
+// GetToken returns the value of Token.
+func (s *BearerAuth) GetToken() string {
+	return s.Token
+}
+
+// GetRoles returns the value of Roles.
+func (s *BearerAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetToken sets the value of Token.
+func (s *BearerAuth) SetToken(val string) {
+	s.Token = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *BearerAuth) SetRoles(val []string) {
+	s.Roles = val
+}
+
+// CancelNoteConflict is response for CancelNote operation.
+type CancelNoteConflict struct{}
+
+func (*CancelNoteConflict) cancelNoteRes() {}
+
+// CancelNoteForbidden is response for CancelNote operation.
+type CancelNoteForbidden struct{}
+
+func (*CancelNoteForbidden) cancelNoteRes() {}
+
+// CancelNoteNotFound is response for CancelNote operation.
+type CancelNoteNotFound struct{}
+
+func (*CancelNoteNotFound) cancelNoteRes() {}
+
+// 伏字のまま編集したテキストの伏字 (!!■■■!! または !!category:■■■!!)
+// の数またはカテゴリが、元のテキストの閲覧できない伏字と合わず、どの伏字を残すか決められない.
+// Ref: #/components/schemas/CensorConflict
+type CensorConflict struct {
+	Message string `json:"message"`
+	// 伏字の数またはカテゴリが合わないフィールド.
+	Field string `json:"field"`
+	// 元のテキストの閲覧できない伏字の数.
+	ExpectedPlaceholders int `json:"expected_placeholders"`
+	// 編集後のテキストに含まれる伏字の置換後の文字列の数.
+	ActualPlaceholders int `json:"actual_placeholders"`
+}
+
+// GetMessage returns the value of Message.
+func (s *CensorConflict) GetMessage() string {
+	return s.Message
+}
+
+// GetField returns the value of Field.
+func (s *CensorConflict) GetField() string {
+	return s.Field
+}
+
+// GetExpectedPlaceholders returns the value of ExpectedPlaceholders.
+func (s *CensorConflict) GetExpectedPlaceholders() int {
+	return s.ExpectedPlaceholders
+}
+
+// GetActualPlaceholders returns the value of ActualPlaceholders.
+func (s *CensorConflict) GetActualPlaceholders() int {
+	return s.ActualPlaceholders
+}
+
+// SetMessage sets the value of Message.
+func (s *CensorConflict) SetMessage(val string) {
+	s.Message = val
+}
+
+// SetField sets the value of Field.
+func (s *CensorConflict) SetField(val string) {
+	s.Field = val
+}
+
+// SetExpectedPlaceholders sets the value of ExpectedPlaceholders.
+func (s *CensorConflict) SetExpectedPlaceholders(val int) {
+	s.ExpectedPlaceholders = val
+}
+
+// SetActualPlaceholders sets the value of ActualPlaceholders.
+func (s *CensorConflict) SetActualPlaceholders(val int) {
+	s.ActualPlaceholders = val
+}
+
+// 伏字のカテゴリ (!!category:text!!) を閲覧できるユーザー。
+// 本職は常にすべての伏字を閲覧できる。カテゴリのない伏字 (!!text!!)
+// とルールのないカテゴリは本職のみ閲覧できる。.
+// Ref: #/components/schemas/CensorPolicyRule
+type CensorPolicyRule struct {
+	// 伏字のカテゴリ名 (例: price, contact).
+	Category string `json:"category"`
+	// 閲覧できるロール.
+	Roles []CensorPolicyRuleRolesItem `json:"roles"`
+	// チケットの担当者・副担当者であればロールに関わらず閲覧できるか.
+	Assignees bool `json:"assignees"`
+}
+
+// GetCategory returns the value of Category.
+func (s *CensorPolicyRule) GetCategory() string {
+	return s.Category
+}
+
+// GetRoles returns the value of Roles.
+func (s *CensorPolicyRule) GetRoles() []CensorPolicyRuleRolesItem {
+	return s.Roles
+}
+
+// GetAssignees returns the value of Assignees.
+func (s *CensorPolicyRule) GetAssignees() bool {
+	return s.Assignees
+}
+
+// SetCategory sets the value of Category.
+func (s *CensorPolicyRule) SetCategory(val string) {
+	s.Category = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *CensorPolicyRule) SetRoles(val []CensorPolicyRuleRolesItem) {
+	s.Roles = val
+}
+
+// SetAssignees sets the value of Assignees.
+func (s *CensorPolicyRule) SetAssignees(val bool) {
+	s.Assignees = val
+}
+
+type CensorPolicyRuleRolesItem string
+
+const (
+	CensorPolicyRuleRolesItemManager   CensorPolicyRuleRolesItem = "manager"
+	CensorPolicyRuleRolesItemAssistant CensorPolicyRuleRolesItem = "assistant"
+	CensorPolicyRuleRolesItemMember    CensorPolicyRuleRolesItem = "member"
+)
+
+// AllValues returns all CensorPolicyRuleRolesItem values.
+func (CensorPolicyRuleRolesItem) AllValues() []CensorPolicyRuleRolesItem {
+	return []CensorPolicyRuleRolesItem{
+		CensorPolicyRuleRolesItemManager,
+		CensorPolicyRuleRolesItemAssistant,
+		CensorPolicyRuleRolesItemMember,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s CensorPolicyRuleRolesItem) MarshalText() ([]byte, error) {
+	switch s {
+	case CensorPolicyRuleRolesItemManager:
+		return []byte(s), nil
+	case CensorPolicyRuleRolesItemAssistant:
+		return []byte(s), nil
+	case CensorPolicyRuleRolesItemMember:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *CensorPolicyRuleRolesItem) UnmarshalText(data []byte) error {
+	switch CensorPolicyRuleRolesItem(data) {
+	case CensorPolicyRuleRolesItemManager:
+		*s = CensorPolicyRuleRolesItemManager
+		return nil
+	case CensorPolicyRuleRolesItemAssistant:
+		*s = CensorPolicyRuleRolesItemAssistant
+		return nil
+	case CensorPolicyRuleRolesItemMember:
+		*s = CensorPolicyRuleRolesItemMember
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
 // Ref: #/components/schemas/Config
 type Config struct {
 	// リマインドのタイミング設定.
 	ReminderInterval ConfigReminderInterval `json:"reminder_interval"`
 	// レビュアーに渡すリビジョン指示テキスト.
-	RevisePrompt string `json:"revise_prompt"`
+	RevisePrompt   string            `json:"revise_prompt"`
+	DuePolicy      OptDuePolicy      `json:"due_policy"`
+	ApprovalPolicy OptApprovalPolicy `json:"approval_policy"`
+	// 削除したチケットを完全に削除するまでの日数
+	// (0の場合は自動で削除しない)。
+	// 更新時に省略した場合は現在の設定が維持される。.
+	TrashRetentionDays OptInt `json:"trash_retention_days"`
+	// チケット・ノートの作成・更新時に検出した個人情報を自動で伏字
+	// (!!kind:text!!) にするか (falseの場合は警告のみ)。
+	// 更新時に省略した場合は現在の設定が維持される。.
+	PiiAutoCensor OptBool `json:"pii_auto_censor"`
 }
 
 // GetReminderInterval returns the value of ReminderInterval.
@@ -27,6 +570,26 @@ func (s *Config) GetRevisePrompt() string {
 	return s.RevisePrompt
 }
 
+// GetDuePolicy returns the value of DuePolicy.
+func (s *Config) GetDuePolicy() OptDuePolicy {
+	return s.DuePolicy
+}
+
+// GetApprovalPolicy returns the value of ApprovalPolicy.
+func (s *Config) GetApprovalPolicy() OptApprovalPolicy {
+	return s.ApprovalPolicy
+}
+
+// GetTrashRetentionDays returns the value of TrashRetentionDays.
+func (s *Config) GetTrashRetentionDays() OptInt {
+	return s.TrashRetentionDays
+}
+
+// GetPiiAutoCensor returns the value of PiiAutoCensor.
+func (s *Config) GetPiiAutoCensor() OptBool {
+	return s.PiiAutoCensor
+}
+
 // SetReminderInterval sets the value of ReminderInterval.
 func (s *Config) SetReminderInterval(val ConfigReminderInterval) {
 	s.ReminderInterval = val
@@ -37,6 +600,26 @@ func (s *Config) SetRevisePrompt(val string) {
 	s.RevisePrompt = val
 }
 
+// SetDuePolicy sets the value of DuePolicy.
+func (s *Config) SetDuePolicy(val OptDuePolicy) {
+	s.DuePolicy = val
+}
+
+// SetApprovalPolicy sets the value of ApprovalPolicy.
+func (s *Config) SetApprovalPolicy(val OptApprovalPolicy) {
+	s.ApprovalPolicy = val
+}
+
+// SetTrashRetentionDays sets the value of TrashRetentionDays.
+func (s *Config) SetTrashRetentionDays(val OptInt) {
+	s.TrashRetentionDays = val
+}
+
+// SetPiiAutoCensor sets the value of PiiAutoCensor.
+func (s *Config) SetPiiAutoCensor(val OptBool) {
+	s.PiiAutoCensor = val
+}
+
 func (*Config) configGetRes()  {}
 func (*Config) configPostRes() {}
 
@@ -45,6 +628,11 @@ type ConfigGetForbidden struct{}
 
 func (*ConfigGetForbidden) configGetRes() {}
 
+// ConfigPostBadRequest is response for ConfigPost operation.
+type ConfigPostBadRequest struct{}
+
+func (*ConfigPostBadRequest) configPostRes() {}
+
 // ConfigPostForbidden is response for ConfigPost operation.
 type ConfigPostForbidden struct{}
 
@@ -78,6 +666,11 @@ func (s *ConfigReminderInterval) SetNotesentHour(val int) {
 	s.NotesentHour = val
 }
 
+// CreateMyTokenBadRequest is response for CreateMyToken operation.
+type CreateMyTokenBadRequest struct{}
+
+func (*CreateMyTokenBadRequest) createMyTokenRes() {}
+
 // CreateReviewBadRequest is response for CreateReview operation.
 type CreateReviewBadRequest struct{}
 
@@ -88,6 +681,11 @@ type CreateReviewConflict struct{}
 
 func (*CreateReviewConflict) createReviewRes() {}
 
+// CreateReviewForbidden is response for CreateReview operation.
+type CreateReviewForbidden struct{}
+
+func (*CreateReviewForbidden) createReviewRes() {}
+
 // CreateReviewNotFound is response for CreateReview operation.
 type CreateReviewNotFound struct{}
 
@@ -144,14 +742,18 @@ type CreateTicketReq struct {
 	Title       string       `json:"title"`
 	Description OptString    `json:"description"`
 	Status      TicketStatus `json:"status"`
+	// Trueの場合、ステータスを自動更新しない.
+	ManualStatus OptBool `json:"manual_status"`
 	// 主担当 (traQ ID).
 	Assignee string `json:"assignee"`
 	// 副担当リスト (traQ ID).
 	SubAssignees []string `json:"sub_assignees"`
 	// その他関係者 (traQ ID).
 	Stakeholders []string `json:"stakeholders"`
-	Due          OptDate  `json:"due"`
-	Tags         []string `json:"tags"`
+	// 期日。未指定時は設定のdue_policyに従って自動設定される.
+	Due        OptDate             `json:"due"`
+	Tags       []string            `json:"tags"`
+	Visibility OptTicketVisibility `json:"visibility"`
 }
 
 // GetTitle returns the value of Title.
@@ -169,6 +771,11 @@ func (s *CreateTicketReq) GetStatus() TicketStatus {
 	return s.Status
 }
 
+// GetManualStatus returns the value of ManualStatus.
+func (s *CreateTicketReq) GetManualStatus() OptBool {
+	return s.ManualStatus
+}
+
 // GetAssignee returns the value of Assignee.
 func (s *CreateTicketReq) GetAssignee() string {
 	return s.Assignee
@@ -194,6 +801,11 @@ func (s *CreateTicketReq) GetTags() []string {
 	return s.Tags
 }
 
+// GetVisibility returns the value of Visibility.
+func (s *CreateTicketReq) GetVisibility() OptTicketVisibility {
+	return s.Visibility
+}
+
 // SetTitle sets the value of Title.
 func (s *CreateTicketReq) SetTitle(val string) {
 	s.Title = val
@@ -209,6 +821,11 @@ func (s *CreateTicketReq) SetStatus(val TicketStatus) {
 	s.Status = val
 }
 
+// SetManualStatus sets the value of ManualStatus.
+func (s *CreateTicketReq) SetManualStatus(val OptBool) {
+	s.ManualStatus = val
+}
+
 // SetAssignee sets the value of Assignee.
 func (s *CreateTicketReq) SetAssignee(val string) {
 	s.Assignee = val
@@ -234,11 +851,122 @@ func (s *CreateTicketReq) SetTags(val []string) {
 	s.Tags = val
 }
 
+// SetVisibility sets the value of Visibility.
+func (s *CreateTicketReq) SetVisibility(val OptTicketVisibility) {
+	s.Visibility = val
+}
+
 // CreateTicketUnauthorized is response for CreateTicket operation.
 type CreateTicketUnauthorized struct{}
 
 func (*CreateTicketUnauthorized) createTicketRes() {}
 
+// CreateUserConflict is response for CreateUser operation.
+type CreateUserConflict struct{}
+
+func (*CreateUserConflict) createUserRes() {}
+
+// CreateUserForbidden is response for CreateUser operation.
+type CreateUserForbidden struct{}
+
+func (*CreateUserForbidden) createUserRes() {}
+
+// Merged schema.
+// Ref: #/components/schemas/CreatedAPIToken
+type CreatedAPIToken struct {
+	ID        int64         `json:"id"`
+	Name      string        `json:"name"`
+	Scope     APITokenScope `json:"scope"`
+	ExpiresAt time.Time     `json:"expires_at"`
+	// 最後に使われた日時 (未使用の場合はなし).
+	LastUsedAt OptDateTime `json:"last_used_at"`
+	CreatedAt  time.Time   `json:"created_at"`
+	// Authorization: Bearer に指定するトークン。発行時のみ返す.
+	Token string `json:"token"`
+}
+
+// GetID returns the value of ID.
+func (s *CreatedAPIToken) GetID() int64 {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *CreatedAPIToken) GetName() string {
+	return s.Name
+}
+
+// GetScope returns the value of Scope.
+func (s *CreatedAPIToken) GetScope() APITokenScope {
+	return s.Scope
+}
+
+// GetExpiresAt returns the value of ExpiresAt.
+func (s *CreatedAPIToken) GetExpiresAt() time.Time {
+	return s.ExpiresAt
+}
+
+// GetLastUsedAt returns the value of LastUsedAt.
+func (s *CreatedAPIToken) GetLastUsedAt() OptDateTime {
+	return s.LastUsedAt
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *CreatedAPIToken) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetToken returns the value of Token.
+func (s *CreatedAPIToken) GetToken() string {
+	return s.Token
+}
+
+// SetID sets the value of ID.
+func (s *CreatedAPIToken) SetID(val int64) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *CreatedAPIToken) SetName(val string) {
+	s.Name = val
+}
+
+// SetScope sets the value of Scope.
+func (s *CreatedAPIToken) SetScope(val APITokenScope) {
+	s.Scope = val
+}
+
+// SetExpiresAt sets the value of ExpiresAt.
+func (s *CreatedAPIToken) SetExpiresAt(val time.Time) {
+	s.ExpiresAt = val
+}
+
+// SetLastUsedAt sets the value of LastUsedAt.
+func (s *CreatedAPIToken) SetLastUsedAt(val OptDateTime) {
+	s.LastUsedAt = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *CreatedAPIToken) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetToken sets the value of Token.
+func (s *CreatedAPIToken) SetToken(val string) {
+	s.Token = val
+}
+
+func (*CreatedAPIToken) createMyTokenRes() {}
+
+// DeleteMyTokenNoContent is response for DeleteMyToken operation.
+type DeleteMyTokenNoContent struct{}
+
+func (*DeleteMyTokenNoContent) deleteMyTokenRes() {}
+
+// DeleteMyTokenNotFound is response for DeleteMyToken operation.
+type DeleteMyTokenNotFound struct{}
+
+func (*DeleteMyTokenNotFound) deleteMyTokenRes() {}
+
 // DeleteReviewForbidden is response for DeleteReview operation.
 type DeleteReviewForbidden struct{}
 
@@ -274,6 +1002,245 @@ type DeleteTicketByIDUnauthorized struct{}
 
 func (*DeleteTicketByIDUnauthorized) deleteTicketByIDRes() {}
 
+// DeleteUserConflict is response for DeleteUser operation.
+type DeleteUserConflict struct{}
+
+func (*DeleteUserConflict) deleteUserRes() {}
+
+// DeleteUserForbidden is response for DeleteUser operation.
+type DeleteUserForbidden struct{}
+
+func (*DeleteUserForbidden) deleteUserRes() {}
+
+// DeleteUserNoContent is response for DeleteUser operation.
+type DeleteUserNoContent struct{}
+
+func (*DeleteUserNoContent) deleteUserRes() {}
+
+// DeleteUserNotFound is response for DeleteUser operation.
+type DeleteUserNotFound struct{}
+
+func (*DeleteUserNotFound) deleteUserRes() {}
+
+// Ref: #/components/schemas/DiffLine
+type DiffLine struct {
+	// Equal: 変更なし, insert: 追加, delete: 削除.
+	Type DiffLineType `json:"type"`
+	Text string       `json:"text"`
+	// 書き換えられた行の文字単位の差分。連続する削除と追加の行を先頭から順に対応させる。
+	// 削除された行はequalとdelete、追加された行はequalとinsertの区間からなる。
+	// 対応する行がない場合は省略される。.
+	Segments []DiffSegment `json:"segments"`
+}
+
+// GetType returns the value of Type.
+func (s *DiffLine) GetType() DiffLineType {
+	return s.Type
+}
+
+// GetText returns the value of Text.
+func (s *DiffLine) GetText() string {
+	return s.Text
+}
+
+// GetSegments returns the value of Segments.
+func (s *DiffLine) GetSegments() []DiffSegment {
+	return s.Segments
+}
+
+// SetType sets the value of Type.
+func (s *DiffLine) SetType(val DiffLineType) {
+	s.Type = val
+}
+
+// SetText sets the value of Text.
+func (s *DiffLine) SetText(val string) {
+	s.Text = val
+}
+
+// SetSegments sets the value of Segments.
+func (s *DiffLine) SetSegments(val []DiffSegment) {
+	s.Segments = val
+}
+
+// Equal: 変更なし, insert: 追加, delete: 削除.
+type DiffLineType string
+
+const (
+	DiffLineTypeEqual  DiffLineType = "equal"
+	DiffLineTypeInsert DiffLineType = "insert"
+	DiffLineTypeDelete DiffLineType = "delete"
+)
+
+// AllValues returns all DiffLineType values.
+func (DiffLineType) AllValues() []DiffLineType {
+	return []DiffLineType{
+		DiffLineTypeEqual,
+		DiffLineTypeInsert,
+		DiffLineTypeDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s DiffLineType) MarshalText() ([]byte, error) {
+	switch s {
+	case DiffLineTypeEqual:
+		return []byte(s), nil
+	case DiffLineTypeInsert:
+		return []byte(s), nil
+	case DiffLineTypeDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DiffLineType) UnmarshalText(data []byte) error {
+	switch DiffLineType(data) {
+	case DiffLineTypeEqual:
+		*s = DiffLineTypeEqual
+		return nil
+	case DiffLineTypeInsert:
+		*s = DiffLineTypeInsert
+		return nil
+	case DiffLineTypeDelete:
+		*s = DiffLineTypeDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/DiffSegment
+type DiffSegment struct {
+	Type DiffSegmentType `json:"type"`
+	Text string          `json:"text"`
+}
+
+// GetType returns the value of Type.
+func (s *DiffSegment) GetType() DiffSegmentType {
+	return s.Type
+}
+
+// GetText returns the value of Text.
+func (s *DiffSegment) GetText() string {
+	return s.Text
+}
+
+// SetType sets the value of Type.
+func (s *DiffSegment) SetType(val DiffSegmentType) {
+	s.Type = val
+}
+
+// SetText sets the value of Text.
+func (s *DiffSegment) SetText(val string) {
+	s.Text = val
+}
+
+type DiffSegmentType string
+
+const (
+	DiffSegmentTypeEqual  DiffSegmentType = "equal"
+	DiffSegmentTypeInsert DiffSegmentType = "insert"
+	DiffSegmentTypeDelete DiffSegmentType = "delete"
+)
+
+// AllValues returns all DiffSegmentType values.
+func (DiffSegmentType) AllValues() []DiffSegmentType {
+	return []DiffSegmentType{
+		DiffSegmentTypeEqual,
+		DiffSegmentTypeInsert,
+		DiffSegmentTypeDelete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s DiffSegmentType) MarshalText() ([]byte, error) {
+	switch s {
+	case DiffSegmentTypeEqual:
+		return []byte(s), nil
+	case DiffSegmentTypeInsert:
+		return []byte(s), nil
+	case DiffSegmentTypeDelete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *DiffSegmentType) UnmarshalText(data []byte) error {
+	switch DiffSegmentType(data) {
+	case DiffSegmentTypeEqual:
+		*s = DiffSegmentTypeEqual
+		return nil
+	case DiffSegmentTypeInsert:
+		*s = DiffSegmentTypeInsert
+		return nil
+	case DiffSegmentTypeDelete:
+		*s = DiffSegmentTypeDelete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// 期日未指定でチケットを作成した際の期日の自動設定ルール。
+// 更新時に省略した場合は現在の設定が維持される。.
+// Ref: #/components/schemas/DuePolicy
+type DuePolicy struct {
+	// 作成日から期日までの営業日数 (0の場合は自動設定しない).
+	DefaultBusinessDays int `json:"default_business_days"`
+	// タグごとの営業日数。複数のタグが該当する場合は先に書かれたものを優先する.
+	TagBusinessDays []DuePolicyTagBusinessDaysItem `json:"tag_business_days"`
+}
+
+// GetDefaultBusinessDays returns the value of DefaultBusinessDays.
+func (s *DuePolicy) GetDefaultBusinessDays() int {
+	return s.DefaultBusinessDays
+}
+
+// GetTagBusinessDays returns the value of TagBusinessDays.
+func (s *DuePolicy) GetTagBusinessDays() []DuePolicyTagBusinessDaysItem {
+	return s.TagBusinessDays
+}
+
+// SetDefaultBusinessDays sets the value of DefaultBusinessDays.
+func (s *DuePolicy) SetDefaultBusinessDays(val int) {
+	s.DefaultBusinessDays = val
+}
+
+// SetTagBusinessDays sets the value of TagBusinessDays.
+func (s *DuePolicy) SetTagBusinessDays(val []DuePolicyTagBusinessDaysItem) {
+	s.TagBusinessDays = val
+}
+
+type DuePolicyTagBusinessDaysItem struct {
+	Tag          string `json:"tag"`
+	BusinessDays int    `json:"business_days"`
+}
+
+// GetTag returns the value of Tag.
+func (s *DuePolicyTagBusinessDaysItem) GetTag() string {
+	return s.Tag
+}
+
+// GetBusinessDays returns the value of BusinessDays.
+func (s *DuePolicyTagBusinessDaysItem) GetBusinessDays() int {
+	return s.BusinessDays
+}
+
+// SetTag sets the value of Tag.
+func (s *DuePolicyTagBusinessDaysItem) SetTag(val string) {
+	s.Tag = val
+}
+
+// SetBusinessDays sets the value of BusinessDays.
+func (s *DuePolicyTagBusinessDaysItem) SetBusinessDays(val int) {
+	s.BusinessDays = val
+}
+
 // Ref: #/components/schemas/Error
 type Error struct {
 	// エラーメッセージ.
@@ -316,22 +1283,133 @@ func (s *ErrorResponseStatusCode) SetResponse(val Error) {
 	s.Response = val
 }
 
+func (*ErrorResponseStatusCode) authCallbackRes()                     {}
+func (*ErrorResponseStatusCode) cancelNoteRes()                       {}
 func (*ErrorResponseStatusCode) configGetRes()                        {}
 func (*ErrorResponseStatusCode) configPostRes()                       {}
+func (*ErrorResponseStatusCode) createMyTokenRes()                    {}
 func (*ErrorResponseStatusCode) createReviewRes()                     {}
 func (*ErrorResponseStatusCode) createTicketRes()                     {}
+func (*ErrorResponseStatusCode) createUserRes()                       {}
+func (*ErrorResponseStatusCode) deleteMyTokenRes()                    {}
 func (*ErrorResponseStatusCode) deleteReviewRes()                     {}
 func (*ErrorResponseStatusCode) deleteTicketByIDRes()                 {}
+func (*ErrorResponseStatusCode) deleteUserRes()                       {}
+func (*ErrorResponseStatusCode) getCensorPolicyRes()                  {}
+func (*ErrorResponseStatusCode) getMyTokensRes()                      {}
+func (*ErrorResponseStatusCode) getNoteRevisionDiffRes()              {}
+func (*ErrorResponseStatusCode) getNoteRevisionsRes()                 {}
+func (*ErrorResponseStatusCode) getReviewDiffRes()                    {}
+func (*ErrorResponseStatusCode) getRoleChangesRes()                   {}
 func (*ErrorResponseStatusCode) getTicketByIDRes()                    {}
+func (*ErrorResponseStatusCode) getTicketHistoryRes()                 {}
+func (*ErrorResponseStatusCode) getTicketTransitionsRes()             {}
 func (*ErrorResponseStatusCode) getTicketsRes()                       {}
+func (*ErrorResponseStatusCode) getTrashedTicketsRes()                {}
+func (*ErrorResponseStatusCode) getUncensoredViewsRes()               {}
+func (*ErrorResponseStatusCode) getUserRes()                          {}
+func (*ErrorResponseStatusCode) loginRes()                            {}
+func (*ErrorResponseStatusCode) logoutRes()                           {}
+func (*ErrorResponseStatusCode) markNoteSentRes()                     {}
 func (*ErrorResponseStatusCode) meGetRes()                            {}
+func (*ErrorResponseStatusCode) purgeTicketRes()                      {}
+func (*ErrorResponseStatusCode) requestReviewsRes()                   {}
+func (*ErrorResponseStatusCode) restoreTicketRes()                    {}
+func (*ErrorResponseStatusCode) scanPIIRes()                          {}
+func (*ErrorResponseStatusCode) searchRes()                           {}
+func (*ErrorResponseStatusCode) submitNoteRes()                       {}
+func (*ErrorResponseStatusCode) syncUsersRes()                        {}
 func (*ErrorResponseStatusCode) ticketsTicketIdNotesNoteIdDeleteRes() {}
 func (*ErrorResponseStatusCode) ticketsTicketIdNotesNoteIdPutRes()    {}
 func (*ErrorResponseStatusCode) ticketsTicketIdNotesPostRes()         {}
+func (*ErrorResponseStatusCode) updateCensorPolicyRes()               {}
 func (*ErrorResponseStatusCode) updateReviewRes()                     {}
 func (*ErrorResponseStatusCode) updateTicketByIDRes()                 {}
+func (*ErrorResponseStatusCode) updateUserRes()                       {}
 func (*ErrorResponseStatusCode) usersGetRes()                         {}
 func (*ErrorResponseStatusCode) usersPutRes()                         {}
+func (*ErrorResponseStatusCode) withdrawNoteRes()                     {}
+
+// フィールドの変更前後の値 (リスト・日付・真偽値は文字列で表す).
+// Ref: #/components/schemas/FieldChange
+type FieldChange struct {
+	Field string `json:"field"`
+	// 変更前の値 (作成時は null).
+	Before NilString `json:"before"`
+	// 変更後の値 (削除時は null).
+	After NilString `json:"after"`
+}
+
+// GetField returns the value of Field.
+func (s *FieldChange) GetField() string {
+	return s.Field
+}
+
+// GetBefore returns the value of Before.
+func (s *FieldChange) GetBefore() NilString {
+	return s.Before
+}
+
+// GetAfter returns the value of After.
+func (s *FieldChange) GetAfter() NilString {
+	return s.After
+}
+
+// SetField sets the value of Field.
+func (s *FieldChange) SetField(val string) {
+	s.Field = val
+}
+
+// SetBefore sets the value of Before.
+func (s *FieldChange) SetBefore(val NilString) {
+	s.Before = val
+}
+
+// SetAfter sets the value of After.
+func (s *FieldChange) SetAfter(val NilString) {
+	s.After = val
+}
+
+// GetCensorPolicyForbidden is response for GetCensorPolicy operation.
+type GetCensorPolicyForbidden struct{}
+
+func (*GetCensorPolicyForbidden) getCensorPolicyRes() {}
+
+type GetCensorPolicyOKApplicationJSON []CensorPolicyRule
+
+func (*GetCensorPolicyOKApplicationJSON) getCensorPolicyRes() {}
+
+type GetMyTokensOKApplicationJSON []APIToken
+
+func (*GetMyTokensOKApplicationJSON) getMyTokensRes() {}
+
+// GetNoteRevisionDiffNotFound is response for GetNoteRevisionDiff operation.
+type GetNoteRevisionDiffNotFound struct{}
+
+func (*GetNoteRevisionDiffNotFound) getNoteRevisionDiffRes() {}
+
+// GetNoteRevisionsNotFound is response for GetNoteRevisions operation.
+type GetNoteRevisionsNotFound struct{}
+
+func (*GetNoteRevisionsNotFound) getNoteRevisionsRes() {}
+
+type GetNoteRevisionsOKApplicationJSON []NoteRevision
+
+func (*GetNoteRevisionsOKApplicationJSON) getNoteRevisionsRes() {}
+
+// GetReviewDiffNotFound is response for GetReviewDiff operation.
+type GetReviewDiffNotFound struct{}
+
+func (*GetReviewDiffNotFound) getReviewDiffRes() {}
+
+// GetRoleChangesForbidden is response for GetRoleChanges operation.
+type GetRoleChangesForbidden struct{}
+
+func (*GetRoleChangesForbidden) getRoleChangesRes() {}
+
+type GetRoleChangesOKApplicationJSON []RoleChange
+
+func (*GetRoleChangesOKApplicationJSON) getRoleChangesRes() {}
 
 // GetTicketByIDNotFound is response for GetTicketByID operation.
 type GetTicketByIDNotFound struct{}
@@ -353,12 +1431,17 @@ type GetTicketByIDOK struct {
 	// その他関係者リスト (traQ ID)。.
 	Stakeholders []string     `json:"stakeholders"`
 	Status       TicketStatus `json:"status"`
+	// Trueの場合、ノートやレビューの変更によるステータスの自動更新を行わない.
+	ManualStatus bool `json:"manual_status"`
 	// タグ (例: 協賛, 問い合わせ).
-	Tags []string `json:"tags"`
+	Tags       []string         `json:"tags"`
+	Visibility TicketVisibility `json:"visibility"`
 	// 期日。未指定時は自動設定される。.
 	Due       NilDate   `json:"due"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// 作成時に伏字になっていない個人情報を検出した場合のみ。検出結果.
+	PiiWarnings []PIIWarning `json:"pii_warnings"`
 	// このチケットに紐づくノート一覧.
 	Notes []Note `json:"notes"`
 }
@@ -398,11 +1481,21 @@ func (s *GetTicketByIDOK) GetStatus() TicketStatus {
 	return s.Status
 }
 
+// GetManualStatus returns the value of ManualStatus.
+func (s *GetTicketByIDOK) GetManualStatus() bool {
+	return s.ManualStatus
+}
+
 // GetTags returns the value of Tags.
 func (s *GetTicketByIDOK) GetTags() []string {
 	return s.Tags
 }
 
+// GetVisibility returns the value of Visibility.
+func (s *GetTicketByIDOK) GetVisibility() TicketVisibility {
+	return s.Visibility
+}
+
 // GetDue returns the value of Due.
 func (s *GetTicketByIDOK) GetDue() NilDate {
 	return s.Due
@@ -418,6 +1511,11 @@ func (s *GetTicketByIDOK) GetUpdatedAt() time.Time {
 	return s.UpdatedAt
 }
 
+// GetPiiWarnings returns the value of PiiWarnings.
+func (s *GetTicketByIDOK) GetPiiWarnings() []PIIWarning {
+	return s.PiiWarnings
+}
+
 // GetNotes returns the value of Notes.
 func (s *GetTicketByIDOK) GetNotes() []Note {
 	return s.Notes
@@ -458,11 +1556,21 @@ func (s *GetTicketByIDOK) SetStatus(val TicketStatus) {
 	s.Status = val
 }
 
+// SetManualStatus sets the value of ManualStatus.
+func (s *GetTicketByIDOK) SetManualStatus(val bool) {
+	s.ManualStatus = val
+}
+
 // SetTags sets the value of Tags.
 func (s *GetTicketByIDOK) SetTags(val []string) {
 	s.Tags = val
 }
 
+// SetVisibility sets the value of Visibility.
+func (s *GetTicketByIDOK) SetVisibility(val TicketVisibility) {
+	s.Visibility = val
+}
+
 // SetDue sets the value of Due.
 func (s *GetTicketByIDOK) SetDue(val NilDate) {
 	s.Due = val
@@ -478,6 +1586,11 @@ func (s *GetTicketByIDOK) SetUpdatedAt(val time.Time) {
 	s.UpdatedAt = val
 }
 
+// SetPiiWarnings sets the value of PiiWarnings.
+func (s *GetTicketByIDOK) SetPiiWarnings(val []PIIWarning) {
+	s.PiiWarnings = val
+}
+
 // SetNotes sets the value of Notes.
 func (s *GetTicketByIDOK) SetNotes(val []Note) {
 	s.Notes = val
@@ -490,14 +1603,73 @@ type GetTicketByIDUnauthorized struct{}
 
 func (*GetTicketByIDUnauthorized) getTicketByIDRes() {}
 
+// GetTicketHistoryNotFound is response for GetTicketHistory operation.
+type GetTicketHistoryNotFound struct{}
+
+func (*GetTicketHistoryNotFound) getTicketHistoryRes() {}
+
+type GetTicketHistoryOKApplicationJSON []TicketEvent
+
+func (*GetTicketHistoryOKApplicationJSON) getTicketHistoryRes() {}
+
+// GetTicketHistoryUnauthorized is response for GetTicketHistory operation.
+type GetTicketHistoryUnauthorized struct{}
+
+func (*GetTicketHistoryUnauthorized) getTicketHistoryRes() {}
+
+// GetTicketTransitionsNotFound is response for GetTicketTransitions operation.
+type GetTicketTransitionsNotFound struct{}
+
+func (*GetTicketTransitionsNotFound) getTicketTransitionsRes() {}
+
+// GetTicketTransitionsUnauthorized is response for GetTicketTransitions operation.
+type GetTicketTransitionsUnauthorized struct{}
+
+func (*GetTicketTransitionsUnauthorized) getTicketTransitionsRes() {}
+
 // GetTicketsBadRequest is response for GetTickets operation.
 type GetTicketsBadRequest struct{}
 
 func (*GetTicketsBadRequest) getTicketsRes() {}
 
-type GetTicketsOKApplicationJSON []Ticket
+// GetTicketsOKHeaders wraps []Ticket with response headers.
+type GetTicketsOKHeaders struct {
+	XNextCursor OptString
+	XTotalCount int
+	Response    []Ticket
+}
+
+// GetXNextCursor returns the value of XNextCursor.
+func (s *GetTicketsOKHeaders) GetXNextCursor() OptString {
+	return s.XNextCursor
+}
+
+// GetXTotalCount returns the value of XTotalCount.
+func (s *GetTicketsOKHeaders) GetXTotalCount() int {
+	return s.XTotalCount
+}
+
+// GetResponse returns the value of Response.
+func (s *GetTicketsOKHeaders) GetResponse() []Ticket {
+	return s.Response
+}
+
+// SetXNextCursor sets the value of XNextCursor.
+func (s *GetTicketsOKHeaders) SetXNextCursor(val OptString) {
+	s.XNextCursor = val
+}
+
+// SetXTotalCount sets the value of XTotalCount.
+func (s *GetTicketsOKHeaders) SetXTotalCount(val int) {
+	s.XTotalCount = val
+}
+
+// SetResponse sets the value of Response.
+func (s *GetTicketsOKHeaders) SetResponse(val []Ticket) {
+	s.Response = val
+}
 
-func (*GetTicketsOKApplicationJSON) getTicketsRes() {}
+func (*GetTicketsOKHeaders) getTicketsRes() {}
 
 type GetTicketsSort string
 
@@ -552,36 +1724,124 @@ type GetTicketsUnauthorized struct{}
 
 func (*GetTicketsUnauthorized) getTicketsRes() {}
 
-type MeGetOK struct {
-	// TraQ ID.
-	ID string `json:"id"`
-}
+// GetTrashedTicketsForbidden is response for GetTrashedTickets operation.
+type GetTrashedTicketsForbidden struct{}
 
-// GetID returns the value of ID.
-func (s *MeGetOK) GetID() string {
-	return s.ID
-}
+func (*GetTrashedTicketsForbidden) getTrashedTicketsRes() {}
 
-// SetID sets the value of ID.
-func (s *MeGetOK) SetID(val string) {
-	s.ID = val
-}
+type GetTrashedTicketsOKApplicationJSON []TrashedTicket
 
-func (*MeGetOK) meGetRes() {}
+func (*GetTrashedTicketsOKApplicationJSON) getTrashedTicketsRes() {}
 
-// MeGetUnauthorized is response for MeGet operation.
-type MeGetUnauthorized struct{}
+// GetTrashedTicketsUnauthorized is response for GetTrashedTickets operation.
+type GetTrashedTicketsUnauthorized struct{}
 
-func (*MeGetUnauthorized) meGetRes() {}
+func (*GetTrashedTicketsUnauthorized) getTrashedTicketsRes() {}
 
-// NewNilDate returns new NilDate with value set to v.
-func NewNilDate(v time.Time) NilDate {
-	return NilDate{
-		Value: v,
-	}
-}
+// GetUncensoredViewsForbidden is response for GetUncensoredViews operation.
+type GetUncensoredViewsForbidden struct{}
 
-// NilDate is nullable time.Time.
+func (*GetUncensoredViewsForbidden) getUncensoredViewsRes() {}
+
+type GetUncensoredViewsOKApplicationJSON []UncensoredView
+
+func (*GetUncensoredViewsOKApplicationJSON) getUncensoredViewsRes() {}
+
+// GetUserNotFound is response for GetUser operation.
+type GetUserNotFound struct{}
+
+func (*GetUserNotFound) getUserRes() {}
+
+// LoginFound is response for Login operation.
+type LoginFound struct {
+	Location  OptString
+	SetCookie OptString
+}
+
+// GetLocation returns the value of Location.
+func (s *LoginFound) GetLocation() OptString {
+	return s.Location
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *LoginFound) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// SetLocation sets the value of Location.
+func (s *LoginFound) SetLocation(val OptString) {
+	s.Location = val
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *LoginFound) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+func (*LoginFound) loginRes() {}
+
+// LogoutNoContent is response for Logout operation.
+type LogoutNoContent struct {
+	SetCookie OptString
+}
+
+// GetSetCookie returns the value of SetCookie.
+func (s *LogoutNoContent) GetSetCookie() OptString {
+	return s.SetCookie
+}
+
+// SetSetCookie sets the value of SetCookie.
+func (s *LogoutNoContent) SetSetCookie(val OptString) {
+	s.SetCookie = val
+}
+
+func (*LogoutNoContent) logoutRes() {}
+
+// MarkNoteSentConflict is response for MarkNoteSent operation.
+type MarkNoteSentConflict struct{}
+
+func (*MarkNoteSentConflict) markNoteSentRes() {}
+
+// MarkNoteSentForbidden is response for MarkNoteSent operation.
+type MarkNoteSentForbidden struct{}
+
+func (*MarkNoteSentForbidden) markNoteSentRes() {}
+
+// MarkNoteSentNotFound is response for MarkNoteSent operation.
+type MarkNoteSentNotFound struct{}
+
+func (*MarkNoteSentNotFound) markNoteSentRes() {}
+
+type MeGetOK struct {
+	// TraQ ID.
+	ID string `json:"id"`
+}
+
+// GetID returns the value of ID.
+func (s *MeGetOK) GetID() string {
+	return s.ID
+}
+
+// SetID sets the value of ID.
+func (s *MeGetOK) SetID(val string) {
+	s.ID = val
+}
+
+func (*MeGetOK) meGetRes() {}
+
+// MeGetUnauthorized is response for MeGet operation.
+type MeGetUnauthorized struct{}
+
+func (*MeGetUnauthorized) meGetRes() {}
+
+// NewNilDate returns new NilDate with value set to v.
+func NewNilDate(v time.Time) NilDate {
+	return NilDate{
+		Value: v,
+	}
+}
+
+// NilDate is nullable time.Time.
 type NilDate struct {
 	Value time.Time
 	Null  bool
@@ -619,6 +1879,141 @@ func (o NilDate) Or(d time.Time) time.Time {
 	return d
 }
 
+// NewNilDateTime returns new NilDateTime with value set to v.
+func NewNilDateTime(v time.Time) NilDateTime {
+	return NilDateTime{
+		Value: v,
+	}
+}
+
+// NilDateTime is nullable time.Time.
+type NilDateTime struct {
+	Value time.Time
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilDateTime) SetTo(v time.Time) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilDateTime) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilDateTime) SetToNull() {
+	o.Null = true
+	var v time.Time
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilDateTime) Get() (v time.Time, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilInt64 returns new NilInt64 with value set to v.
+func NewNilInt64(v int64) NilInt64 {
+	return NilInt64{
+		Value: v,
+	}
+}
+
+// NilInt64 is nullable int64.
+type NilInt64 struct {
+	Value int64
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilInt64) SetTo(v int64) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilInt64) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilInt64) SetToNull() {
+	o.Null = true
+	var v int64
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilInt64) Get() (v int64, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilInt64) Or(d int64) int64 {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewNilString returns new NilString with value set to v.
+func NewNilString(v string) NilString {
+	return NilString{
+		Value: v,
+	}
+}
+
+// NilString is nullable string.
+type NilString struct {
+	Value string
+	Null  bool
+}
+
+// SetTo sets value to v.
+func (o *NilString) SetTo(v string) {
+	o.Null = false
+	o.Value = v
+}
+
+// IsNull returns true if value is Null.
+func (o NilString) IsNull() bool { return o.Null }
+
+// SetToNull sets value to null.
+func (o *NilString) SetToNull() {
+	o.Null = true
+	var v string
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o NilString) Get() (v string, ok bool) {
+	if o.Null {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o NilString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
 // Ref: #/components/schemas/Note
 type Note struct {
 	// ノートID.
@@ -629,10 +2024,20 @@ type Note struct {
 	// 作成者.
 	Author string `json:"author"`
 	// メッセージ本文.
-	Content   string    `json:"content"`
-	Reviews   []Review  `json:"reviews"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	Content string   `json:"content"`
+	Reviews []Review `json:"reviews"`
+	// レビューを依頼したユーザーと、それぞれのレビューの状況.
+	ReviewRequests []ReviewRequest `json:"review_requests"`
+	// Trueの場合、送信待ちになるにはWeightに加えてレビューを依頼した全員の承認が必要.
+	RequireRequestedReviews bool `json:"require_requested_reviews"`
+	// 最後にレビューに出した日時。下書きに戻すとnullになる.
+	SubmittedAt NilDateTime `json:"submitted_at"`
+	// 送信済みにした日時.
+	SentAt    NilDateTime `json:"sent_at"`
+	CreatedAt time.Time   `json:"created_at"`
+	UpdatedAt time.Time   `json:"updated_at"`
+	// 作成時に伏字になっていない個人情報を検出した場合のみ。検出結果.
+	PiiWarnings []PIIWarning `json:"pii_warnings"`
 }
 
 // GetID returns the value of ID.
@@ -670,6 +2075,26 @@ func (s *Note) GetReviews() []Review {
 	return s.Reviews
 }
 
+// GetReviewRequests returns the value of ReviewRequests.
+func (s *Note) GetReviewRequests() []ReviewRequest {
+	return s.ReviewRequests
+}
+
+// GetRequireRequestedReviews returns the value of RequireRequestedReviews.
+func (s *Note) GetRequireRequestedReviews() bool {
+	return s.RequireRequestedReviews
+}
+
+// GetSubmittedAt returns the value of SubmittedAt.
+func (s *Note) GetSubmittedAt() NilDateTime {
+	return s.SubmittedAt
+}
+
+// GetSentAt returns the value of SentAt.
+func (s *Note) GetSentAt() NilDateTime {
+	return s.SentAt
+}
+
 // GetCreatedAt returns the value of CreatedAt.
 func (s *Note) GetCreatedAt() time.Time {
 	return s.CreatedAt
@@ -680,6 +2105,11 @@ func (s *Note) GetUpdatedAt() time.Time {
 	return s.UpdatedAt
 }
 
+// GetPiiWarnings returns the value of PiiWarnings.
+func (s *Note) GetPiiWarnings() []PIIWarning {
+	return s.PiiWarnings
+}
+
 // SetID sets the value of ID.
 func (s *Note) SetID(val int64) {
 	s.ID = val
@@ -715,6 +2145,26 @@ func (s *Note) SetReviews(val []Review) {
 	s.Reviews = val
 }
 
+// SetReviewRequests sets the value of ReviewRequests.
+func (s *Note) SetReviewRequests(val []ReviewRequest) {
+	s.ReviewRequests = val
+}
+
+// SetRequireRequestedReviews sets the value of RequireRequestedReviews.
+func (s *Note) SetRequireRequestedReviews(val bool) {
+	s.RequireRequestedReviews = val
+}
+
+// SetSubmittedAt sets the value of SubmittedAt.
+func (s *Note) SetSubmittedAt(val NilDateTime) {
+	s.SubmittedAt = val
+}
+
+// SetSentAt sets the value of SentAt.
+func (s *Note) SetSentAt(val NilDateTime) {
+	s.SentAt = val
+}
+
 // SetCreatedAt sets the value of CreatedAt.
 func (s *Note) SetCreatedAt(val time.Time) {
 	s.CreatedAt = val
@@ -725,14 +2175,141 @@ func (s *Note) SetUpdatedAt(val time.Time) {
 	s.UpdatedAt = val
 }
 
+// SetPiiWarnings sets the value of PiiWarnings.
+func (s *Note) SetPiiWarnings(val []PIIWarning) {
+	s.PiiWarnings = val
+}
+
+func (*Note) cancelNoteRes()               {}
+func (*Note) markNoteSentRes()             {}
+func (*Note) submitNoteRes()               {}
 func (*Note) ticketsTicketIdNotesPostRes() {}
+func (*Note) withdrawNoteRes()             {}
+
+// 送信済み・破棄済みのノートは本文を編集できない.
+// Ref: #/components/schemas/NoteNotEditable
+type NoteNotEditable struct {
+	Status NoteStatus `json:"status"`
+}
+
+// GetStatus returns the value of Status.
+func (s *NoteNotEditable) GetStatus() NoteStatus {
+	return s.Status
+}
+
+// SetStatus sets the value of Status.
+func (s *NoteNotEditable) SetStatus(val NoteStatus) {
+	s.Status = val
+}
+
+// ノートの本文の版.
+// Ref: #/components/schemas/NoteRevision
+type NoteRevision struct {
+	// ノートごとに1から振る通し番号.
+	Revision int64 `json:"revision"`
+	// この版を書いたユーザー.
+	Author    string    `json:"author"`
+	Content   string    `json:"content"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetRevision returns the value of Revision.
+func (s *NoteRevision) GetRevision() int64 {
+	return s.Revision
+}
+
+// GetAuthor returns the value of Author.
+func (s *NoteRevision) GetAuthor() string {
+	return s.Author
+}
+
+// GetContent returns the value of Content.
+func (s *NoteRevision) GetContent() string {
+	return s.Content
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *NoteRevision) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetRevision sets the value of Revision.
+func (s *NoteRevision) SetRevision(val int64) {
+	s.Revision = val
+}
+
+// SetAuthor sets the value of Author.
+func (s *NoteRevision) SetAuthor(val string) {
+	s.Author = val
+}
+
+// SetContent sets the value of Content.
+func (s *NoteRevision) SetContent(val string) {
+	s.Content = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *NoteRevision) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// Ref: #/components/schemas/NoteRevisionDiff
+type NoteRevisionDiff struct {
+	FromRevision int64      `json:"from_revision"`
+	ToRevision   int64      `json:"to_revision"`
+	Changed      bool       `json:"changed"`
+	Lines        []DiffLine `json:"lines"`
+}
+
+// GetFromRevision returns the value of FromRevision.
+func (s *NoteRevisionDiff) GetFromRevision() int64 {
+	return s.FromRevision
+}
+
+// GetToRevision returns the value of ToRevision.
+func (s *NoteRevisionDiff) GetToRevision() int64 {
+	return s.ToRevision
+}
+
+// GetChanged returns the value of Changed.
+func (s *NoteRevisionDiff) GetChanged() bool {
+	return s.Changed
+}
+
+// GetLines returns the value of Lines.
+func (s *NoteRevisionDiff) GetLines() []DiffLine {
+	return s.Lines
+}
+
+// SetFromRevision sets the value of FromRevision.
+func (s *NoteRevisionDiff) SetFromRevision(val int64) {
+	s.FromRevision = val
+}
+
+// SetToRevision sets the value of ToRevision.
+func (s *NoteRevisionDiff) SetToRevision(val int64) {
+	s.ToRevision = val
+}
+
+// SetChanged sets the value of Changed.
+func (s *NoteRevisionDiff) SetChanged(val bool) {
+	s.Changed = val
+}
+
+// SetLines sets the value of Lines.
+func (s *NoteRevisionDiff) SetLines(val []DiffLine) {
+	s.Lines = val
+}
+
+func (*NoteRevisionDiff) getNoteRevisionDiffRes() {}
 
 // Outgoing(発信)ノートの状態管理用
 // - draft: 下書き
 // - waiting_review: 添削待ち
-// - waiting_sent: 承認完了・送信待ち (Weight >= 5)
+// - waiting_sent: 承認完了・送信待ち (承認ポリシーの条件を満たした状態)
 // - sent: 送信済み (手動完了)
-// - canceled: 破棄.
+// - canceled: 破棄
+// ステータスはsubmit/withdraw/send/cancelの各操作とレビューによってのみ変わる。.
 // Ref: #/components/schemas/NoteStatus
 type NoteStatus string
 
@@ -846,25 +2423,117 @@ func (s *NoteType) UnmarshalText(data []byte) error {
 	}
 }
 
-// NewOptDate returns new OptDate with value set to v.
-func NewOptDate(v time.Time) OptDate {
-	return OptDate{
+// NewOptApprovalPolicy returns new OptApprovalPolicy with value set to v.
+func NewOptApprovalPolicy(v ApprovalPolicy) OptApprovalPolicy {
+	return OptApprovalPolicy{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptDate is optional time.Time.
-type OptDate struct {
-	Value time.Time
+// OptApprovalPolicy is optional ApprovalPolicy.
+type OptApprovalPolicy struct {
+	Value ApprovalPolicy
 	Set   bool
 }
 
-// IsSet returns true if OptDate was set.
-func (o OptDate) IsSet() bool { return o.Set }
+// IsSet returns true if OptApprovalPolicy was set.
+func (o OptApprovalPolicy) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptDate) Reset() {
+func (o *OptApprovalPolicy) Reset() {
+	var v ApprovalPolicy
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptApprovalPolicy) SetTo(v ApprovalPolicy) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptApprovalPolicy) Get() (v ApprovalPolicy, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptApprovalPolicy) Or(d ApprovalPolicy) ApprovalPolicy {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptBool returns new OptBool with value set to v.
+func NewOptBool(v bool) OptBool {
+	return OptBool{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptBool is optional bool.
+type OptBool struct {
+	Value bool
+	Set   bool
+}
+
+// IsSet returns true if OptBool was set.
+func (o OptBool) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptBool) Reset() {
+	var v bool
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptBool) SetTo(v bool) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptBool) Get() (v bool, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptBool) Or(d bool) bool {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDate returns new OptDate with value set to v.
+func NewOptDate(v time.Time) OptDate {
+	return OptDate{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDate is optional time.Time.
+type OptDate struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDate was set.
+func (o OptDate) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDate) Reset() {
 	var v time.Time
 	o.Value = v
 	o.Set = false
@@ -892,38 +2561,38 @@ func (o OptDate) Or(d time.Time) time.Time {
 	return d
 }
 
-// NewOptGetTicketsSort returns new OptGetTicketsSort with value set to v.
-func NewOptGetTicketsSort(v GetTicketsSort) OptGetTicketsSort {
-	return OptGetTicketsSort{
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptGetTicketsSort is optional GetTicketsSort.
-type OptGetTicketsSort struct {
-	Value GetTicketsSort
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
 	Set   bool
 }
 
-// IsSet returns true if OptGetTicketsSort was set.
-func (o OptGetTicketsSort) IsSet() bool { return o.Set }
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptGetTicketsSort) Reset() {
-	var v GetTicketsSort
+func (o *OptDateTime) Reset() {
+	var v time.Time
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptGetTicketsSort) SetTo(v GetTicketsSort) {
+func (o *OptDateTime) SetTo(v time.Time) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptGetTicketsSort) Get() (v GetTicketsSort, ok bool) {
+func (o OptDateTime) Get() (v time.Time, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -931,45 +2600,45 @@ func (o OptGetTicketsSort) Get() (v GetTicketsSort, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptGetTicketsSort) Or(d GetTicketsSort) GetTicketsSort {
+func (o OptDateTime) Or(d time.Time) time.Time {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
+// NewOptDuePolicy returns new OptDuePolicy with value set to v.
+func NewOptDuePolicy(v DuePolicy) OptDuePolicy {
+	return OptDuePolicy{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
+// OptDuePolicy is optional DuePolicy.
+type OptDuePolicy struct {
+	Value DuePolicy
 	Set   bool
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// IsSet returns true if OptDuePolicy was set.
+func (o OptDuePolicy) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
+func (o *OptDuePolicy) Reset() {
+	var v DuePolicy
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
+func (o *OptDuePolicy) SetTo(v DuePolicy) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
+func (o OptDuePolicy) Get() (v DuePolicy, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -977,45 +2646,45 @@ func (o OptString) Get() (v string, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
+func (o OptDuePolicy) Or(d DuePolicy) DuePolicy {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptTicketStatus returns new OptTicketStatus with value set to v.
-func NewOptTicketStatus(v TicketStatus) OptTicketStatus {
-	return OptTicketStatus{
+// NewOptGetTicketsSort returns new OptGetTicketsSort with value set to v.
+func NewOptGetTicketsSort(v GetTicketsSort) OptGetTicketsSort {
+	return OptGetTicketsSort{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptTicketStatus is optional TicketStatus.
-type OptTicketStatus struct {
-	Value TicketStatus
+// OptGetTicketsSort is optional GetTicketsSort.
+type OptGetTicketsSort struct {
+	Value GetTicketsSort
 	Set   bool
 }
 
-// IsSet returns true if OptTicketStatus was set.
-func (o OptTicketStatus) IsSet() bool { return o.Set }
+// IsSet returns true if OptGetTicketsSort was set.
+func (o OptGetTicketsSort) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptTicketStatus) Reset() {
-	var v TicketStatus
+func (o *OptGetTicketsSort) Reset() {
+	var v GetTicketsSort
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptTicketStatus) SetTo(v TicketStatus) {
+func (o *OptGetTicketsSort) SetTo(v GetTicketsSort) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptTicketStatus) Get() (v TicketStatus, ok bool) {
+func (o OptGetTicketsSort) Get() (v GetTicketsSort, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1023,45 +2692,45 @@ func (o OptTicketStatus) Get() (v TicketStatus, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptTicketStatus) Or(d TicketStatus) TicketStatus {
+func (o OptGetTicketsSort) Or(d GetTicketsSort) GetTicketsSort {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptUpdateReviewReq returns new OptUpdateReviewReq with value set to v.
-func NewOptUpdateReviewReq(v UpdateReviewReq) OptUpdateReviewReq {
-	return OptUpdateReviewReq{
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptUpdateReviewReq is optional UpdateReviewReq.
-type OptUpdateReviewReq struct {
-	Value UpdateReviewReq
+// OptInt is optional int.
+type OptInt struct {
+	Value int
 	Set   bool
 }
 
-// IsSet returns true if OptUpdateReviewReq was set.
-func (o OptUpdateReviewReq) IsSet() bool { return o.Set }
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptUpdateReviewReq) Reset() {
-	var v UpdateReviewReq
+func (o *OptInt) Reset() {
+	var v int
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptUpdateReviewReq) SetTo(v UpdateReviewReq) {
+func (o *OptInt) SetTo(v int) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptUpdateReviewReq) Get() (v UpdateReviewReq, ok bool) {
+func (o OptInt) Get() (v int, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1069,45 +2738,45 @@ func (o OptUpdateReviewReq) Get() (v UpdateReviewReq, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptUpdateReviewReq) Or(d UpdateReviewReq) UpdateReviewReq {
+func (o OptInt) Or(d int) int {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// NewOptUpdateTicketByIDReq returns new OptUpdateTicketByIDReq with value set to v.
-func NewOptUpdateTicketByIDReq(v UpdateTicketByIDReq) OptUpdateTicketByIDReq {
-	return OptUpdateTicketByIDReq{
+// NewOptInt64 returns new OptInt64 with value set to v.
+func NewOptInt64(v int64) OptInt64 {
+	return OptInt64{
 		Value: v,
 		Set:   true,
 	}
 }
 
-// OptUpdateTicketByIDReq is optional UpdateTicketByIDReq.
-type OptUpdateTicketByIDReq struct {
-	Value UpdateTicketByIDReq
+// OptInt64 is optional int64.
+type OptInt64 struct {
+	Value int64
 	Set   bool
 }
 
-// IsSet returns true if OptUpdateTicketByIDReq was set.
-func (o OptUpdateTicketByIDReq) IsSet() bool { return o.Set }
+// IsSet returns true if OptInt64 was set.
+func (o OptInt64) IsSet() bool { return o.Set }
 
 // Reset unsets value.
-func (o *OptUpdateTicketByIDReq) Reset() {
-	var v UpdateTicketByIDReq
+func (o *OptInt64) Reset() {
+	var v int64
 	o.Value = v
 	o.Set = false
 }
 
 // SetTo sets value to v.
-func (o *OptUpdateTicketByIDReq) SetTo(v UpdateTicketByIDReq) {
+func (o *OptInt64) SetTo(v int64) {
 	o.Set = true
 	o.Value = v
 }
 
 // Get returns value and boolean that denotes whether value was set.
-func (o OptUpdateTicketByIDReq) Get() (v UpdateTicketByIDReq, ok bool) {
+func (o OptInt64) Get() (v int64, ok bool) {
 	if !o.Set {
 		return v, false
 	}
@@ -1115,147 +2784,1198 @@ func (o OptUpdateTicketByIDReq) Get() (v UpdateTicketByIDReq, ok bool) {
 }
 
 // Or returns value if set, or given parameter if does not.
-func (o OptUpdateTicketByIDReq) Or(d UpdateTicketByIDReq) UpdateTicketByIDReq {
+func (o OptInt64) Or(d int64) int64 {
 	if v, ok := o.Get(); ok {
 		return v
 	}
 	return d
 }
 
-// Ref: #/components/schemas/Review
-type Review struct {
-	ID     int64 `json:"id"`
-	NoteID int64 `json:"note_id"`
-	// レビュワー.
-	Reviewer string     `json:"reviewer"`
-	Type     ReviewType `json:"type"`
-	// 承認ウェイト。
-	// - 本職: 1-5
-	// - 補佐: 1-4
-	// - その他: 0.
-	Weight int `json:"weight"`
-	// レビュー状態 (active: 有効, stale: 修正により無効化済み).
-	Status ReviewStatus `json:"status"`
-	// コメント.
-	Comment   string    `json:"comment"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+// NewOptNoteStatus returns new OptNoteStatus with value set to v.
+func NewOptNoteStatus(v NoteStatus) OptNoteStatus {
+	return OptNoteStatus{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetID returns the value of ID.
-func (s *Review) GetID() int64 {
-	return s.ID
+// OptNoteStatus is optional NoteStatus.
+type OptNoteStatus struct {
+	Value NoteStatus
+	Set   bool
 }
 
-// GetNoteID returns the value of NoteID.
-func (s *Review) GetNoteID() int64 {
-	return s.NoteID
+// IsSet returns true if OptNoteStatus was set.
+func (o OptNoteStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptNoteStatus) Reset() {
+	var v NoteStatus
+	o.Value = v
+	o.Set = false
 }
 
-// GetReviewer returns the value of Reviewer.
-func (s *Review) GetReviewer() string {
-	return s.Reviewer
+// SetTo sets value to v.
+func (o *OptNoteStatus) SetTo(v NoteStatus) {
+	o.Set = true
+	o.Value = v
 }
 
-// GetType returns the value of Type.
-func (s *Review) GetType() ReviewType {
-	return s.Type
+// Get returns value and boolean that denotes whether value was set.
+func (o OptNoteStatus) Get() (v NoteStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// GetWeight returns the value of Weight.
-func (s *Review) GetWeight() int {
-	return s.Weight
+// Or returns value if set, or given parameter if does not.
+func (o OptNoteStatus) Or(d NoteStatus) NoteStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
 }
 
-// GetStatus returns the value of Status.
-func (s *Review) GetStatus() ReviewStatus {
-	return s.Status
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
 }
 
-// GetComment returns the value of Comment.
-func (s *Review) GetComment() string {
-	return s.Comment
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
 }
 
-// GetCreatedAt returns the value of CreatedAt.
-func (s *Review) GetCreatedAt() time.Time {
-	return s.CreatedAt
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
 }
 
-// GetUpdatedAt returns the value of UpdatedAt.
-func (s *Review) GetUpdatedAt() time.Time {
-	return s.UpdatedAt
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
 }
 
-// SetID sets the value of ID.
-func (s *Review) SetID(val int64) {
-	s.ID = val
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
 }
 
-// SetNoteID sets the value of NoteID.
-func (s *Review) SetNoteID(val int64) {
-	s.NoteID = val
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTicketStatus returns new OptTicketStatus with value set to v.
+func NewOptTicketStatus(v TicketStatus) OptTicketStatus {
+	return OptTicketStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTicketStatus is optional TicketStatus.
+type OptTicketStatus struct {
+	Value TicketStatus
+	Set   bool
+}
+
+// IsSet returns true if OptTicketStatus was set.
+func (o OptTicketStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTicketStatus) Reset() {
+	var v TicketStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTicketStatus) SetTo(v TicketStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTicketStatus) Get() (v TicketStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTicketStatus) Or(d TicketStatus) TicketStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptTicketVisibility returns new OptTicketVisibility with value set to v.
+func NewOptTicketVisibility(v TicketVisibility) OptTicketVisibility {
+	return OptTicketVisibility{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptTicketVisibility is optional TicketVisibility.
+type OptTicketVisibility struct {
+	Value TicketVisibility
+	Set   bool
+}
+
+// IsSet returns true if OptTicketVisibility was set.
+func (o OptTicketVisibility) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptTicketVisibility) Reset() {
+	var v TicketVisibility
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptTicketVisibility) SetTo(v TicketVisibility) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptTicketVisibility) Get() (v TicketVisibility, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptTicketVisibility) Or(d TicketVisibility) TicketVisibility {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUpdateReviewReq returns new OptUpdateReviewReq with value set to v.
+func NewOptUpdateReviewReq(v UpdateReviewReq) OptUpdateReviewReq {
+	return OptUpdateReviewReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateReviewReq is optional UpdateReviewReq.
+type OptUpdateReviewReq struct {
+	Value UpdateReviewReq
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateReviewReq was set.
+func (o OptUpdateReviewReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateReviewReq) Reset() {
+	var v UpdateReviewReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateReviewReq) SetTo(v UpdateReviewReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateReviewReq) Get() (v UpdateReviewReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateReviewReq) Or(d UpdateReviewReq) UpdateReviewReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUpdateTicketByIDReq returns new OptUpdateTicketByIDReq with value set to v.
+func NewOptUpdateTicketByIDReq(v UpdateTicketByIDReq) OptUpdateTicketByIDReq {
+	return OptUpdateTicketByIDReq{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUpdateTicketByIDReq is optional UpdateTicketByIDReq.
+type OptUpdateTicketByIDReq struct {
+	Value UpdateTicketByIDReq
+	Set   bool
+}
+
+// IsSet returns true if OptUpdateTicketByIDReq was set.
+func (o OptUpdateTicketByIDReq) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUpdateTicketByIDReq) Reset() {
+	var v UpdateTicketByIDReq
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUpdateTicketByIDReq) SetTo(v UpdateTicketByIDReq) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUpdateTicketByIDReq) Get() (v UpdateTicketByIDReq, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUpdateTicketByIDReq) Or(d UpdateTicketByIDReq) UpdateTicketByIDReq {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// 個人情報の種類 (自動で伏字にする場合のカテゴリ名にもなる).
+// Ref: #/components/schemas/PIIKind
+type PIIKind string
+
+const (
+	PIIKindEmail       PIIKind = "email"
+	PIIKindPhone       PIIKind = "phone"
+	PIIKindPostalCode  PIIKind = "postal_code"
+	PIIKindBankAccount PIIKind = "bank_account"
+)
+
+// AllValues returns all PIIKind values.
+func (PIIKind) AllValues() []PIIKind {
+	return []PIIKind{
+		PIIKindEmail,
+		PIIKindPhone,
+		PIIKindPostalCode,
+		PIIKindBankAccount,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PIIKind) MarshalText() ([]byte, error) {
+	switch s {
+	case PIIKindEmail:
+		return []byte(s), nil
+	case PIIKindPhone:
+		return []byte(s), nil
+	case PIIKindPostalCode:
+		return []byte(s), nil
+	case PIIKindBankAccount:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PIIKind) UnmarshalText(data []byte) error {
+	switch PIIKind(data) {
+	case PIIKindEmail:
+		*s = PIIKindEmail
+		return nil
+	case PIIKindPhone:
+		*s = PIIKindPhone
+		return nil
+	case PIIKindPostalCode:
+		*s = PIIKindPostalCode
+		return nil
+	case PIIKindBankAccount:
+		*s = PIIKindBankAccount
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/PIIReport
+type PIIReport struct {
+	PiiWarnings []PIIWarning `json:"pii_warnings"`
+}
+
+// GetPiiWarnings returns the value of PiiWarnings.
+func (s *PIIReport) GetPiiWarnings() []PIIWarning {
+	return s.PiiWarnings
+}
+
+// SetPiiWarnings sets the value of PiiWarnings.
+func (s *PIIReport) SetPiiWarnings(val []PIIWarning) {
+	s.PiiWarnings = val
+}
+
+func (*PIIReport) ticketsTicketIdNotesNoteIdPutRes() {}
+func (*PIIReport) updateTicketByIDRes()              {}
+
+// 既存のチケット・ノートに含まれる伏字になっていない個人情報.
+// Ref: #/components/schemas/PIIScanResult
+type PIIScanResult struct {
+	TicketID int64 `json:"ticket_id"`
+	// ノートで検出した場合のみ.
+	NoteID OptInt64 `json:"note_id"`
+	// 検出したフィールド (title, description, content).
+	Field string  `json:"field"`
+	Kind  PIIKind `json:"kind"`
+	// 検出したテキスト.
+	Text string `json:"text"`
+}
+
+// GetTicketID returns the value of TicketID.
+func (s *PIIScanResult) GetTicketID() int64 {
+	return s.TicketID
+}
+
+// GetNoteID returns the value of NoteID.
+func (s *PIIScanResult) GetNoteID() OptInt64 {
+	return s.NoteID
+}
+
+// GetField returns the value of Field.
+func (s *PIIScanResult) GetField() string {
+	return s.Field
+}
+
+// GetKind returns the value of Kind.
+func (s *PIIScanResult) GetKind() PIIKind {
+	return s.Kind
+}
+
+// GetText returns the value of Text.
+func (s *PIIScanResult) GetText() string {
+	return s.Text
+}
+
+// SetTicketID sets the value of TicketID.
+func (s *PIIScanResult) SetTicketID(val int64) {
+	s.TicketID = val
+}
+
+// SetNoteID sets the value of NoteID.
+func (s *PIIScanResult) SetNoteID(val OptInt64) {
+	s.NoteID = val
+}
+
+// SetField sets the value of Field.
+func (s *PIIScanResult) SetField(val string) {
+	s.Field = val
+}
+
+// SetKind sets the value of Kind.
+func (s *PIIScanResult) SetKind(val PIIKind) {
+	s.Kind = val
+}
+
+// SetText sets the value of Text.
+func (s *PIIScanResult) SetText(val string) {
+	s.Text = val
+}
+
+// 伏字になっていない個人情報の検出結果.
+// Ref: #/components/schemas/PIIWarning
+type PIIWarning struct {
+	// 検出したフィールド (title, description, content).
+	Field string  `json:"field"`
+	Kind  PIIKind `json:"kind"`
+	// 検出したテキスト.
+	Text string `json:"text"`
+	// 自動で伏字にしたか.
+	Censored bool `json:"censored"`
+}
+
+// GetField returns the value of Field.
+func (s *PIIWarning) GetField() string {
+	return s.Field
+}
+
+// GetKind returns the value of Kind.
+func (s *PIIWarning) GetKind() PIIKind {
+	return s.Kind
+}
+
+// GetText returns the value of Text.
+func (s *PIIWarning) GetText() string {
+	return s.Text
+}
+
+// GetCensored returns the value of Censored.
+func (s *PIIWarning) GetCensored() bool {
+	return s.Censored
+}
+
+// SetField sets the value of Field.
+func (s *PIIWarning) SetField(val string) {
+	s.Field = val
+}
+
+// SetKind sets the value of Kind.
+func (s *PIIWarning) SetKind(val PIIKind) {
+	s.Kind = val
+}
+
+// SetText sets the value of Text.
+func (s *PIIWarning) SetText(val string) {
+	s.Text = val
+}
+
+// SetCensored sets the value of Censored.
+func (s *PIIWarning) SetCensored(val bool) {
+	s.Censored = val
+}
+
+// PurgeTicketForbidden is response for PurgeTicket operation.
+type PurgeTicketForbidden struct{}
+
+func (*PurgeTicketForbidden) purgeTicketRes() {}
+
+// PurgeTicketNoContent is response for PurgeTicket operation.
+type PurgeTicketNoContent struct{}
+
+func (*PurgeTicketNoContent) purgeTicketRes() {}
+
+// PurgeTicketNotFound is response for PurgeTicket operation.
+type PurgeTicketNotFound struct{}
+
+func (*PurgeTicketNotFound) purgeTicketRes() {}
+
+// PurgeTicketUnauthorized is response for PurgeTicket operation.
+type PurgeTicketUnauthorized struct{}
+
+func (*PurgeTicketUnauthorized) purgeTicketRes() {}
+
+// RequestReviewsBadRequest is response for RequestReviews operation.
+type RequestReviewsBadRequest struct{}
+
+func (*RequestReviewsBadRequest) requestReviewsRes() {}
+
+// RequestReviewsForbidden is response for RequestReviews operation.
+type RequestReviewsForbidden struct{}
+
+func (*RequestReviewsForbidden) requestReviewsRes() {}
+
+// RequestReviewsNotFound is response for RequestReviews operation.
+type RequestReviewsNotFound struct{}
+
+func (*RequestReviewsNotFound) requestReviewsRes() {}
+
+type RequestReviewsOKApplicationJSON []ReviewRequest
+
+func (*RequestReviewsOKApplicationJSON) requestReviewsRes() {}
+
+type RequestReviewsReq struct {
+	// レビューを依頼するユーザーのtraQ ID.
+	Reviewers               []string `json:"reviewers"`
+	RequireRequestedReviews bool     `json:"require_requested_reviews"`
+}
+
+// GetReviewers returns the value of Reviewers.
+func (s *RequestReviewsReq) GetReviewers() []string {
+	return s.Reviewers
+}
+
+// GetRequireRequestedReviews returns the value of RequireRequestedReviews.
+func (s *RequestReviewsReq) GetRequireRequestedReviews() bool {
+	return s.RequireRequestedReviews
+}
+
+// SetReviewers sets the value of Reviewers.
+func (s *RequestReviewsReq) SetReviewers(val []string) {
+	s.Reviewers = val
+}
+
+// SetRequireRequestedReviews sets the value of RequireRequestedReviews.
+func (s *RequestReviewsReq) SetRequireRequestedReviews(val bool) {
+	s.RequireRequestedReviews = val
+}
+
+// RestoreTicketForbidden is response for RestoreTicket operation.
+type RestoreTicketForbidden struct{}
+
+func (*RestoreTicketForbidden) restoreTicketRes() {}
+
+// RestoreTicketNotFound is response for RestoreTicket operation.
+type RestoreTicketNotFound struct{}
+
+func (*RestoreTicketNotFound) restoreTicketRes() {}
+
+// RestoreTicketUnauthorized is response for RestoreTicket operation.
+type RestoreTicketUnauthorized struct{}
+
+func (*RestoreTicketUnauthorized) restoreTicketRes() {}
+
+// Ref: #/components/schemas/Review
+type Review struct {
+	ID     int64 `json:"id"`
+	NoteID int64 `json:"note_id"`
+	// レビューしたノートの版。版の記録前のレビューで分からない場合はnull.
+	Revision NilInt64 `json:"revision"`
+	// レビュワー.
+	Reviewer string     `json:"reviewer"`
+	Type     ReviewType `json:"type"`
+	// 承認ウェイト。
+	// - 本職: 1-5
+	// - 補佐: 1-4
+	// - その他: 0.
+	Weight int `json:"weight"`
+	// レビュー状態 (active: 有効, stale: 修正により無効化済み).
+	Status ReviewStatus `json:"status"`
+	// コメント.
+	Comment   string    `json:"comment"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// GetID returns the value of ID.
+func (s *Review) GetID() int64 {
+	return s.ID
+}
+
+// GetNoteID returns the value of NoteID.
+func (s *Review) GetNoteID() int64 {
+	return s.NoteID
+}
+
+// GetRevision returns the value of Revision.
+func (s *Review) GetRevision() NilInt64 {
+	return s.Revision
+}
+
+// GetReviewer returns the value of Reviewer.
+func (s *Review) GetReviewer() string {
+	return s.Reviewer
+}
+
+// GetType returns the value of Type.
+func (s *Review) GetType() ReviewType {
+	return s.Type
+}
+
+// GetWeight returns the value of Weight.
+func (s *Review) GetWeight() int {
+	return s.Weight
+}
+
+// GetStatus returns the value of Status.
+func (s *Review) GetStatus() ReviewStatus {
+	return s.Status
+}
+
+// GetComment returns the value of Comment.
+func (s *Review) GetComment() string {
+	return s.Comment
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *Review) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// GetUpdatedAt returns the value of UpdatedAt.
+func (s *Review) GetUpdatedAt() time.Time {
+	return s.UpdatedAt
+}
+
+// SetID sets the value of ID.
+func (s *Review) SetID(val int64) {
+	s.ID = val
+}
+
+// SetNoteID sets the value of NoteID.
+func (s *Review) SetNoteID(val int64) {
+	s.NoteID = val
+}
+
+// SetRevision sets the value of Revision.
+func (s *Review) SetRevision(val NilInt64) {
+	s.Revision = val
+}
+
+// SetReviewer sets the value of Reviewer.
+func (s *Review) SetReviewer(val string) {
+	s.Reviewer = val
+}
+
+// SetType sets the value of Type.
+func (s *Review) SetType(val ReviewType) {
+	s.Type = val
+}
+
+// SetWeight sets the value of Weight.
+func (s *Review) SetWeight(val int) {
+	s.Weight = val
+}
+
+// SetStatus sets the value of Status.
+func (s *Review) SetStatus(val ReviewStatus) {
+	s.Status = val
+}
+
+// SetComment sets the value of Comment.
+func (s *Review) SetComment(val string) {
+	s.Comment = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *Review) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// SetUpdatedAt sets the value of UpdatedAt.
+func (s *Review) SetUpdatedAt(val time.Time) {
+	s.UpdatedAt = val
+}
+
+func (*Review) createReviewRes() {}
+
+// Ref: #/components/schemas/ReviewDiff
+type ReviewDiff struct {
+	ReviewID int64 `json:"review_id"`
+	// レビューしたノートの版.
+	Revision int64 `json:"revision"`
+	// ノートの最新の版.
+	LatestRevision int64 `json:"latest_revision"`
+	// レビュー状態 (active: 有効, stale: 修正により無効化済み).
+	Status ReviewDiffStatus `json:"status"`
+	// レビューした日時.
+	ReviewedAt time.Time `json:"reviewed_at"`
+	// レビュー時から本文が変更されているか.
+	Changed bool       `json:"changed"`
+	Lines   []DiffLine `json:"lines"`
+}
+
+// GetReviewID returns the value of ReviewID.
+func (s *ReviewDiff) GetReviewID() int64 {
+	return s.ReviewID
+}
+
+// GetRevision returns the value of Revision.
+func (s *ReviewDiff) GetRevision() int64 {
+	return s.Revision
+}
+
+// GetLatestRevision returns the value of LatestRevision.
+func (s *ReviewDiff) GetLatestRevision() int64 {
+	return s.LatestRevision
+}
+
+// GetStatus returns the value of Status.
+func (s *ReviewDiff) GetStatus() ReviewDiffStatus {
+	return s.Status
+}
+
+// GetReviewedAt returns the value of ReviewedAt.
+func (s *ReviewDiff) GetReviewedAt() time.Time {
+	return s.ReviewedAt
+}
+
+// GetChanged returns the value of Changed.
+func (s *ReviewDiff) GetChanged() bool {
+	return s.Changed
+}
+
+// GetLines returns the value of Lines.
+func (s *ReviewDiff) GetLines() []DiffLine {
+	return s.Lines
+}
+
+// SetReviewID sets the value of ReviewID.
+func (s *ReviewDiff) SetReviewID(val int64) {
+	s.ReviewID = val
+}
+
+// SetRevision sets the value of Revision.
+func (s *ReviewDiff) SetRevision(val int64) {
+	s.Revision = val
+}
+
+// SetLatestRevision sets the value of LatestRevision.
+func (s *ReviewDiff) SetLatestRevision(val int64) {
+	s.LatestRevision = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ReviewDiff) SetStatus(val ReviewDiffStatus) {
+	s.Status = val
+}
+
+// SetReviewedAt sets the value of ReviewedAt.
+func (s *ReviewDiff) SetReviewedAt(val time.Time) {
+	s.ReviewedAt = val
+}
+
+// SetChanged sets the value of Changed.
+func (s *ReviewDiff) SetChanged(val bool) {
+	s.Changed = val
+}
+
+// SetLines sets the value of Lines.
+func (s *ReviewDiff) SetLines(val []DiffLine) {
+	s.Lines = val
+}
+
+func (*ReviewDiff) getReviewDiffRes() {}
+
+// レビュー状態 (active: 有効, stale: 修正により無効化済み).
+type ReviewDiffStatus string
+
+const (
+	ReviewDiffStatusActive ReviewDiffStatus = "active"
+	ReviewDiffStatusStale  ReviewDiffStatus = "stale"
+)
+
+// AllValues returns all ReviewDiffStatus values.
+func (ReviewDiffStatus) AllValues() []ReviewDiffStatus {
+	return []ReviewDiffStatus{
+		ReviewDiffStatusActive,
+		ReviewDiffStatusStale,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ReviewDiffStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ReviewDiffStatusActive:
+		return []byte(s), nil
+	case ReviewDiffStatusStale:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReviewDiffStatus) UnmarshalText(data []byte) error {
+	switch ReviewDiffStatus(data) {
+	case ReviewDiffStatusActive:
+		*s = ReviewDiffStatusActive
+		return nil
+	case ReviewDiffStatusStale:
+		*s = ReviewDiffStatusStale
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/ReviewRequest
+type ReviewRequest struct {
+	// レビューを依頼されたユーザー.
+	Reviewer string `json:"reviewer"`
+	// レビューを依頼したユーザー.
+	RequestedBy string `json:"requested_by"`
+	// - pending: 有効なレビューがない (本文の編集で無効化された場合を含む)
+	// - complete: 有効なレビューがある.
+	Status      ReviewRequestStatus `json:"status"`
+	RequestedAt time.Time           `json:"requested_at"`
+}
+
+// GetReviewer returns the value of Reviewer.
+func (s *ReviewRequest) GetReviewer() string {
+	return s.Reviewer
+}
+
+// GetRequestedBy returns the value of RequestedBy.
+func (s *ReviewRequest) GetRequestedBy() string {
+	return s.RequestedBy
+}
+
+// GetStatus returns the value of Status.
+func (s *ReviewRequest) GetStatus() ReviewRequestStatus {
+	return s.Status
+}
+
+// GetRequestedAt returns the value of RequestedAt.
+func (s *ReviewRequest) GetRequestedAt() time.Time {
+	return s.RequestedAt
+}
+
+// SetReviewer sets the value of Reviewer.
+func (s *ReviewRequest) SetReviewer(val string) {
+	s.Reviewer = val
+}
+
+// SetRequestedBy sets the value of RequestedBy.
+func (s *ReviewRequest) SetRequestedBy(val string) {
+	s.RequestedBy = val
+}
+
+// SetStatus sets the value of Status.
+func (s *ReviewRequest) SetStatus(val ReviewRequestStatus) {
+	s.Status = val
+}
+
+// SetRequestedAt sets the value of RequestedAt.
+func (s *ReviewRequest) SetRequestedAt(val time.Time) {
+	s.RequestedAt = val
+}
+
+// - pending: 有効なレビューがない (本文の編集で無効化された場合を含む)
+// - complete: 有効なレビューがある.
+type ReviewRequestStatus string
+
+const (
+	ReviewRequestStatusPending  ReviewRequestStatus = "pending"
+	ReviewRequestStatusComplete ReviewRequestStatus = "complete"
+)
+
+// AllValues returns all ReviewRequestStatus values.
+func (ReviewRequestStatus) AllValues() []ReviewRequestStatus {
+	return []ReviewRequestStatus{
+		ReviewRequestStatusPending,
+		ReviewRequestStatusComplete,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ReviewRequestStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ReviewRequestStatusPending:
+		return []byte(s), nil
+	case ReviewRequestStatusComplete:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReviewRequestStatus) UnmarshalText(data []byte) error {
+	switch ReviewRequestStatus(data) {
+	case ReviewRequestStatusPending:
+		*s = ReviewRequestStatusPending
+		return nil
+	case ReviewRequestStatusComplete:
+		*s = ReviewRequestStatusComplete
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// レビュー状態 (active: 有効, stale: 修正により無効化済み).
+type ReviewStatus string
+
+const (
+	ReviewStatusActive ReviewStatus = "active"
+	ReviewStatusStale  ReviewStatus = "stale"
+)
+
+// AllValues returns all ReviewStatus values.
+func (ReviewStatus) AllValues() []ReviewStatus {
+	return []ReviewStatus{
+		ReviewStatusActive,
+		ReviewStatusStale,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ReviewStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ReviewStatusActive:
+		return []byte(s), nil
+	case ReviewStatusStale:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReviewStatus) UnmarshalText(data []byte) error {
+	switch ReviewStatus(data) {
+	case ReviewStatusActive:
+		*s = ReviewStatusActive
+		return nil
+	case ReviewStatusStale:
+		*s = ReviewStatusStale
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// レビューの種類.
+// Ref: #/components/schemas/ReviewType
+type ReviewType string
+
+const (
+	ReviewTypeApprove       ReviewType = "approve"
+	ReviewTypeChangeRequest ReviewType = "change_request"
+	ReviewTypeComment       ReviewType = "comment"
+	ReviewTypeSystem        ReviewType = "system"
+)
+
+// AllValues returns all ReviewType values.
+func (ReviewType) AllValues() []ReviewType {
+	return []ReviewType{
+		ReviewTypeApprove,
+		ReviewTypeChangeRequest,
+		ReviewTypeComment,
+		ReviewTypeSystem,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ReviewType) MarshalText() ([]byte, error) {
+	switch s {
+	case ReviewTypeApprove:
+		return []byte(s), nil
+	case ReviewTypeChangeRequest:
+		return []byte(s), nil
+	case ReviewTypeComment:
+		return []byte(s), nil
+	case ReviewTypeSystem:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ReviewType) UnmarshalText(data []byte) error {
+	switch ReviewType(data) {
+	case ReviewTypeApprove:
+		*s = ReviewTypeApprove
+		return nil
+	case ReviewTypeChangeRequest:
+		*s = ReviewTypeChangeRequest
+		return nil
+	case ReviewTypeComment:
+		*s = ReviewTypeComment
+		return nil
+	case ReviewTypeSystem:
+		*s = ReviewTypeSystem
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// ユーザーの追加・ロールの変更・削除の記録.
+// Ref: #/components/schemas/RoleChange
+type RoleChange struct {
+	ID int64 `json:"id"`
+	// 変更されたユーザーのtraQ ID.
+	TraqID string `json:"traq_id"`
+	// 変更したユーザーのtraQ ID.
+	Actor string `json:"actor"`
+	// 変更前のロール。追加の場合はnull.
+	BeforeRole NilString `json:"before_role"`
+	// 変更後のロール。削除の場合はnull.
+	AfterRole NilString `json:"after_role"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *RoleChange) GetID() int64 {
+	return s.ID
+}
+
+// GetTraqID returns the value of TraqID.
+func (s *RoleChange) GetTraqID() string {
+	return s.TraqID
+}
+
+// GetActor returns the value of Actor.
+func (s *RoleChange) GetActor() string {
+	return s.Actor
+}
+
+// GetBeforeRole returns the value of BeforeRole.
+func (s *RoleChange) GetBeforeRole() NilString {
+	return s.BeforeRole
+}
+
+// GetAfterRole returns the value of AfterRole.
+func (s *RoleChange) GetAfterRole() NilString {
+	return s.AfterRole
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *RoleChange) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *RoleChange) SetID(val int64) {
+	s.ID = val
+}
+
+// SetTraqID sets the value of TraqID.
+func (s *RoleChange) SetTraqID(val string) {
+	s.TraqID = val
+}
+
+// SetActor sets the value of Actor.
+func (s *RoleChange) SetActor(val string) {
+	s.Actor = val
+}
+
+// SetBeforeRole sets the value of BeforeRole.
+func (s *RoleChange) SetBeforeRole(val NilString) {
+	s.BeforeRole = val
+}
+
+// SetAfterRole sets the value of AfterRole.
+func (s *RoleChange) SetAfterRole(val NilString) {
+	s.AfterRole = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *RoleChange) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// ScanPIIForbidden is response for ScanPII operation.
+type ScanPIIForbidden struct{}
+
+func (*ScanPIIForbidden) scanPIIRes() {}
+
+type ScanPIIOKApplicationJSON []PIIScanResult
+
+func (*ScanPIIOKApplicationJSON) scanPIIRes() {}
+
+// SearchBadRequest is response for Search operation.
+type SearchBadRequest struct{}
+
+func (*SearchBadRequest) searchRes() {}
+
+// 全文検索の結果.
+// Ref: #/components/schemas/SearchHit
+type SearchHit struct {
+	// ヒットした対象 (ticket: タイトル・説明, note: 本文, review: コメント).
+	Type     SearchHitType `json:"type"`
+	TicketID int64         `json:"ticket_id"`
+	// ノート・レビューの場合のみ.
+	NoteID OptInt64 `json:"note_id"`
+	// レビューの場合のみ.
+	ReviewID OptInt64 `json:"review_id"`
+	// 関連度 (大きいほど関連が高い).
+	Score float64 `json:"score"`
+	// ヒットした箇所の前後の抜粋。伏字は閲覧者の権限に応じて適用される.
+	Snippet string `json:"snippet"`
+}
+
+// GetType returns the value of Type.
+func (s *SearchHit) GetType() SearchHitType {
+	return s.Type
+}
+
+// GetTicketID returns the value of TicketID.
+func (s *SearchHit) GetTicketID() int64 {
+	return s.TicketID
+}
+
+// GetNoteID returns the value of NoteID.
+func (s *SearchHit) GetNoteID() OptInt64 {
+	return s.NoteID
+}
+
+// GetReviewID returns the value of ReviewID.
+func (s *SearchHit) GetReviewID() OptInt64 {
+	return s.ReviewID
+}
+
+// GetScore returns the value of Score.
+func (s *SearchHit) GetScore() float64 {
+	return s.Score
 }
 
-// SetReviewer sets the value of Reviewer.
-func (s *Review) SetReviewer(val string) {
-	s.Reviewer = val
+// GetSnippet returns the value of Snippet.
+func (s *SearchHit) GetSnippet() string {
+	return s.Snippet
 }
 
 // SetType sets the value of Type.
-func (s *Review) SetType(val ReviewType) {
+func (s *SearchHit) SetType(val SearchHitType) {
 	s.Type = val
 }
 
-// SetWeight sets the value of Weight.
-func (s *Review) SetWeight(val int) {
-	s.Weight = val
+// SetTicketID sets the value of TicketID.
+func (s *SearchHit) SetTicketID(val int64) {
+	s.TicketID = val
 }
 
-// SetStatus sets the value of Status.
-func (s *Review) SetStatus(val ReviewStatus) {
-	s.Status = val
+// SetNoteID sets the value of NoteID.
+func (s *SearchHit) SetNoteID(val OptInt64) {
+	s.NoteID = val
 }
 
-// SetComment sets the value of Comment.
-func (s *Review) SetComment(val string) {
-	s.Comment = val
+// SetReviewID sets the value of ReviewID.
+func (s *SearchHit) SetReviewID(val OptInt64) {
+	s.ReviewID = val
 }
 
-// SetCreatedAt sets the value of CreatedAt.
-func (s *Review) SetCreatedAt(val time.Time) {
-	s.CreatedAt = val
+// SetScore sets the value of Score.
+func (s *SearchHit) SetScore(val float64) {
+	s.Score = val
 }
 
-// SetUpdatedAt sets the value of UpdatedAt.
-func (s *Review) SetUpdatedAt(val time.Time) {
-	s.UpdatedAt = val
+// SetSnippet sets the value of Snippet.
+func (s *SearchHit) SetSnippet(val string) {
+	s.Snippet = val
 }
 
-func (*Review) createReviewRes() {}
-
-// レビュー状態 (active: 有効, stale: 修正により無効化済み).
-type ReviewStatus string
+// ヒットした対象 (ticket: タイトル・説明, note: 本文, review: コメント).
+type SearchHitType string
 
 const (
-	ReviewStatusActive ReviewStatus = "active"
-	ReviewStatusStale  ReviewStatus = "stale"
+	SearchHitTypeTicket SearchHitType = "ticket"
+	SearchHitTypeNote   SearchHitType = "note"
+	SearchHitTypeReview SearchHitType = "review"
 )
 
-// AllValues returns all ReviewStatus values.
-func (ReviewStatus) AllValues() []ReviewStatus {
-	return []ReviewStatus{
-		ReviewStatusActive,
-		ReviewStatusStale,
+// AllValues returns all SearchHitType values.
+func (SearchHitType) AllValues() []SearchHitType {
+	return []SearchHitType{
+		SearchHitTypeTicket,
+		SearchHitTypeNote,
+		SearchHitTypeReview,
 	}
 }
 
 // MarshalText implements encoding.TextMarshaler.
-func (s ReviewStatus) MarshalText() ([]byte, error) {
+func (s SearchHitType) MarshalText() ([]byte, error) {
 	switch s {
-	case ReviewStatusActive:
+	case SearchHitTypeTicket:
 		return []byte(s), nil
-	case ReviewStatusStale:
+	case SearchHitTypeNote:
+		return []byte(s), nil
+	case SearchHitTypeReview:
 		return []byte(s), nil
 	default:
 		return nil, errors.Errorf("invalid value: %q", s)
@@ -1263,76 +3983,86 @@ func (s ReviewStatus) MarshalText() ([]byte, error) {
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ReviewStatus) UnmarshalText(data []byte) error {
-	switch ReviewStatus(data) {
-	case ReviewStatusActive:
-		*s = ReviewStatusActive
+func (s *SearchHitType) UnmarshalText(data []byte) error {
+	switch SearchHitType(data) {
+	case SearchHitTypeTicket:
+		*s = SearchHitTypeTicket
 		return nil
-	case ReviewStatusStale:
-		*s = ReviewStatusStale
+	case SearchHitTypeNote:
+		*s = SearchHitTypeNote
+		return nil
+	case SearchHitTypeReview:
+		*s = SearchHitTypeReview
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
-// レビューの種類.
-// Ref: #/components/schemas/ReviewType
-type ReviewType string
+type SearchOKApplicationJSON []SearchHit
 
-const (
-	ReviewTypeApprove       ReviewType = "approve"
-	ReviewTypeChangeRequest ReviewType = "change_request"
-	ReviewTypeComment       ReviewType = "comment"
-	ReviewTypeSystem        ReviewType = "system"
-)
+func (*SearchOKApplicationJSON) searchRes() {}
 
-// AllValues returns all ReviewType values.
-func (ReviewType) AllValues() []ReviewType {
-	return []ReviewType{
-		ReviewTypeApprove,
-		ReviewTypeChangeRequest,
-		ReviewTypeComment,
-		ReviewTypeSystem,
-	}
+// SearchUnauthorized is response for Search operation.
+type SearchUnauthorized struct{}
+
+func (*SearchUnauthorized) searchRes() {}
+
+type SessionAuth struct {
+	APIKey string
+	Roles  []string
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s ReviewType) MarshalText() ([]byte, error) {
-	switch s {
-	case ReviewTypeApprove:
-		return []byte(s), nil
-	case ReviewTypeChangeRequest:
-		return []byte(s), nil
-	case ReviewTypeComment:
-		return []byte(s), nil
-	case ReviewTypeSystem:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetAPIKey returns the value of APIKey.
+func (s *SessionAuth) GetAPIKey() string {
+	return s.APIKey
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *ReviewType) UnmarshalText(data []byte) error {
-	switch ReviewType(data) {
-	case ReviewTypeApprove:
-		*s = ReviewTypeApprove
-		return nil
-	case ReviewTypeChangeRequest:
-		*s = ReviewTypeChangeRequest
-		return nil
-	case ReviewTypeComment:
-		*s = ReviewTypeComment
-		return nil
-	case ReviewTypeSystem:
-		*s = ReviewTypeSystem
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// GetRoles returns the value of Roles.
+func (s *SessionAuth) GetRoles() []string {
+	return s.Roles
+}
+
+// SetAPIKey sets the value of APIKey.
+func (s *SessionAuth) SetAPIKey(val string) {
+	s.APIKey = val
+}
+
+// SetRoles sets the value of Roles.
+func (s *SessionAuth) SetRoles(val []string) {
+	s.Roles = val
 }
 
+// SubmitNoteConflict is response for SubmitNote operation.
+type SubmitNoteConflict struct{}
+
+func (*SubmitNoteConflict) submitNoteRes() {}
+
+// SubmitNoteForbidden is response for SubmitNote operation.
+type SubmitNoteForbidden struct{}
+
+func (*SubmitNoteForbidden) submitNoteRes() {}
+
+// SubmitNoteNotFound is response for SubmitNote operation.
+type SubmitNoteNotFound struct{}
+
+func (*SubmitNoteNotFound) submitNoteRes() {}
+
+// SyncUsersBadRequest is response for SyncUsers operation.
+type SyncUsersBadRequest struct{}
+
+func (*SyncUsersBadRequest) syncUsersRes() {}
+
+// SyncUsersConflict is response for SyncUsers operation.
+type SyncUsersConflict struct{}
+
+func (*SyncUsersConflict) syncUsersRes() {}
+
+// SyncUsersForbidden is response for SyncUsers operation.
+type SyncUsersForbidden struct{}
+
+func (*SyncUsersForbidden) syncUsersRes() {}
+
 // Ref: #/components/schemas/Ticket
 type Ticket struct {
 	// チケットID.
@@ -1348,12 +4078,17 @@ type Ticket struct {
 	// その他関係者リスト (traQ ID)。.
 	Stakeholders []string     `json:"stakeholders"`
 	Status       TicketStatus `json:"status"`
+	// Trueの場合、ノートやレビューの変更によるステータスの自動更新を行わない.
+	ManualStatus bool `json:"manual_status"`
 	// タグ (例: 協賛, 問い合わせ).
-	Tags []string `json:"tags"`
+	Tags       []string         `json:"tags"`
+	Visibility TicketVisibility `json:"visibility"`
 	// 期日。未指定時は自動設定される。.
 	Due       NilDate   `json:"due"`
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
+	// 作成時に伏字になっていない個人情報を検出した場合のみ。検出結果.
+	PiiWarnings []PIIWarning `json:"pii_warnings"`
 }
 
 // GetID returns the value of ID.
@@ -1391,11 +4126,21 @@ func (s *Ticket) GetStatus() TicketStatus {
 	return s.Status
 }
 
+// GetManualStatus returns the value of ManualStatus.
+func (s *Ticket) GetManualStatus() bool {
+	return s.ManualStatus
+}
+
 // GetTags returns the value of Tags.
 func (s *Ticket) GetTags() []string {
 	return s.Tags
 }
 
+// GetVisibility returns the value of Visibility.
+func (s *Ticket) GetVisibility() TicketVisibility {
+	return s.Visibility
+}
+
 // GetDue returns the value of Due.
 func (s *Ticket) GetDue() NilDate {
 	return s.Due
@@ -1411,6 +4156,11 @@ func (s *Ticket) GetUpdatedAt() time.Time {
 	return s.UpdatedAt
 }
 
+// GetPiiWarnings returns the value of PiiWarnings.
+func (s *Ticket) GetPiiWarnings() []PIIWarning {
+	return s.PiiWarnings
+}
+
 // SetID sets the value of ID.
 func (s *Ticket) SetID(val int64) {
 	s.ID = val
@@ -1446,11 +4196,21 @@ func (s *Ticket) SetStatus(val TicketStatus) {
 	s.Status = val
 }
 
+// SetManualStatus sets the value of ManualStatus.
+func (s *Ticket) SetManualStatus(val bool) {
+	s.ManualStatus = val
+}
+
 // SetTags sets the value of Tags.
 func (s *Ticket) SetTags(val []string) {
 	s.Tags = val
 }
 
+// SetVisibility sets the value of Visibility.
+func (s *Ticket) SetVisibility(val TicketVisibility) {
+	s.Visibility = val
+}
+
 // SetDue sets the value of Due.
 func (s *Ticket) SetDue(val NilDate) {
 	s.Due = val
@@ -1466,7 +4226,219 @@ func (s *Ticket) SetUpdatedAt(val time.Time) {
 	s.UpdatedAt = val
 }
 
-func (*Ticket) createTicketRes() {}
+// SetPiiWarnings sets the value of PiiWarnings.
+func (s *Ticket) SetPiiWarnings(val []PIIWarning) {
+	s.PiiWarnings = val
+}
+
+func (*Ticket) createTicketRes()  {}
+func (*Ticket) restoreTicketRes() {}
+
+// チケットの変更履歴.
+// Ref: #/components/schemas/TicketEvent
+type TicketEvent struct {
+	ID       int64 `json:"id"`
+	TicketID int64 `json:"ticket_id"`
+	// 変更したユーザーの traQ ID.
+	Actor string `json:"actor"`
+	// 変更の種類。
+	// status_derived
+	// はノートやレビューの変更に伴ってステータスが自動で変わったことを表す.
+	Action TicketEventAction `json:"action"`
+	// 対象のノートID (ノート・レビューの変更の場合のみ).
+	NoteID OptInt64 `json:"note_id"`
+	// 対象のレビューID (レビューの変更の場合のみ).
+	ReviewID  OptInt64      `json:"review_id"`
+	Changes   []FieldChange `json:"changes"`
+	CreatedAt time.Time     `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *TicketEvent) GetID() int64 {
+	return s.ID
+}
+
+// GetTicketID returns the value of TicketID.
+func (s *TicketEvent) GetTicketID() int64 {
+	return s.TicketID
+}
+
+// GetActor returns the value of Actor.
+func (s *TicketEvent) GetActor() string {
+	return s.Actor
+}
+
+// GetAction returns the value of Action.
+func (s *TicketEvent) GetAction() TicketEventAction {
+	return s.Action
+}
+
+// GetNoteID returns the value of NoteID.
+func (s *TicketEvent) GetNoteID() OptInt64 {
+	return s.NoteID
+}
+
+// GetReviewID returns the value of ReviewID.
+func (s *TicketEvent) GetReviewID() OptInt64 {
+	return s.ReviewID
+}
+
+// GetChanges returns the value of Changes.
+func (s *TicketEvent) GetChanges() []FieldChange {
+	return s.Changes
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *TicketEvent) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *TicketEvent) SetID(val int64) {
+	s.ID = val
+}
+
+// SetTicketID sets the value of TicketID.
+func (s *TicketEvent) SetTicketID(val int64) {
+	s.TicketID = val
+}
+
+// SetActor sets the value of Actor.
+func (s *TicketEvent) SetActor(val string) {
+	s.Actor = val
+}
+
+// SetAction sets the value of Action.
+func (s *TicketEvent) SetAction(val TicketEventAction) {
+	s.Action = val
+}
+
+// SetNoteID sets the value of NoteID.
+func (s *TicketEvent) SetNoteID(val OptInt64) {
+	s.NoteID = val
+}
+
+// SetReviewID sets the value of ReviewID.
+func (s *TicketEvent) SetReviewID(val OptInt64) {
+	s.ReviewID = val
+}
+
+// SetChanges sets the value of Changes.
+func (s *TicketEvent) SetChanges(val []FieldChange) {
+	s.Changes = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *TicketEvent) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// 変更の種類。
+// status_derived
+// はノートやレビューの変更に伴ってステータスが自動で変わったことを表す.
+type TicketEventAction string
+
+const (
+	TicketEventActionTicketCreated  TicketEventAction = "ticket_created"
+	TicketEventActionTicketUpdated  TicketEventAction = "ticket_updated"
+	TicketEventActionTicketDeleted  TicketEventAction = "ticket_deleted"
+	TicketEventActionTicketRestored TicketEventAction = "ticket_restored"
+	TicketEventActionStatusDerived  TicketEventAction = "status_derived"
+	TicketEventActionNoteCreated    TicketEventAction = "note_created"
+	TicketEventActionNoteUpdated    TicketEventAction = "note_updated"
+	TicketEventActionNoteDeleted    TicketEventAction = "note_deleted"
+	TicketEventActionReviewCreated  TicketEventAction = "review_created"
+	TicketEventActionReviewUpdated  TicketEventAction = "review_updated"
+	TicketEventActionReviewDeleted  TicketEventAction = "review_deleted"
+)
+
+// AllValues returns all TicketEventAction values.
+func (TicketEventAction) AllValues() []TicketEventAction {
+	return []TicketEventAction{
+		TicketEventActionTicketCreated,
+		TicketEventActionTicketUpdated,
+		TicketEventActionTicketDeleted,
+		TicketEventActionTicketRestored,
+		TicketEventActionStatusDerived,
+		TicketEventActionNoteCreated,
+		TicketEventActionNoteUpdated,
+		TicketEventActionNoteDeleted,
+		TicketEventActionReviewCreated,
+		TicketEventActionReviewUpdated,
+		TicketEventActionReviewDeleted,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TicketEventAction) MarshalText() ([]byte, error) {
+	switch s {
+	case TicketEventActionTicketCreated:
+		return []byte(s), nil
+	case TicketEventActionTicketUpdated:
+		return []byte(s), nil
+	case TicketEventActionTicketDeleted:
+		return []byte(s), nil
+	case TicketEventActionTicketRestored:
+		return []byte(s), nil
+	case TicketEventActionStatusDerived:
+		return []byte(s), nil
+	case TicketEventActionNoteCreated:
+		return []byte(s), nil
+	case TicketEventActionNoteUpdated:
+		return []byte(s), nil
+	case TicketEventActionNoteDeleted:
+		return []byte(s), nil
+	case TicketEventActionReviewCreated:
+		return []byte(s), nil
+	case TicketEventActionReviewUpdated:
+		return []byte(s), nil
+	case TicketEventActionReviewDeleted:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TicketEventAction) UnmarshalText(data []byte) error {
+	switch TicketEventAction(data) {
+	case TicketEventActionTicketCreated:
+		*s = TicketEventActionTicketCreated
+		return nil
+	case TicketEventActionTicketUpdated:
+		*s = TicketEventActionTicketUpdated
+		return nil
+	case TicketEventActionTicketDeleted:
+		*s = TicketEventActionTicketDeleted
+		return nil
+	case TicketEventActionTicketRestored:
+		*s = TicketEventActionTicketRestored
+		return nil
+	case TicketEventActionStatusDerived:
+		*s = TicketEventActionStatusDerived
+		return nil
+	case TicketEventActionNoteCreated:
+		*s = TicketEventActionNoteCreated
+		return nil
+	case TicketEventActionNoteUpdated:
+		*s = TicketEventActionNoteUpdated
+		return nil
+	case TicketEventActionNoteDeleted:
+		*s = TicketEventActionNoteDeleted
+		return nil
+	case TicketEventActionReviewCreated:
+		*s = TicketEventActionReviewCreated
+		return nil
+	case TicketEventActionReviewUpdated:
+		*s = TicketEventActionReviewUpdated
+		return nil
+	case TicketEventActionReviewDeleted:
+		*s = TicketEventActionReviewDeleted
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
 
 // チケットの進行状況
 // - not_planned: 方針決定待ち
@@ -1550,17 +4522,105 @@ func (s *TicketStatus) UnmarshalText(data []byte) error {
 	case TicketStatusMilestoneScheduled:
 		*s = TicketStatusMilestoneScheduled
 		return nil
-	case TicketStatusCompleted:
-		*s = TicketStatusCompleted
+	case TicketStatusCompleted:
+		*s = TicketStatusCompleted
+		return nil
+	case TicketStatusForgotten:
+		*s = TicketStatusForgotten
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// チケットの現在のステータスと、リクエストしたユーザーが遷移できるステータス.
+// Ref: #/components/schemas/TicketTransitions
+type TicketTransitions struct {
+	Current TicketStatus   `json:"current"`
+	Allowed []TicketStatus `json:"allowed"`
+}
+
+// GetCurrent returns the value of Current.
+func (s *TicketTransitions) GetCurrent() TicketStatus {
+	return s.Current
+}
+
+// GetAllowed returns the value of Allowed.
+func (s *TicketTransitions) GetAllowed() []TicketStatus {
+	return s.Allowed
+}
+
+// SetCurrent sets the value of Current.
+func (s *TicketTransitions) SetCurrent(val TicketStatus) {
+	s.Current = val
+}
+
+// SetAllowed sets the value of Allowed.
+func (s *TicketTransitions) SetAllowed(val []TicketStatus) {
+	s.Allowed = val
+}
+
+func (*TicketTransitions) getTicketTransitionsRes() {}
+
+// チケットの公開範囲
+// - public: 全員
+// - involved: 本職と担当者・副担当者・関係者のみ
+// - managers: 本職のみ
+// 公開範囲外のユーザーには、一覧・詳細・検索・AI機能・Botの通知のいずれでもチケットの存在自体を見せない.
+// Ref: #/components/schemas/TicketVisibility
+type TicketVisibility string
+
+const (
+	TicketVisibilityPublic   TicketVisibility = "public"
+	TicketVisibilityInvolved TicketVisibility = "involved"
+	TicketVisibilityManagers TicketVisibility = "managers"
+)
+
+// AllValues returns all TicketVisibility values.
+func (TicketVisibility) AllValues() []TicketVisibility {
+	return []TicketVisibility{
+		TicketVisibilityPublic,
+		TicketVisibilityInvolved,
+		TicketVisibilityManagers,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s TicketVisibility) MarshalText() ([]byte, error) {
+	switch s {
+	case TicketVisibilityPublic:
+		return []byte(s), nil
+	case TicketVisibilityInvolved:
+		return []byte(s), nil
+	case TicketVisibilityManagers:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *TicketVisibility) UnmarshalText(data []byte) error {
+	switch TicketVisibility(data) {
+	case TicketVisibilityPublic:
+		*s = TicketVisibilityPublic
+		return nil
+	case TicketVisibilityInvolved:
+		*s = TicketVisibilityInvolved
 		return nil
-	case TicketStatusForgotten:
-		*s = TicketStatusForgotten
+	case TicketVisibilityManagers:
+		*s = TicketVisibilityManagers
 		return nil
 	default:
 		return errors.Errorf("invalid value: %q", data)
 	}
 }
 
+// TicketsTicketIdAiGeneratePostForbidden is response for TicketsTicketIdAiGeneratePost operation.
+type TicketsTicketIdAiGeneratePostForbidden struct{}
+
+func (*TicketsTicketIdAiGeneratePostForbidden) ticketsTicketIdAiGeneratePostRes() {}
+
 // TicketsTicketIdAiGeneratePostInternalServerError is response for TicketsTicketIdAiGeneratePost operation.
 type TicketsTicketIdAiGeneratePostInternalServerError struct{}
 
@@ -1602,6 +4662,11 @@ func (s *TicketsTicketIdAiGeneratePostReq) SetInstruction(val OptString) {
 	s.Instruction = val
 }
 
+// TicketsTicketIdNotesNoteIdAiReviewPostForbidden is response for TicketsTicketIdNotesNoteIdAiReviewPost operation.
+type TicketsTicketIdNotesNoteIdAiReviewPostForbidden struct{}
+
+func (*TicketsTicketIdNotesNoteIdAiReviewPostForbidden) ticketsTicketIdNotesNoteIdAiReviewPostRes() {}
+
 // TicketsTicketIdNotesNoteIdAiReviewPostInternalServerError is response for TicketsTicketIdNotesNoteIdAiReviewPost operation.
 type TicketsTicketIdNotesNoteIdAiReviewPostInternalServerError struct{}
 
@@ -1629,26 +4694,114 @@ func (s TicketsTicketIdNotesNoteIdAiReviewPostOK) Read(p []byte) (n int, err err
 
 func (*TicketsTicketIdNotesNoteIdAiReviewPostOK) ticketsTicketIdNotesNoteIdAiReviewPostRes() {}
 
+// TicketsTicketIdNotesNoteIdDeleteForbidden is response for TicketsTicketIdNotesNoteIdDelete operation.
+type TicketsTicketIdNotesNoteIdDeleteForbidden struct{}
+
+func (*TicketsTicketIdNotesNoteIdDeleteForbidden) ticketsTicketIdNotesNoteIdDeleteRes() {}
+
 // TicketsTicketIdNotesNoteIdDeleteNoContent is response for TicketsTicketIdNotesNoteIdDelete operation.
 type TicketsTicketIdNotesNoteIdDeleteNoContent struct{}
 
 func (*TicketsTicketIdNotesNoteIdDeleteNoContent) ticketsTicketIdNotesNoteIdDeleteRes() {}
 
+// TicketsTicketIdNotesNoteIdDeleteNotFound is response for TicketsTicketIdNotesNoteIdDelete operation.
+type TicketsTicketIdNotesNoteIdDeleteNotFound struct{}
+
+func (*TicketsTicketIdNotesNoteIdDeleteNotFound) ticketsTicketIdNotesNoteIdDeleteRes() {}
+
+// TicketsTicketIdNotesNoteIdPutBadRequest is response for TicketsTicketIdNotesNoteIdPut operation.
+type TicketsTicketIdNotesNoteIdPutBadRequest struct{}
+
+func (*TicketsTicketIdNotesNoteIdPutBadRequest) ticketsTicketIdNotesNoteIdPutRes() {}
+
+// TicketsTicketIdNotesNoteIdPutConflict represents sum type.
+type TicketsTicketIdNotesNoteIdPutConflict struct {
+	Type            TicketsTicketIdNotesNoteIdPutConflictType // switch on this field
+	CensorConflict  CensorConflict
+	NoteNotEditable NoteNotEditable
+}
+
+// TicketsTicketIdNotesNoteIdPutConflictType is oneOf type of TicketsTicketIdNotesNoteIdPutConflict.
+type TicketsTicketIdNotesNoteIdPutConflictType string
+
+// Possible values for TicketsTicketIdNotesNoteIdPutConflictType.
+const (
+	CensorConflictTicketsTicketIdNotesNoteIdPutConflict  TicketsTicketIdNotesNoteIdPutConflictType = "CensorConflict"
+	NoteNotEditableTicketsTicketIdNotesNoteIdPutConflict TicketsTicketIdNotesNoteIdPutConflictType = "NoteNotEditable"
+)
+
+// IsCensorConflict reports whether TicketsTicketIdNotesNoteIdPutConflict is CensorConflict.
+func (s TicketsTicketIdNotesNoteIdPutConflict) IsCensorConflict() bool {
+	return s.Type == CensorConflictTicketsTicketIdNotesNoteIdPutConflict
+}
+
+// IsNoteNotEditable reports whether TicketsTicketIdNotesNoteIdPutConflict is NoteNotEditable.
+func (s TicketsTicketIdNotesNoteIdPutConflict) IsNoteNotEditable() bool {
+	return s.Type == NoteNotEditableTicketsTicketIdNotesNoteIdPutConflict
+}
+
+// SetCensorConflict sets TicketsTicketIdNotesNoteIdPutConflict to CensorConflict.
+func (s *TicketsTicketIdNotesNoteIdPutConflict) SetCensorConflict(v CensorConflict) {
+	s.Type = CensorConflictTicketsTicketIdNotesNoteIdPutConflict
+	s.CensorConflict = v
+}
+
+// GetCensorConflict returns CensorConflict and true boolean if TicketsTicketIdNotesNoteIdPutConflict is CensorConflict.
+func (s TicketsTicketIdNotesNoteIdPutConflict) GetCensorConflict() (v CensorConflict, ok bool) {
+	if !s.IsCensorConflict() {
+		return v, false
+	}
+	return s.CensorConflict, true
+}
+
+// NewCensorConflictTicketsTicketIdNotesNoteIdPutConflict returns new TicketsTicketIdNotesNoteIdPutConflict from CensorConflict.
+func NewCensorConflictTicketsTicketIdNotesNoteIdPutConflict(v CensorConflict) TicketsTicketIdNotesNoteIdPutConflict {
+	var s TicketsTicketIdNotesNoteIdPutConflict
+	s.SetCensorConflict(v)
+	return s
+}
+
+// SetNoteNotEditable sets TicketsTicketIdNotesNoteIdPutConflict to NoteNotEditable.
+func (s *TicketsTicketIdNotesNoteIdPutConflict) SetNoteNotEditable(v NoteNotEditable) {
+	s.Type = NoteNotEditableTicketsTicketIdNotesNoteIdPutConflict
+	s.NoteNotEditable = v
+}
+
+// GetNoteNotEditable returns NoteNotEditable and true boolean if TicketsTicketIdNotesNoteIdPutConflict is NoteNotEditable.
+func (s TicketsTicketIdNotesNoteIdPutConflict) GetNoteNotEditable() (v NoteNotEditable, ok bool) {
+	if !s.IsNoteNotEditable() {
+		return v, false
+	}
+	return s.NoteNotEditable, true
+}
+
+// NewNoteNotEditableTicketsTicketIdNotesNoteIdPutConflict returns new TicketsTicketIdNotesNoteIdPutConflict from NoteNotEditable.
+func NewNoteNotEditableTicketsTicketIdNotesNoteIdPutConflict(v NoteNotEditable) TicketsTicketIdNotesNoteIdPutConflict {
+	var s TicketsTicketIdNotesNoteIdPutConflict
+	s.SetNoteNotEditable(v)
+	return s
+}
+
+func (*TicketsTicketIdNotesNoteIdPutConflict) ticketsTicketIdNotesNoteIdPutRes() {}
+
 // TicketsTicketIdNotesNoteIdPutForbidden is response for TicketsTicketIdNotesNoteIdPut operation.
 type TicketsTicketIdNotesNoteIdPutForbidden struct{}
 
 func (*TicketsTicketIdNotesNoteIdPutForbidden) ticketsTicketIdNotesNoteIdPutRes() {}
 
-// TicketsTicketIdNotesNoteIdPutOK is response for TicketsTicketIdNotesNoteIdPut operation.
-type TicketsTicketIdNotesNoteIdPutOK struct{}
+// TicketsTicketIdNotesNoteIdPutNotFound is response for TicketsTicketIdNotesNoteIdPut operation.
+type TicketsTicketIdNotesNoteIdPutNotFound struct{}
 
-func (*TicketsTicketIdNotesNoteIdPutOK) ticketsTicketIdNotesNoteIdPutRes() {}
+func (*TicketsTicketIdNotesNoteIdPutNotFound) ticketsTicketIdNotesNoteIdPutRes() {}
 
 type TicketsTicketIdNotesNoteIdPutReq struct {
-	Content string     `json:"content"`
-	Status  NoteStatus `json:"status"`
-	// Trueの場合、承認状況(Weight)をリセットしてDraftに戻す.
-	ResetReviews bool `json:"reset_reviews"`
+	Content string `json:"content"`
+	// 現在のステータスと同じ値のみ受け付ける。ステータスはsubmit/withdraw/send/cancelで変更する.
+	Status OptNoteStatus `json:"status"`
+	// 使われない。本文を変更した場合は常にReviewが無効化される.
+	//
+	// Deprecated: schema marks this property as deprecated.
+	ResetReviews OptBool `json:"reset_reviews"`
 }
 
 // GetContent returns the value of Content.
@@ -1657,12 +4810,12 @@ func (s *TicketsTicketIdNotesNoteIdPutReq) GetContent() string {
 }
 
 // GetStatus returns the value of Status.
-func (s *TicketsTicketIdNotesNoteIdPutReq) GetStatus() NoteStatus {
+func (s *TicketsTicketIdNotesNoteIdPutReq) GetStatus() OptNoteStatus {
 	return s.Status
 }
 
 // GetResetReviews returns the value of ResetReviews.
-func (s *TicketsTicketIdNotesNoteIdPutReq) GetResetReviews() bool {
+func (s *TicketsTicketIdNotesNoteIdPutReq) GetResetReviews() OptBool {
 	return s.ResetReviews
 }
 
@@ -1672,15 +4825,25 @@ func (s *TicketsTicketIdNotesNoteIdPutReq) SetContent(val string) {
 }
 
 // SetStatus sets the value of Status.
-func (s *TicketsTicketIdNotesNoteIdPutReq) SetStatus(val NoteStatus) {
+func (s *TicketsTicketIdNotesNoteIdPutReq) SetStatus(val OptNoteStatus) {
 	s.Status = val
 }
 
 // SetResetReviews sets the value of ResetReviews.
-func (s *TicketsTicketIdNotesNoteIdPutReq) SetResetReviews(val bool) {
+func (s *TicketsTicketIdNotesNoteIdPutReq) SetResetReviews(val OptBool) {
 	s.ResetReviews = val
 }
 
+// TicketsTicketIdNotesPostForbidden is response for TicketsTicketIdNotesPost operation.
+type TicketsTicketIdNotesPostForbidden struct{}
+
+func (*TicketsTicketIdNotesPostForbidden) ticketsTicketIdNotesPostRes() {}
+
+// TicketsTicketIdNotesPostNotFound is response for TicketsTicketIdNotesPost operation.
+type TicketsTicketIdNotesPostNotFound struct{}
+
+func (*TicketsTicketIdNotesPostNotFound) ticketsTicketIdNotesPostRes() {}
+
 type TicketsTicketIdNotesPostReq struct {
 	Type    NoteType `json:"type"`
 	Content string   `json:"content"`
@@ -1743,6 +4906,133 @@ func (s *TraQAuth) SetRoles(val []string) {
 	s.Roles = val
 }
 
+// 削除済み (ゴミ箱にある) チケット.
+// Ref: #/components/schemas/TrashedTicket
+type TrashedTicket struct {
+	Ticket    Ticket    `json:"ticket"`
+	DeletedAt time.Time `json:"deleted_at"`
+	// 完全に削除される予定日時 (自動削除が無効の場合は省略).
+	PurgeAt OptDateTime `json:"purge_at"`
+}
+
+// GetTicket returns the value of Ticket.
+func (s *TrashedTicket) GetTicket() Ticket {
+	return s.Ticket
+}
+
+// GetDeletedAt returns the value of DeletedAt.
+func (s *TrashedTicket) GetDeletedAt() time.Time {
+	return s.DeletedAt
+}
+
+// GetPurgeAt returns the value of PurgeAt.
+func (s *TrashedTicket) GetPurgeAt() OptDateTime {
+	return s.PurgeAt
+}
+
+// SetTicket sets the value of Ticket.
+func (s *TrashedTicket) SetTicket(val Ticket) {
+	s.Ticket = val
+}
+
+// SetDeletedAt sets the value of DeletedAt.
+func (s *TrashedTicket) SetDeletedAt(val time.Time) {
+	s.DeletedAt = val
+}
+
+// SetPurgeAt sets the value of PurgeAt.
+func (s *TrashedTicket) SetPurgeAt(val OptDateTime) {
+	s.PurgeAt = val
+}
+
+// 伏字を伏せずに返した記録.
+// Ref: #/components/schemas/UncensoredView
+type UncensoredView struct {
+	ID int64 `json:"id"`
+	// 閲覧したユーザーのtraQ ID.
+	Actor    string `json:"actor"`
+	TicketID int64  `json:"ticket_id"`
+	// ノートの本文・レビューのコメントを閲覧した場合のみ.
+	NoteID OptInt64 `json:"note_id"`
+	// 閲覧したAPI (例: GET /tickets/1)。BotのDMで伏字を伏せずに送った場合はtraQ DM.
+	Endpoint  string    `json:"endpoint"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// GetID returns the value of ID.
+func (s *UncensoredView) GetID() int64 {
+	return s.ID
+}
+
+// GetActor returns the value of Actor.
+func (s *UncensoredView) GetActor() string {
+	return s.Actor
+}
+
+// GetTicketID returns the value of TicketID.
+func (s *UncensoredView) GetTicketID() int64 {
+	return s.TicketID
+}
+
+// GetNoteID returns the value of NoteID.
+func (s *UncensoredView) GetNoteID() OptInt64 {
+	return s.NoteID
+}
+
+// GetEndpoint returns the value of Endpoint.
+func (s *UncensoredView) GetEndpoint() string {
+	return s.Endpoint
+}
+
+// GetCreatedAt returns the value of CreatedAt.
+func (s *UncensoredView) GetCreatedAt() time.Time {
+	return s.CreatedAt
+}
+
+// SetID sets the value of ID.
+func (s *UncensoredView) SetID(val int64) {
+	s.ID = val
+}
+
+// SetActor sets the value of Actor.
+func (s *UncensoredView) SetActor(val string) {
+	s.Actor = val
+}
+
+// SetTicketID sets the value of TicketID.
+func (s *UncensoredView) SetTicketID(val int64) {
+	s.TicketID = val
+}
+
+// SetNoteID sets the value of NoteID.
+func (s *UncensoredView) SetNoteID(val OptInt64) {
+	s.NoteID = val
+}
+
+// SetEndpoint sets the value of Endpoint.
+func (s *UncensoredView) SetEndpoint(val string) {
+	s.Endpoint = val
+}
+
+// SetCreatedAt sets the value of CreatedAt.
+func (s *UncensoredView) SetCreatedAt(val time.Time) {
+	s.CreatedAt = val
+}
+
+// UpdateCensorPolicyBadRequest is response for UpdateCensorPolicy operation.
+type UpdateCensorPolicyBadRequest struct{}
+
+func (*UpdateCensorPolicyBadRequest) updateCensorPolicyRes() {}
+
+// UpdateCensorPolicyForbidden is response for UpdateCensorPolicy operation.
+type UpdateCensorPolicyForbidden struct{}
+
+func (*UpdateCensorPolicyForbidden) updateCensorPolicyRes() {}
+
+type UpdateCensorPolicyOKApplicationJSON []CensorPolicyRule
+
+func (*UpdateCensorPolicyOKApplicationJSON) updateCensorPolicyRes() {}
+
 // UpdateReviewForbidden is response for UpdateReview operation.
 type UpdateReviewForbidden struct{}
 
@@ -1799,6 +5089,76 @@ type UpdateTicketByIDBadRequest struct{}
 
 func (*UpdateTicketByIDBadRequest) updateTicketByIDRes() {}
 
+// UpdateTicketByIDConflict represents sum type.
+type UpdateTicketByIDConflict struct {
+	Type              UpdateTicketByIDConflictType // switch on this field
+	TicketTransitions TicketTransitions
+	CensorConflict    CensorConflict
+}
+
+// UpdateTicketByIDConflictType is oneOf type of UpdateTicketByIDConflict.
+type UpdateTicketByIDConflictType string
+
+// Possible values for UpdateTicketByIDConflictType.
+const (
+	TicketTransitionsUpdateTicketByIDConflict UpdateTicketByIDConflictType = "TicketTransitions"
+	CensorConflictUpdateTicketByIDConflict    UpdateTicketByIDConflictType = "CensorConflict"
+)
+
+// IsTicketTransitions reports whether UpdateTicketByIDConflict is TicketTransitions.
+func (s UpdateTicketByIDConflict) IsTicketTransitions() bool {
+	return s.Type == TicketTransitionsUpdateTicketByIDConflict
+}
+
+// IsCensorConflict reports whether UpdateTicketByIDConflict is CensorConflict.
+func (s UpdateTicketByIDConflict) IsCensorConflict() bool {
+	return s.Type == CensorConflictUpdateTicketByIDConflict
+}
+
+// SetTicketTransitions sets UpdateTicketByIDConflict to TicketTransitions.
+func (s *UpdateTicketByIDConflict) SetTicketTransitions(v TicketTransitions) {
+	s.Type = TicketTransitionsUpdateTicketByIDConflict
+	s.TicketTransitions = v
+}
+
+// GetTicketTransitions returns TicketTransitions and true boolean if UpdateTicketByIDConflict is TicketTransitions.
+func (s UpdateTicketByIDConflict) GetTicketTransitions() (v TicketTransitions, ok bool) {
+	if !s.IsTicketTransitions() {
+		return v, false
+	}
+	return s.TicketTransitions, true
+}
+
+// NewTicketTransitionsUpdateTicketByIDConflict returns new UpdateTicketByIDConflict from TicketTransitions.
+func NewTicketTransitionsUpdateTicketByIDConflict(v TicketTransitions) UpdateTicketByIDConflict {
+	var s UpdateTicketByIDConflict
+	s.SetTicketTransitions(v)
+	return s
+}
+
+// SetCensorConflict sets UpdateTicketByIDConflict to CensorConflict.
+func (s *UpdateTicketByIDConflict) SetCensorConflict(v CensorConflict) {
+	s.Type = CensorConflictUpdateTicketByIDConflict
+	s.CensorConflict = v
+}
+
+// GetCensorConflict returns CensorConflict and true boolean if UpdateTicketByIDConflict is CensorConflict.
+func (s UpdateTicketByIDConflict) GetCensorConflict() (v CensorConflict, ok bool) {
+	if !s.IsCensorConflict() {
+		return v, false
+	}
+	return s.CensorConflict, true
+}
+
+// NewCensorConflictUpdateTicketByIDConflict returns new UpdateTicketByIDConflict from CensorConflict.
+func NewCensorConflictUpdateTicketByIDConflict(v CensorConflict) UpdateTicketByIDConflict {
+	var s UpdateTicketByIDConflict
+	s.SetCensorConflict(v)
+	return s
+}
+
+func (*UpdateTicketByIDConflict) updateTicketByIDRes() {}
+
 // UpdateTicketByIDForbidden is response for UpdateTicketByID operation.
 type UpdateTicketByIDForbidden struct{}
 
@@ -1809,20 +5169,18 @@ type UpdateTicketByIDNotFound struct{}
 
 func (*UpdateTicketByIDNotFound) updateTicketByIDRes() {}
 
-// UpdateTicketByIDOK is response for UpdateTicketByID operation.
-type UpdateTicketByIDOK struct{}
-
-func (*UpdateTicketByIDOK) updateTicketByIDRes() {}
-
 type UpdateTicketByIDReq struct {
-	Title        OptString       `json:"title"`
-	Description  OptString       `json:"description"`
-	Status       OptTicketStatus `json:"status"`
-	Assignee     OptString       `json:"assignee"`
-	SubAssignees []string        `json:"sub_assignees"`
-	Stakeholders []string        `json:"stakeholders"`
-	Due          OptDate         `json:"due"`
-	Tags         []string        `json:"tags"`
+	Title       OptString       `json:"title"`
+	Description OptString       `json:"description"`
+	Status      OptTicketStatus `json:"status"`
+	// Trueの場合、ステータスを自動更新しない.
+	ManualStatus OptBool             `json:"manual_status"`
+	Assignee     OptString           `json:"assignee"`
+	SubAssignees []string            `json:"sub_assignees"`
+	Stakeholders []string            `json:"stakeholders"`
+	Due          OptDate             `json:"due"`
+	Tags         []string            `json:"tags"`
+	Visibility   OptTicketVisibility `json:"visibility"`
 }
 
 // GetTitle returns the value of Title.
@@ -1840,6 +5198,11 @@ func (s *UpdateTicketByIDReq) GetStatus() OptTicketStatus {
 	return s.Status
 }
 
+// GetManualStatus returns the value of ManualStatus.
+func (s *UpdateTicketByIDReq) GetManualStatus() OptBool {
+	return s.ManualStatus
+}
+
 // GetAssignee returns the value of Assignee.
 func (s *UpdateTicketByIDReq) GetAssignee() OptString {
 	return s.Assignee
@@ -1865,6 +5228,11 @@ func (s *UpdateTicketByIDReq) GetTags() []string {
 	return s.Tags
 }
 
+// GetVisibility returns the value of Visibility.
+func (s *UpdateTicketByIDReq) GetVisibility() OptTicketVisibility {
+	return s.Visibility
+}
+
 // SetTitle sets the value of Title.
 func (s *UpdateTicketByIDReq) SetTitle(val OptString) {
 	s.Title = val
@@ -1880,6 +5248,11 @@ func (s *UpdateTicketByIDReq) SetStatus(val OptTicketStatus) {
 	s.Status = val
 }
 
+// SetManualStatus sets the value of ManualStatus.
+func (s *UpdateTicketByIDReq) SetManualStatus(val OptBool) {
+	s.ManualStatus = val
+}
+
 // SetAssignee sets the value of Assignee.
 func (s *UpdateTicketByIDReq) SetAssignee(val OptString) {
 	s.Assignee = val
@@ -1905,17 +5278,50 @@ func (s *UpdateTicketByIDReq) SetTags(val []string) {
 	s.Tags = val
 }
 
+// SetVisibility sets the value of Visibility.
+func (s *UpdateTicketByIDReq) SetVisibility(val OptTicketVisibility) {
+	s.Visibility = val
+}
+
 // UpdateTicketByIDUnauthorized is response for UpdateTicketByID operation.
 type UpdateTicketByIDUnauthorized struct{}
 
 func (*UpdateTicketByIDUnauthorized) updateTicketByIDRes() {}
 
+// UpdateUserConflict is response for UpdateUser operation.
+type UpdateUserConflict struct{}
+
+func (*UpdateUserConflict) updateUserRes() {}
+
+// UpdateUserForbidden is response for UpdateUser operation.
+type UpdateUserForbidden struct{}
+
+func (*UpdateUserForbidden) updateUserRes() {}
+
+// UpdateUserNotFound is response for UpdateUser operation.
+type UpdateUserNotFound struct{}
+
+func (*UpdateUserNotFound) updateUserRes() {}
+
+type UpdateUserReq struct {
+	Role UserRole `json:"role"`
+}
+
+// GetRole returns the value of Role.
+func (s *UpdateUserReq) GetRole() UserRole {
+	return s.Role
+}
+
+// SetRole sets the value of Role.
+func (s *UpdateUserReq) SetRole(val UserRole) {
+	s.Role = val
+}
+
 // Ref: #/components/schemas/User
 type User struct {
 	// TraQ ID (例: ramdos).
-	TraqID string `json:"traq_id"`
-	// ユーザーの役割 (manager: 本職, assistant: 補佐, member: その他).
-	Role UserRole `json:"role"`
+	TraqID string   `json:"traq_id"`
+	Role   UserRole `json:"role"`
 }
 
 // GetTraqID returns the value of TraqID.
@@ -1938,7 +5344,140 @@ func (s *User) SetRole(val UserRole) {
 	s.Role = val
 }
 
+func (*User) createUserRes() {}
+func (*User) updateUserRes() {}
+
+// ユーザーの追加・ロールの変更・削除.
+// Ref: #/components/schemas/UserChange
+type UserChange struct {
+	TraqID string `json:"traq_id"`
+	// 変更前のロール。追加の場合はnull.
+	BeforeRole NilString `json:"before_role"`
+	// 変更後のロール。削除の場合はnull.
+	AfterRole NilString `json:"after_role"`
+}
+
+// GetTraqID returns the value of TraqID.
+func (s *UserChange) GetTraqID() string {
+	return s.TraqID
+}
+
+// GetBeforeRole returns the value of BeforeRole.
+func (s *UserChange) GetBeforeRole() NilString {
+	return s.BeforeRole
+}
+
+// GetAfterRole returns the value of AfterRole.
+func (s *UserChange) GetAfterRole() NilString {
+	return s.AfterRole
+}
+
+// SetTraqID sets the value of TraqID.
+func (s *UserChange) SetTraqID(val string) {
+	s.TraqID = val
+}
+
+// SetBeforeRole sets the value of BeforeRole.
+func (s *UserChange) SetBeforeRole(val NilString) {
+	s.BeforeRole = val
+}
+
+// SetAfterRole sets the value of AfterRole.
+func (s *UserChange) SetAfterRole(val NilString) {
+	s.AfterRole = val
+}
+
+// TraQ のユーザー情報.
+// Ref: #/components/schemas/UserProfile
+type UserProfile struct {
+	// TraQ ID (例: ramdos).
+	TraqID string `json:"traq_id"`
+	// TraQ のユーザー UUID.
+	UserID      string `json:"user_id"`
+	DisplayName string `json:"display_name"`
+	// アイコン画像の traQ のファイル UUID.
+	IconFileID string `json:"icon_file_id"`
+	Bot        bool   `json:"bot"`
+	// TraQ で凍結されているか.
+	Suspended bool `json:"suspended"`
+	// 登録されているロール。登録されていないユーザーの場合はnull.
+	Role NilString `json:"role"`
+}
+
+// GetTraqID returns the value of TraqID.
+func (s *UserProfile) GetTraqID() string {
+	return s.TraqID
+}
+
+// GetUserID returns the value of UserID.
+func (s *UserProfile) GetUserID() string {
+	return s.UserID
+}
+
+// GetDisplayName returns the value of DisplayName.
+func (s *UserProfile) GetDisplayName() string {
+	return s.DisplayName
+}
+
+// GetIconFileID returns the value of IconFileID.
+func (s *UserProfile) GetIconFileID() string {
+	return s.IconFileID
+}
+
+// GetBot returns the value of Bot.
+func (s *UserProfile) GetBot() bool {
+	return s.Bot
+}
+
+// GetSuspended returns the value of Suspended.
+func (s *UserProfile) GetSuspended() bool {
+	return s.Suspended
+}
+
+// GetRole returns the value of Role.
+func (s *UserProfile) GetRole() NilString {
+	return s.Role
+}
+
+// SetTraqID sets the value of TraqID.
+func (s *UserProfile) SetTraqID(val string) {
+	s.TraqID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *UserProfile) SetUserID(val string) {
+	s.UserID = val
+}
+
+// SetDisplayName sets the value of DisplayName.
+func (s *UserProfile) SetDisplayName(val string) {
+	s.DisplayName = val
+}
+
+// SetIconFileID sets the value of IconFileID.
+func (s *UserProfile) SetIconFileID(val string) {
+	s.IconFileID = val
+}
+
+// SetBot sets the value of Bot.
+func (s *UserProfile) SetBot(val bool) {
+	s.Bot = val
+}
+
+// SetSuspended sets the value of Suspended.
+func (s *UserProfile) SetSuspended(val bool) {
+	s.Suspended = val
+}
+
+// SetRole sets the value of Role.
+func (s *UserProfile) SetRole(val NilString) {
+	s.Role = val
+}
+
+func (*UserProfile) getUserRes() {}
+
 // ユーザーの役割 (manager: 本職, assistant: 補佐, member: その他).
+// Ref: #/components/schemas/UserRole
 type UserRole string
 
 const (
@@ -1987,16 +5526,65 @@ func (s *UserRole) UnmarshalText(data []byte) error {
 	}
 }
 
+// Ref: #/components/schemas/UserSyncResult
+type UserSyncResult struct {
+	// Trueの場合、changesは適用されていない.
+	DryRun bool `json:"dry_run"`
+	// TraQ ID順.
+	Changes []UserChange `json:"changes"`
+}
+
+// GetDryRun returns the value of DryRun.
+func (s *UserSyncResult) GetDryRun() bool {
+	return s.DryRun
+}
+
+// GetChanges returns the value of Changes.
+func (s *UserSyncResult) GetChanges() []UserChange {
+	return s.Changes
+}
+
+// SetDryRun sets the value of DryRun.
+func (s *UserSyncResult) SetDryRun(val bool) {
+	s.DryRun = val
+}
+
+// SetChanges sets the value of Changes.
+func (s *UserSyncResult) SetChanges(val []UserChange) {
+	s.Changes = val
+}
+
+func (*UserSyncResult) syncUsersRes() {}
+
 type UsersGetOKApplicationJSON []User
 
 func (*UsersGetOKApplicationJSON) usersGetRes() {}
 
+// UsersPutConflict is response for UsersPut operation.
+type UsersPutConflict struct{}
+
+func (*UsersPutConflict) usersPutRes() {}
+
 // UsersPutForbidden is response for UsersPut operation.
 type UsersPutForbidden struct{}
 
 func (*UsersPutForbidden) usersPutRes() {}
 
-// UsersPutOK is response for UsersPut operation.
-type UsersPutOK struct{}
+type UsersPutOKApplicationJSON []RoleChange
+
+func (*UsersPutOKApplicationJSON) usersPutRes() {}
+
+// WithdrawNoteConflict is response for WithdrawNote operation.
+type WithdrawNoteConflict struct{}
+
+func (*WithdrawNoteConflict) withdrawNoteRes() {}
+
+// WithdrawNoteForbidden is response for WithdrawNote operation.
+type WithdrawNoteForbidden struct{}
+
+func (*WithdrawNoteForbidden) withdrawNoteRes() {}
+
+// WithdrawNoteNotFound is response for WithdrawNote operation.
+type WithdrawNoteNotFound struct{}
 
-func (*UsersPutOK) usersPutRes() {}
+func (*WithdrawNoteNotFound) withdrawNoteRes() {}
@@ -13,8 +13,20 @@ import (
 
 // SecurityHandler is handler for security parameters.
 type SecurityHandler interface {
+	// HandleBearerAuth handles bearerAuth security.
+	// 個人用APIトークン (POST /me/tokens で発行)。
+	// スコープが read のトークンは読み取りのみ、tickets:write
+	// のトークンはチケット・ノート・レビューの操作まで、admin
+	// のトークンはすべての操作を実行できる。
+	// スコープが足りない場合は403を返す。.
+	HandleBearerAuth(ctx context.Context, operationName OperationName, t BearerAuth) (context.Context, error)
+	// HandleSessionAuth handles sessionAuth security.
+	// TraQ OAuth2でログインした際に発行されるセッション.
+	HandleSessionAuth(ctx context.Context, operationName OperationName, t SessionAuth) (context.Context, error)
 	// HandleTraQAuth handles traQAuth security.
-	// TraQ IDをヘッダーに付与して認証.
+	// TraQ IDをヘッダーに付与して認証。
+	// 信頼できるプロキシ (TRUSTED_PROXY_CIDRS)
+	// からのリクエストのみ有効で、それ以外のリクエストではヘッダーは無視される。.
 	HandleTraQAuth(ctx context.Context, operationName OperationName, t TraQAuth) (context.Context, error)
 }
 
@@ -33,25 +45,257 @@ func findAuthorization(h http.Header, prefix string) (string, bool) {
 	return "", false
 }
 
+// operationRolesBearerAuth is a private map storing roles per operation.
+var operationRolesBearerAuth = map[string][]string{
+	CancelNoteOperation:                             []string{},
+	ConfigGetOperation:                              []string{},
+	ConfigPostOperation:                             []string{},
+	CreateMyTokenOperation:                          []string{},
+	CreateReviewOperation:                           []string{},
+	CreateTicketOperation:                           []string{},
+	CreateUserOperation:                             []string{},
+	DeleteMyTokenOperation:                          []string{},
+	DeleteReviewOperation:                           []string{},
+	DeleteTicketByIDOperation:                       []string{},
+	DeleteUserOperation:                             []string{},
+	GetCensorPolicyOperation:                        []string{},
+	GetMyTokensOperation:                            []string{},
+	GetNoteRevisionDiffOperation:                    []string{},
+	GetNoteRevisionsOperation:                       []string{},
+	GetReviewDiffOperation:                          []string{},
+	GetRoleChangesOperation:                         []string{},
+	GetTicketByIDOperation:                          []string{},
+	GetTicketHistoryOperation:                       []string{},
+	GetTicketTransitionsOperation:                   []string{},
+	GetTicketsOperation:                             []string{},
+	GetTrashedTicketsOperation:                      []string{},
+	GetUncensoredViewsOperation:                     []string{},
+	GetUserOperation:                                []string{},
+	LogoutOperation:                                 []string{},
+	MarkNoteSentOperation:                           []string{},
+	MeGetOperation:                                  []string{},
+	PurgeTicketOperation:                            []string{},
+	RequestReviewsOperation:                         []string{},
+	RestoreTicketOperation:                          []string{},
+	ScanPIIOperation:                                []string{},
+	SearchOperation:                                 []string{},
+	SubmitNoteOperation:                             []string{},
+	SyncUsersOperation:                              []string{},
+	TicketsTicketIdAiGeneratePostOperation:          []string{},
+	TicketsTicketIdNotesNoteIdAiReviewPostOperation: []string{},
+	TicketsTicketIdNotesNoteIdDeleteOperation:       []string{},
+	TicketsTicketIdNotesNoteIdPutOperation:          []string{},
+	TicketsTicketIdNotesPostOperation:               []string{},
+	UpdateCensorPolicyOperation:                     []string{},
+	UpdateReviewOperation:                           []string{},
+	UpdateTicketByIDOperation:                       []string{},
+	UpdateUserOperation:                             []string{},
+	UsersGetOperation:                               []string{},
+	UsersPutOperation:                               []string{},
+	WithdrawNoteOperation:                           []string{},
+}
+
+// GetRolesForBearerAuth returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForBearerAuth(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForBearerAuth(operation string) []string {
+	roles, ok := operationRolesBearerAuth[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+// operationRolesSessionAuth is a private map storing roles per operation.
+var operationRolesSessionAuth = map[string][]string{
+	CancelNoteOperation:                             []string{},
+	ConfigGetOperation:                              []string{},
+	ConfigPostOperation:                             []string{},
+	CreateMyTokenOperation:                          []string{},
+	CreateReviewOperation:                           []string{},
+	CreateTicketOperation:                           []string{},
+	CreateUserOperation:                             []string{},
+	DeleteMyTokenOperation:                          []string{},
+	DeleteReviewOperation:                           []string{},
+	DeleteTicketByIDOperation:                       []string{},
+	DeleteUserOperation:                             []string{},
+	GetCensorPolicyOperation:                        []string{},
+	GetMyTokensOperation:                            []string{},
+	GetNoteRevisionDiffOperation:                    []string{},
+	GetNoteRevisionsOperation:                       []string{},
+	GetReviewDiffOperation:                          []string{},
+	GetRoleChangesOperation:                         []string{},
+	GetTicketByIDOperation:                          []string{},
+	GetTicketHistoryOperation:                       []string{},
+	GetTicketTransitionsOperation:                   []string{},
+	GetTicketsOperation:                             []string{},
+	GetTrashedTicketsOperation:                      []string{},
+	GetUncensoredViewsOperation:                     []string{},
+	GetUserOperation:                                []string{},
+	LogoutOperation:                                 []string{},
+	MarkNoteSentOperation:                           []string{},
+	MeGetOperation:                                  []string{},
+	PurgeTicketOperation:                            []string{},
+	RequestReviewsOperation:                         []string{},
+	RestoreTicketOperation:                          []string{},
+	ScanPIIOperation:                                []string{},
+	SearchOperation:                                 []string{},
+	SubmitNoteOperation:                             []string{},
+	SyncUsersOperation:                              []string{},
+	TicketsTicketIdAiGeneratePostOperation:          []string{},
+	TicketsTicketIdNotesNoteIdAiReviewPostOperation: []string{},
+	TicketsTicketIdNotesNoteIdDeleteOperation:       []string{},
+	TicketsTicketIdNotesNoteIdPutOperation:          []string{},
+	TicketsTicketIdNotesPostOperation:               []string{},
+	UpdateCensorPolicyOperation:                     []string{},
+	UpdateReviewOperation:                           []string{},
+	UpdateTicketByIDOperation:                       []string{},
+	UpdateUserOperation:                             []string{},
+	UsersGetOperation:                               []string{},
+	UsersPutOperation:                               []string{},
+	WithdrawNoteOperation:                           []string{},
+}
+
+// GetRolesForSessionAuth returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForSessionAuth(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForSessionAuth(operation string) []string {
+	roles, ok := operationRolesSessionAuth[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+// operationRolesTraQAuth is a private map storing roles per operation.
 var operationRolesTraQAuth = map[string][]string{
+	CancelNoteOperation:                             []string{},
 	ConfigGetOperation:                              []string{},
 	ConfigPostOperation:                             []string{},
+	CreateMyTokenOperation:                          []string{},
 	CreateReviewOperation:                           []string{},
 	CreateTicketOperation:                           []string{},
+	CreateUserOperation:                             []string{},
+	DeleteMyTokenOperation:                          []string{},
 	DeleteReviewOperation:                           []string{},
 	DeleteTicketByIDOperation:                       []string{},
+	DeleteUserOperation:                             []string{},
+	GetCensorPolicyOperation:                        []string{},
+	GetMyTokensOperation:                            []string{},
+	GetNoteRevisionDiffOperation:                    []string{},
+	GetNoteRevisionsOperation:                       []string{},
+	GetReviewDiffOperation:                          []string{},
+	GetRoleChangesOperation:                         []string{},
 	GetTicketByIDOperation:                          []string{},
+	GetTicketHistoryOperation:                       []string{},
+	GetTicketTransitionsOperation:                   []string{},
 	GetTicketsOperation:                             []string{},
+	GetTrashedTicketsOperation:                      []string{},
+	GetUncensoredViewsOperation:                     []string{},
+	GetUserOperation:                                []string{},
+	LogoutOperation:                                 []string{},
+	MarkNoteSentOperation:                           []string{},
 	MeGetOperation:                                  []string{},
+	PurgeTicketOperation:                            []string{},
+	RequestReviewsOperation:                         []string{},
+	RestoreTicketOperation:                          []string{},
+	ScanPIIOperation:                                []string{},
+	SearchOperation:                                 []string{},
+	SubmitNoteOperation:                             []string{},
+	SyncUsersOperation:                              []string{},
 	TicketsTicketIdAiGeneratePostOperation:          []string{},
 	TicketsTicketIdNotesNoteIdAiReviewPostOperation: []string{},
 	TicketsTicketIdNotesNoteIdDeleteOperation:       []string{},
 	TicketsTicketIdNotesNoteIdPutOperation:          []string{},
 	TicketsTicketIdNotesPostOperation:               []string{},
+	UpdateCensorPolicyOperation:                     []string{},
 	UpdateReviewOperation:                           []string{},
 	UpdateTicketByIDOperation:                       []string{},
+	UpdateUserOperation:                             []string{},
 	UsersGetOperation:                               []string{},
 	UsersPutOperation:                               []string{},
+	WithdrawNoteOperation:                           []string{},
+}
+
+// GetRolesForTraQAuth returns the required roles for the given operation.
+//
+// This is useful for authorization scenarios where you need to know which roles
+// are required for an operation.
+//
+// Example:
+//
+//	requiredRoles := GetRolesForTraQAuth(AddPetOperation)
+//
+// Returns nil if the operation has no role requirements or if the operation is unknown.
+func GetRolesForTraQAuth(operation string) []string {
+	roles, ok := operationRolesTraQAuth[operation]
+	if !ok {
+		return nil
+	}
+	// Return a copy to prevent external modification
+	result := make([]string, len(roles))
+	copy(result, roles)
+	return result
+}
+
+func (s *Server) securityBearerAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t BearerAuth
+	token, ok := findAuthorization(req.Header, "Bearer")
+	if !ok {
+		return ctx, false, nil
+	}
+	t.Token = token
+	t.Roles = operationRolesBearerAuth[operationName]
+	rctx, err := s.sec.HandleBearerAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
+}
+
+func (s *Server) securitySessionAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
+	var t SessionAuth
+	const parameterName = "anshin_session"
+	var value string
+	switch cookie, err := req.Cookie(parameterName); {
+	case err == nil: // if NO error
+		value = cookie.Value
+	case errors.Is(err, http.ErrNoCookie):
+		return ctx, false, nil
+	default:
+		return nil, false, errors.Wrap(err, "get cookie value")
+	}
+	t.APIKey = value
+	t.Roles = operationRolesSessionAuth[operationName]
+	rctx, err := s.sec.HandleSessionAuth(ctx, operationName, t)
+	if errors.Is(err, ogenerrors.ErrSkipServerSecurity) {
+		return nil, false, nil
+	} else if err != nil {
+		return nil, false, err
+	}
+	return rctx, true, err
 }
 
 func (s *Server) securityTraQAuth(ctx context.Context, operationName OperationName, req *http.Request) (context.Context, bool, error) {
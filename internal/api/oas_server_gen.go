@@ -8,6 +8,20 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AuthCallback implements authCallback operation.
+	//
+	// 認可コードをトークンに交換し、セッションを発行してトップページにリダイレクトする。traQ OAuth2が設定されていない場合は404を返す.
+	//
+	// GET /auth/callback
+	AuthCallback(ctx context.Context, params AuthCallbackParams) (AuthCallbackRes, error)
+	// CancelNote implements cancelNote operation.
+	//
+	// 送信済み・破棄済みでないノートを破棄(canceled)する。
+	// チケットの担当者・副担当者とレビューを依頼したユーザーにBotから通知する。
+	// Authorと本職のみ実行可能。.
+	//
+	// POST /tickets/{ticketId}/notes/{noteId}/cancel
+	CancelNote(ctx context.Context, params CancelNoteParams) (CancelNoteRes, error)
 	// ConfigGet implements GET /config operation.
 	//
 	// 設定情報の取得.
@@ -20,20 +34,40 @@ type Handler interface {
 	//
 	// POST /config
 	ConfigPost(ctx context.Context, req *Config) (ConfigPostRes, error)
+	// CreateMyToken implements createMyToken operation.
+	//
+	// トークンはハッシュ化して保存されるため、発行時のレスポンスでのみ確認できる.
+	//
+	// POST /me/tokens
+	CreateMyToken(ctx context.Context, req *APITokenCreate) (CreateMyTokenRes, error)
 	// CreateReview implements createReview operation.
 	//
-	// 承認(approve)の場合、Weightの上限はユーザー権限に基づく(本職5/補佐4/他0)。
-	// Weight合計が5以上になると、Noteのstatusが`waiting_sent`になる。
+	// 承認(approve)の場合、Weightの上限は承認ポリシー(設定のapproval_policy)のユーザー権限ごとの上限に基づく(既定は本職5/補佐4/他0)。
+	// 承認ポリシーの条件(既定はWeight合計が5以上)を満たすと、Noteのstatusが`waiting_sent`になる。
+	// Noteのrequire_requested_reviewsがtrueの場合は、レビューを依頼した全員の承認もそろう必要がある。
 	// すでにレビュー済みの場合は失敗する。.
 	//
 	// POST /tickets/{ticketId}/notes/{noteId}/reviews
 	CreateReview(ctx context.Context, req *CreateReviewReq, params CreateReviewParams) (CreateReviewRes, error)
 	// CreateTicket implements createTicket operation.
 	//
-	// 新規チケットを作成する。.
+	// 新規チケットを作成する。公開範囲(visibility)の省略時はpublicになる。managersは本職のみ指定できる。.
 	//
 	// POST /tickets
 	CreateTicket(ctx context.Context, req *CreateTicketReq) (CreateTicketRes, error)
+	// CreateUser implements createUser operation.
+	//
+	// Manager(本職)権限のみ。
+	// 本職が1人も登録されていない場合 (初期設定時) は誰でも実行できる。.
+	//
+	// POST /users
+	CreateUser(ctx context.Context, req *User) (CreateUserRes, error)
+	// DeleteMyToken implements deleteMyToken operation.
+	//
+	// APIトークンの削除.
+	//
+	// DELETE /me/tokens/{tokenId}
+	DeleteMyToken(ctx context.Context, params DeleteMyTokenParams) (DeleteMyTokenRes, error)
 	// DeleteReview implements deleteReview operation.
 	//
 	// レビュー取り消し.
@@ -46,24 +80,174 @@ type Handler interface {
 	//
 	// DELETE /tickets/{ticketId}
 	DeleteTicketByID(ctx context.Context, params DeleteTicketByIDParams) (DeleteTicketByIDRes, error)
+	// DeleteUser implements deleteUser operation.
+	//
+	// Manager(本職)権限のみ。.
+	//
+	// DELETE /users/{traqId}
+	DeleteUser(ctx context.Context, params DeleteUserParams) (DeleteUserRes, error)
+	// GetCensorPolicy implements getCensorPolicy operation.
+	//
+	// 伏字のカテゴリごとの閲覧ルールの取得.
+	//
+	// GET /config/censor-policy
+	GetCensorPolicy(ctx context.Context) (GetCensorPolicyRes, error)
+	// GetMyTokens implements getMyTokens operation.
+	//
+	// トークンそのものは返さない.
+	//
+	// GET /me/tokens
+	GetMyTokens(ctx context.Context) (GetMyTokensRes, error)
+	// GetNoteRevisionDiff implements getNoteRevisionDiff operation.
+	//
+	// FromRevisionの版からtoRevisionの版への行単位・文字単位の差分を返す。伏字は閲覧者の権限に応じて適用される。.
+	//
+	// GET /tickets/{ticketId}/notes/{noteId}/revisions/{fromRevision}/diff/{toRevision}
+	GetNoteRevisionDiff(ctx context.Context, params GetNoteRevisionDiffParams) (GetNoteRevisionDiffRes, error)
+	// GetNoteRevisions implements getNoteRevisions operation.
+	//
+	// ノートの本文の版を古い順に返す。伏字は閲覧者の権限に応じて適用される。.
+	//
+	// GET /tickets/{ticketId}/notes/{noteId}/revisions
+	GetNoteRevisions(ctx context.Context, params GetNoteRevisionsParams) (GetNoteRevisionsRes, error)
+	// GetReviewDiff implements getReviewDiff operation.
+	//
+	// レビューした版からノートの最新の版への差分を返す。伏字は閲覧者の権限に応じて適用される。.
+	//
+	// GET /tickets/{ticketId}/notes/{noteId}/reviews/{reviewId}/diff
+	GetReviewDiff(ctx context.Context, params GetReviewDiffParams) (GetReviewDiffRes, error)
+	// GetRoleChanges implements getRoleChanges operation.
+	//
+	// 本職権限のみ実行可能。新しい順に返す.
+	//
+	// GET /audit/role-changes
+	GetRoleChanges(ctx context.Context, params GetRoleChangesParams) (GetRoleChangesRes, error)
 	// GetTicketByID implements getTicketByID operation.
 	//
-	// チケットに紐づくノート一覧(notes)も同時に返却される。.
+	// チケットに紐づくノート一覧(notes)も同時に返却される。公開範囲外のチケットは404を返す。.
 	//
 	// GET /tickets/{ticketId}
 	GetTicketByID(ctx context.Context, params GetTicketByIDParams) (GetTicketByIDRes, error)
+	// GetTicketHistory implements getTicketHistory operation.
+	//
+	// チケットとそのノート・レビューの変更履歴を古い順に返す。伏字は閲覧者の権限に応じて適用される。.
+	//
+	// GET /tickets/{ticketId}/history
+	GetTicketHistory(ctx context.Context, params GetTicketHistoryParams) (GetTicketHistoryRes, error)
+	// GetTicketTransitions implements getTicketTransitions operation.
+	//
+	// リクエストしたユーザーが現在のステータスから遷移できるステータスを返す。.
+	//
+	// GET /tickets/{ticketId}/transitions
+	GetTicketTransitions(ctx context.Context, params GetTicketTransitionsParams) (GetTicketTransitionsRes, error)
 	// GetTickets implements getTickets operation.
 	//
-	// チケット一覧取得.
+	// 公開範囲外のチケットは結果にもX-Total-Countにも含めない。
+	// limitとcursorをどちらも省略した場合は、これまで通りすべてのチケットを返す。
+	// limitまたはcursorを指定した場合はlimit件 (省略時は50件)
+	// ずつ返すため、続きはX-Next-Cursorがなくなるまでcursorを指定して取得する。.
 	//
 	// GET /tickets
 	GetTickets(ctx context.Context, params GetTicketsParams) (GetTicketsRes, error)
+	// GetTrashedTickets implements getTrashedTickets operation.
+	//
+	// 本職のみ実行可能。削除日時の新しい順に返す。.
+	//
+	// GET /tickets/trash
+	GetTrashedTickets(ctx context.Context) (GetTrashedTicketsRes, error)
+	// GetUncensoredViews implements getUncensoredViews operation.
+	//
+	// 本職権限のみ実行可能。新しい順に返す.
+	//
+	// GET /audit/uncensored-views
+	GetUncensoredViews(ctx context.Context, params GetUncensoredViewsParams) (GetUncensoredViewsRes, error)
+	// GetUser implements getUser operation.
+	//
+	// TraQ の表示名・アイコンと登録されているロールを返す。traQ
+	// のユーザー情報は一定時間キャッシュされる。.
+	//
+	// GET /users/{traqId}
+	GetUser(ctx context.Context, params GetUserParams) (GetUserRes, error)
+	// Login implements login operation.
+	//
+	// TraQの認可画面にリダイレクトする。stateをログインを開始したブラウザに結び付けるCookieを発行する。traQ OAuth2が設定されていない場合は404を返す.
+	//
+	// GET /auth/login
+	Login(ctx context.Context) (LoginRes, error)
+	// Logout implements logout operation.
+	//
+	// セッションを破棄する.
+	//
+	// POST /auth/logout
+	Logout(ctx context.Context) (LogoutRes, error)
+	// MarkNoteSent implements markNoteSent operation.
+	//
+	// 送信待ち(waiting_sent)の発信ノートのみ送信済み(sent)にでき、sent_atを記録する。
+	// チケットの担当者・副担当者・関係者にBotから通知する。
+	// Authorと本職のみ実行可能。.
+	//
+	// POST /tickets/{ticketId}/notes/{noteId}/send
+	MarkNoteSent(ctx context.Context, params MarkNoteSentParams) (MarkNoteSentRes, error)
 	// MeGet implements GET /me operation.
 	//
 	// 認証ヘッダーから自分のtraQ IDを返す。.
 	//
 	// GET /me
 	MeGet(ctx context.Context) (MeGetRes, error)
+	// PurgeTicket implements purgeTicket operation.
+	//
+	// 本職のみ実行可能。ゴミ箱にあるチケットのみ対象で、ノート・レビューも削除される (変更履歴は変更前後の値を消して監査のため残る)。元に戻すことはできない。.
+	//
+	// DELETE /tickets/{ticketId}/purge
+	PurgeTicket(ctx context.Context, params PurgeTicketParams) (PurgeTicketRes, error)
+	// RequestReviews implements requestReviews operation.
+	//
+	// 送信するノート(outgoing)のレビューを指定したユーザーに依頼し、Botから通知する。
+	// すでに依頼しているユーザーには再度通知しない。
+	// require_requested_reviewsをtrueにすると、送信待ちになるにはWeightに加えて依頼した全員の承認が必要になる。
+	// 送信待ちのノートで依頼した全員の承認がそろっていない場合、ステータスはwaiting_reviewに戻る。
+	// Authorと本職のみ実行可能。.
+	//
+	// POST /tickets/{ticketId}/notes/{noteId}/review-requests
+	RequestReviews(ctx context.Context, req *RequestReviewsReq, params RequestReviewsParams) (RequestReviewsRes, error)
+	// RestoreTicket implements restoreTicket operation.
+	//
+	// 本職のみ実行可能。.
+	//
+	// POST /tickets/{ticketId}/restore
+	RestoreTicket(ctx context.Context, params RestoreTicketParams) (RestoreTicketRes, error)
+	// ScanPII implements scanPII operation.
+	//
+	// 本職権限のみ実行可能。削除済みのチケット・ノートは含めない.
+	//
+	// GET /pii/scan
+	ScanPII(ctx context.Context) (ScanPIIRes, error)
+	// Search implements search operation.
+	//
+	// チケットのタイトル・説明、ノートの本文、レビューのコメントを全文検索し、関連度の高い順に返す。
+	// 空白で区切った語はすべて含むものに絞り込む。
+	// 本職以外は閲覧できるカテゴリの伏字の中の文字列でのみ検索できる。
+	// 公開範囲外のチケットとそのノート・レビューは結果に含めない。.
+	//
+	// GET /search
+	Search(ctx context.Context, params SearchParams) (SearchRes, error)
+	// SubmitNote implements submitNote operation.
+	//
+	// 下書き(draft)の発信ノートを添削待ち(waiting_review)にし、submitted_atを記録する。
+	// 承認ポリシーの条件をすでに満たしている場合は送信待ち(waiting_sent)になる。
+	// チケットの担当者・副担当者とレビューを依頼したユーザーにBotから通知する。
+	// Authorと本職のみ実行可能。.
+	//
+	// POST /tickets/{ticketId}/notes/{noteId}/submit
+	SubmitNote(ctx context.Context, params SubmitNoteParams) (SubmitNoteRes, error)
+	// SyncUsers implements syncUsers operation.
+	//
+	// Manager(本職)権限のみ。設定されたtraQグループのメンバーをユーザーとロールに同期する。
+	// 複数のグループに所属するユーザーは上位のロールになり、どのグループにも所属しないユーザーは削除される。
+	// 定期的にも自動で同期される。.
+	//
+	// POST /users/sync
+	SyncUsers(ctx context.Context, params SyncUsersParams) (SyncUsersRes, error)
 	// TicketsTicketIdAiGeneratePost implements POST /tickets/{ticketId}/ai/generate operation.
 	//
 	// AIによる返信ドラフト生成 (SSE).
@@ -78,14 +262,22 @@ type Handler interface {
 	TicketsTicketIdNotesNoteIdAiReviewPost(ctx context.Context, params TicketsTicketIdNotesNoteIdAiReviewPostParams) (TicketsTicketIdNotesNoteIdAiReviewPostRes, error)
 	// TicketsTicketIdNotesNoteIdDelete implements DELETE /tickets/{ticketId}/notes/{noteId} operation.
 	//
-	// ノート削除.
+	// Authorと本職のみ実行可能。.
 	//
 	// DELETE /tickets/{ticketId}/notes/{noteId}
 	TicketsTicketIdNotesNoteIdDelete(ctx context.Context, params TicketsTicketIdNotesNoteIdDeleteParams) (TicketsTicketIdNotesNoteIdDeleteRes, error)
 	// TicketsTicketIdNotesNoteIdPut implements PUT /tickets/{ticketId}/notes/{noteId} operation.
 	//
-	// 送信ノートの編集時、既存のReviewを無効化する(Weightリセット)オプションがある。
-	// Authorのみ実行可能。.
+	// 本文を編集する。ステータスはsubmit/withdraw/send/cancelで変更する。
+	// 本文を変更した場合、有効なReviewはすべて無効化(stale)され、Weightに数えられなくなる。
+	// 無効化されたReviewのレビュワーにはBotから通知され、レビュー時からの差分を確認できる。
+	// waiting_sentのノートの本文を変更した場合、ステータスはwaiting_reviewに戻る。
+	// Authorと本職のみ実行可能。
+	// 本職以外が伏字 (!!■■■!!)
+	// を含む本文を送った場合、伏字は元の本文の伏字部分で順番に置き換えられる。
+	// 伏字の数またはカテゴリが元の本文と合わない場合
+	// (伏字をすべて消した場合を含む) は409を返す。
+	// 送信済み(sent)・破棄済み(canceled)のノートは編集できず、409を返す。.
 	//
 	// PUT /tickets/{ticketId}/notes/{noteId}
 	TicketsTicketIdNotesNoteIdPut(ctx context.Context, req *TicketsTicketIdNotesNoteIdPutReq, params TicketsTicketIdNotesNoteIdPutParams) (TicketsTicketIdNotesNoteIdPutRes, error)
@@ -95,6 +287,12 @@ type Handler interface {
 	//
 	// POST /tickets/{ticketId}/notes
 	TicketsTicketIdNotesPost(ctx context.Context, req *TicketsTicketIdNotesPostReq, params TicketsTicketIdNotesPostParams) (TicketsTicketIdNotesPostRes, error)
+	// UpdateCensorPolicy implements updateCensorPolicy operation.
+	//
+	// 本職権限のみ実行可能。すべてのルールを置き換える.
+	//
+	// PUT /config/censor-policy
+	UpdateCensorPolicy(ctx context.Context, req []CensorPolicyRule) (UpdateCensorPolicyRes, error)
 	// UpdateReview implements updateReview operation.
 	//
 	// ReviewのAuthorのみ実行可能。.
@@ -103,10 +301,22 @@ type Handler interface {
 	UpdateReview(ctx context.Context, req OptUpdateReviewReq, params UpdateReviewParams) (UpdateReviewRes, error)
 	// UpdateTicketByID implements updateTicketByID operation.
 	//
-	// 関係者と渉外のみ実行可能。.
+	// 関係者と渉外のみ実行可能。
+	// 公開範囲(visibility)をmanagersに変更できるのは本職のみ。
+	// 本職以外が伏字 (!!■■■!!)
+	// を含むタイトル・説明を送った場合、伏字は元のテキストの伏字部分で順番に置き換えられる。
+	// 伏字の数またはカテゴリが元のテキストと合わない場合
+	// (伏字をすべて消した場合を含む) は409を返す。
+	// manual_statusがfalseのチケットは、ノートやレビューの変更に応じてステータスが自動で更新される。.
 	//
 	// PATCH /tickets/{ticketId}
 	UpdateTicketByID(ctx context.Context, req OptUpdateTicketByIDReq, params UpdateTicketByIDParams) (UpdateTicketByIDRes, error)
+	// UpdateUser implements updateUser operation.
+	//
+	// Manager(本職)権限のみ。.
+	//
+	// PATCH /users/{traqId}
+	UpdateUser(ctx context.Context, req *UpdateUserReq, params UpdateUserParams) (UpdateUserRes, error)
 	// UsersGet implements GET /users operation.
 	//
 	// ユーザー一覧取得.
@@ -115,10 +325,21 @@ type Handler interface {
 	UsersGet(ctx context.Context) (UsersGetRes, error)
 	// UsersPut implements PUT /users operation.
 	//
-	// Manager(本職)権限のみ。リクエストボディの内容でユーザー情報を一括更新・同期する。.
+	// Manager(本職)権限のみ。リクエストボディの内容でユーザー情報を一括更新・同期する。
+	// リクエストボディにないユーザーは削除される。適用した変更をtraQ
+	// ID順に返す。
+	// 本職が1人も登録されていない場合 (初期設定時) は誰でも実行できる。.
 	//
 	// PUT /users
 	UsersPut(ctx context.Context, req []User) (UsersPutRes, error)
+	// WithdrawNote implements withdrawNote operation.
+	//
+	// 添削待ち・送信待ちの発信ノートを下書き(draft)に戻し、submitted_atをnullにする。
+	// チケットの担当者・副担当者とレビューを依頼したユーザーにBotから通知する。
+	// Authorと本職のみ実行可能。.
+	//
+	// POST /tickets/{ticketId}/notes/{noteId}/withdraw
+	WithdrawNote(ctx context.Context, params WithdrawNoteParams) (WithdrawNoteRes, error)
 }
 
 // Server implements http server based on OpenAPI v3 specification and
@@ -27,6 +27,7 @@ func newAIClient() *openai.Client {
 }
 
 // POST /tickets/{ticketId}/ai/generate
+// 本職・補佐・関係者のみ
 //
 //nolint:revive
 func (h *Handler) TicketsTicketIdAiGeneratePost(ctx context.Context, req *api.TicketsTicketIdAiGeneratePostReq, params api.TicketsTicketIdAiGeneratePostParams) (api.TicketsTicketIdAiGeneratePostRes, error) {
@@ -40,9 +41,9 @@ func (h *Handler) TicketsTicketIdAiGeneratePost(ctx context.Context, req *api.Ti
 
 		return nil, fmt.Errorf("get ticket: %w", err)
 	}
-	role, err := h.repo.GetUserRoleByTraqID(ctx, userID)
+	viewer, err := h.getCensorViewer(ctx, userID, getUserRole(ctx), ticket)
 	if err != nil {
-		return nil, fmt.Errorf("get user role: %w", err)
+		return nil, err
 	}
 	notes, err := h.repo.GetNotes(ctx, params.TicketId)
 	if err != nil {
@@ -52,16 +53,16 @@ func (h *Handler) TicketsTicketIdAiGeneratePost(ctx context.Context, req *api.Ti
 	systemPrompt := `
 あなたはtraPの渉外担当をサポートするAIアシスタントです。
 ユーザーから提供される「案件情報」と「これまでの経緯」を元に、次に送るべき返信メールのドラフトを作成してください。
-なお、情報の一部は「!!■■■!!」のように伏せ字になっています。伏せ字の部分は具体的な内容が不明なものとして扱い、文脈に合わせて自然な文章を作成してください。
+なお、情報の一部は「!!■■■!!」や「!!price:■■■!!」のように伏せ字になっています。「price」のような伏せ字の前の語は伏せられた情報の種類です。伏せ字の部分は具体的な内容が不明なものとして扱い、文脈に合わせて自然な文章を作成してください。
 `
 
-	safeTitle := ApplyCensorIfNeed(role, ticket.Title)
-	safeDescription := ApplyCensorIfNeed(role, ticket.Description.String)
+	safeTitle := ApplyCensorIfNeed(ctx, viewer, ticketViewTarget(ticket.ID), ticket.Title)
+	safeDescription := ApplyCensorIfNeed(ctx, viewer, ticketViewTarget(ticket.ID), ticket.Description.String)
 
 	contextText := fmt.Sprintf("【案件名】: %s\n【詳細】: %s\n\n【これまでの経緯】:\n", safeTitle, safeDescription)
 	for _, n := range notes {
 		if n.Status == "sent" {
-			safeContent := ApplyCensorIfNeed(role, n.Content)
+			safeContent := ApplyCensorIfNeed(ctx, viewer, noteViewTarget(ticket.ID, n.ID), n.Content)
 			contextText += fmt.Sprintf("- %s (%s): %s\n", n.UserID, n.Type, safeContent)
 		}
 	}
@@ -125,6 +126,7 @@ func (h *Handler) TicketsTicketIdAiGeneratePost(ctx context.Context, req *api.Ti
 }
 
 // POST /tickets/{ticketId}/notes/{noteId}/ai/review
+// 本職・補佐・関係者のみ
 //
 //nolint:revive
 func (h *Handler) TicketsTicketIdNotesNoteIdAiReviewPost(ctx context.Context, params api.TicketsTicketIdNotesNoteIdAiReviewPostParams) (api.TicketsTicketIdNotesNoteIdAiReviewPostRes, error) {
@@ -0,0 +1,108 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"sync"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/traP-jp/anshin-techo-backend/internal/api"
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+)
+
+const uncensoredViewsKey contextKey = "uncensoredViews"
+
+// uncensoredViewRecorder : リクエスト中に伏字を伏せずに返したテキストの場所を集める
+type uncensoredViewRecorder struct {
+	mu      sync.Mutex
+	seen    map[repository.UncensoredViewTarget]struct{}
+	targets []repository.UncensoredViewTarget
+}
+
+func (r *uncensoredViewRecorder) add(target repository.UncensoredViewTarget) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.seen[target]; ok {
+		return
+	}
+	r.seen[target] = struct{}{}
+	r.targets = append(r.targets, target)
+}
+
+func ticketViewTarget(ticketID int64) repository.UncensoredViewTarget {
+	return repository.UncensoredViewTarget{
+		TicketID: ticketID,
+		NoteID:   sql.NullInt64{Int64: 0, Valid: false},
+	}
+}
+
+func noteViewTarget(ticketID, noteID int64) repository.UncensoredViewTarget {
+	return repository.UncensoredViewTarget{
+		TicketID: ticketID,
+		NoteID:   sql.NullInt64{Int64: noteID, Valid: true},
+	}
+}
+
+// recordUncensoredView : 伏字を伏せずに返したテキストの場所を記録する (リクエストの終了時に保存する)
+func recordUncensoredView(ctx context.Context, target repository.UncensoredViewTarget) {
+	if r, ok := ctx.Value(uncensoredViewsKey).(*uncensoredViewRecorder); ok {
+		r.add(target)
+	}
+}
+
+// AuditMiddleware : リクエスト中に伏字を伏せずに返したテキストがあれば、閲覧したユーザー・場所・API を記録する
+// 記録に失敗した場合はレスポンスを返さない
+func (h *Handler) AuditMiddleware(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+	recorder := &uncensoredViewRecorder{
+		mu:      sync.Mutex{},
+		seen:    map[repository.UncensoredViewTarget]struct{}{},
+		targets: []repository.UncensoredViewTarget{},
+	}
+	req.SetContext(context.WithValue(req.Context, uncensoredViewsKey, recorder))
+
+	res, err := next(req)
+	if err != nil {
+		return res, err
+	}
+
+	endpoint := req.Raw.Method + " " + req.Raw.URL.Path
+	if err := h.repo.RecordUncensoredViews(req.Context, getUserID(req.Context), endpoint, recorder.targets); err != nil {
+		return middleware.Response{Type: nil}, fmt.Errorf("record uncensored views: %w", err)
+	}
+
+	return res, nil
+}
+
+// GET /audit/uncensored-views
+// 本職のみ
+func (h *Handler) GetUncensoredViews(ctx context.Context, params api.GetUncensoredViewsParams) (api.GetUncensoredViewsRes, error) {
+	views, err := h.repo.GetUncensoredViews(ctx, repository.GetUncensoredViewsParams{
+		Actor: params.Actor.Or(""),
+		Since: sql.NullTime{Time: params.Since.Value, Valid: params.Since.Set},
+		Until: sql.NullTime{Time: params.Until.Value, Valid: params.Until.Set},
+		Limit: params.Limit.Or(repository.DefaultUncensoredViewsLimit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get uncensored views from repository: %w", err)
+	}
+
+	res := make(api.GetUncensoredViewsOKApplicationJSON, 0, len(views))
+	for _, view := range views {
+		//nolint:exhaustruct
+		apiView := api.UncensoredView{
+			ID:        view.ID,
+			Actor:     view.Actor,
+			TicketID:  view.TicketID,
+			Endpoint:  view.Endpoint,
+			CreatedAt: view.CreatedAt,
+		}
+		if view.NoteID.Valid {
+			apiView.NoteID = api.NewOptInt64(view.NoteID.Int64)
+		}
+		res = append(res, apiView)
+	}
+
+	return &res, nil
+}
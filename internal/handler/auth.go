@@ -2,16 +2,34 @@ package handler
 
 import (
 	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
+	"github.com/ogen-go/ogen/ogenerrors"
 	"github.com/traP-jp/anshin-techo-backend/internal/api"
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/auth"
 )
 
 type contextKey string
 
-const userKey contextKey = "user"
+const (
+	userKey         contextKey = "user"
+	sessionTokenKey contextKey = "sessionToken"
+)
+
+// oauthStateTTL : ログイン開始からコールバックまでの猶予
+const oauthStateTTL = 10 * time.Minute
+
+// ErrLoginDisabled : traQ OAuth2 が設定されておらず、ログインできない
+var ErrLoginDisabled = fmt.Errorf("traQ OAuth login is not configured")
 
+// HandleTraQAuth : 信頼できるプロキシが付与した X-Forwarded-User ヘッダーで認証する
+// 信頼できないリクエストのヘッダーは auth.TrustedProxy で取り除かれる
 func (h *Handler) HandleTraQAuth(ctx context.Context, _ string, t api.TraQAuth) (context.Context, error) {
 	if t.APIKey == "" {
 		return nil, echo.NewHTTPError(http.StatusUnauthorized, "unauthorized: missing user header")
@@ -20,6 +38,121 @@ func (h *Handler) HandleTraQAuth(ctx context.Context, _ string, t api.TraQAuth)
 	return context.WithValue(ctx, userKey, t.APIKey), nil
 }
 
+// HandleSessionAuth : traQ OAuth2 でログインした際のセッションで認証する
+// セッションが無効な場合は他の認証方法を試す
+func (h *Handler) HandleSessionAuth(ctx context.Context, _ string, t api.SessionAuth) (context.Context, error) {
+	traqID, err := h.repo.GetSessionUser(ctx, auth.HashToken(t.APIKey))
+	if err != nil {
+		if errors.Is(err, repository.ErrSessionNotFound) {
+			return nil, ogenerrors.ErrSkipServerSecurity
+		}
+
+		return nil, fmt.Errorf("get session from repository: %w", err)
+	}
+
+	ctx = context.WithValue(ctx, sessionTokenKey, t.APIKey)
+
+	return context.WithValue(ctx, userKey, traqID), nil
+}
+
+// GET /auth/login
+func (h *Handler) Login(ctx context.Context) (api.LoginRes, error) {
+	if h.auth.Provider == nil {
+		return nil, ErrLoginDisabled
+	}
+
+	state, err := auth.NewToken()
+	if err != nil {
+		return nil, err
+	}
+	if err := h.repo.CreateOAuthState(ctx, auth.HashToken(state), oauthStateTTL); err != nil {
+		return nil, fmt.Errorf("create oauth state in repository: %w", err)
+	}
+
+	return &api.LoginFound{
+		Location:  api.NewOptString(h.auth.Provider.AuthCodeURL(state)),
+		SetCookie: api.NewOptString(h.stateCookie(state).String()),
+	}, nil
+}
+
+// GET /auth/callback
+// state がログインを開始したブラウザの Cookie と一致しない場合は拒否する (ログイン CSRF 対策)
+func (h *Handler) AuthCallback(ctx context.Context, params api.AuthCallbackParams) (api.AuthCallbackRes, error) {
+	if h.auth.Provider == nil {
+		return nil, ErrLoginDisabled
+	}
+
+	cookieState, ok := params.AnshinOAuthState.Get()
+	if !ok || subtle.ConstantTimeCompare([]byte(cookieState), []byte(params.State)) != 1 {
+		return &api.AuthCallbackBadRequest{}, nil
+	}
+
+	if err := h.repo.ConsumeOAuthState(ctx, auth.HashToken(params.State)); err != nil {
+		if errors.Is(err, repository.ErrStateNotFound) {
+			return &api.AuthCallbackBadRequest{}, nil
+		}
+
+		return nil, fmt.Errorf("consume oauth state in repository: %w", err)
+	}
+
+	traqID, err := h.auth.Provider.Exchange(ctx, params.Code)
+	if err != nil {
+		return &api.AuthCallbackUnauthorized{}, nil
+	}
+
+	token, err := auth.NewToken()
+	if err != nil {
+		return nil, err
+	}
+	if err := h.repo.CreateSession(ctx, auth.HashToken(token), traqID, h.auth.SessionTTL); err != nil {
+		return nil, fmt.Errorf("create session in repository: %w", err)
+	}
+
+	return &api.AuthCallbackFound{
+		Location:  api.NewOptString(h.auth.LoginRedirectURL),
+		SetCookie: api.NewOptString(h.sessionCookie(token, int(h.auth.SessionTTL.Seconds())).String()),
+	}, nil
+}
+
+// POST /auth/logout
+func (h *Handler) Logout(ctx context.Context) (api.LogoutRes, error) {
+	if token, ok := ctx.Value(sessionTokenKey).(string); ok {
+		if err := h.repo.DeleteSession(ctx, auth.HashToken(token)); err != nil {
+			return nil, fmt.Errorf("delete session in repository: %w", err)
+		}
+	}
+
+	return &api.LogoutNoContent{SetCookie: api.NewOptString(h.sessionCookie("", -1).String())}, nil
+}
+
+// sessionCookie : セッションの Cookie (maxAge が負の場合は削除する)
+func (h *Handler) sessionCookie(token string, maxAge int) *http.Cookie {
+	//nolint:exhaustruct
+	return &http.Cookie{
+		Name:     auth.SessionCookieName,
+		Value:    token,
+		Path:     "/",
+		MaxAge:   maxAge,
+		HttpOnly: true,
+		Secure:   h.auth.CookieSecure,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
+// stateCookie : ログインを開始したブラウザに state を結び付ける Cookie
+func (h *Handler) stateCookie(state string) *http.Cookie {
+	//nolint:exhaustruct
+	return &http.Cookie{
+		Name:     auth.StateCookieName,
+		Value:    state,
+		Path:     "/",
+		MaxAge:   int(oauthStateTTL.Seconds()),
+		HttpOnly: true,
+		Secure:   h.auth.CookieSecure,
+		SameSite: http.SameSiteLaxMode,
+	}
+}
+
 // getUserID : ユーザーIDをコンテキストから取得
 func getUserID(ctx context.Context) string {
 	if v, ok := ctx.Value(userKey).(string); ok {
@@ -0,0 +1,304 @@
+package handler
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ogen-go/ogen/middleware"
+	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/traP-jp/anshin-techo-backend/internal/api"
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/authz"
+)
+
+const roleKey contextKey = "role"
+
+var (
+	// ErrForbidden : 403 のレスポンスが定義されていない操作を実行する権限がない
+	ErrForbidden = fmt.Errorf("forbidden")
+	// ErrNotFound : 404 のレスポンスが定義されていない操作の対象が公開範囲外
+	ErrNotFound = fmt.Errorf("not found")
+)
+
+// operationActions : 操作ごとの権限 (ここにない操作は誰も実行できない)
+var operationActions = map[string]authz.Action{
+	api.LoginOperation:        authz.ActionPublic,
+	api.AuthCallbackOperation: authz.ActionPublic,
+
+	api.MeGetOperation:         authz.ActionSelf,
+	api.LogoutOperation:        authz.ActionSelf,
+	api.GetMyTokensOperation:   authz.ActionSelf,
+	api.CreateMyTokenOperation: authz.ActionSelf,
+	api.DeleteMyTokenOperation: authz.ActionSelf,
+
+	api.GetTicketsOperation:           authz.ActionView,
+	api.GetTicketByIDOperation:        authz.ActionView,
+	api.GetTicketHistoryOperation:     authz.ActionView,
+	api.GetTicketTransitionsOperation: authz.ActionView,
+	api.SearchOperation:               authz.ActionView,
+	api.UsersGetOperation:             authz.ActionView,
+	api.GetUserOperation:              authz.ActionView,
+	api.GetReviewDiffOperation:        authz.ActionView,
+	api.GetNoteRevisionsOperation:     authz.ActionView,
+	api.GetNoteRevisionDiffOperation:  authz.ActionView,
+
+	api.CreateTicketOperation: authz.ActionCreateTicket,
+
+	api.UpdateTicketByIDOperation:                       authz.ActionEditTicket,
+	api.TicketsTicketIdNotesPostOperation:               authz.ActionEditTicket,
+	api.TicketsTicketIdAiGeneratePostOperation:          authz.ActionEditTicket,
+	api.TicketsTicketIdNotesNoteIdAiReviewPostOperation: authz.ActionEditTicket,
+
+	api.TicketsTicketIdNotesNoteIdPutOperation:    authz.ActionEditNote,
+	api.TicketsTicketIdNotesNoteIdDeleteOperation: authz.ActionEditNote,
+	api.RequestReviewsOperation:                   authz.ActionEditNote,
+	api.SubmitNoteOperation:                       authz.ActionEditNote,
+	api.WithdrawNoteOperation:                     authz.ActionEditNote,
+	api.MarkNoteSentOperation:                     authz.ActionEditNote,
+	api.CancelNoteOperation:                       authz.ActionEditNote,
+
+	api.CreateReviewOperation: authz.ActionReview,
+	api.UpdateReviewOperation: authz.ActionReview,
+	api.DeleteReviewOperation: authz.ActionReview,
+
+	api.DeleteTicketByIDOperation: authz.ActionDeleteTicket,
+
+	api.GetTrashedTicketsOperation: authz.ActionManageTrash,
+	api.RestoreTicketOperation:     authz.ActionManageTrash,
+	api.PurgeTicketOperation:       authz.ActionManageTrash,
+
+	api.CreateUserOperation: authz.ActionManageUsers,
+	api.UsersPutOperation:   authz.ActionManageUsers,
+	api.SyncUsersOperation:  authz.ActionManageUsers,
+	api.UpdateUserOperation: authz.ActionManageUsers,
+	api.DeleteUserOperation: authz.ActionManageUsers,
+
+	api.ConfigGetOperation:          authz.ActionManageConfig,
+	api.ConfigPostOperation:         authz.ActionManageConfig,
+	api.GetCensorPolicyOperation:    authz.ActionManageConfig,
+	api.UpdateCensorPolicyOperation: authz.ActionManageConfig,
+	api.ScanPIIOperation:            authz.ActionManageConfig,
+	api.GetUncensoredViewsOperation: authz.ActionManageConfig,
+	api.GetRoleChangesOperation:     authz.ActionManageConfig,
+}
+
+// forbiddenResponses : 権限がない場合に返すレスポンス (ここにない操作は ErrForbidden を返す)
+var forbiddenResponses = map[string]any{
+	api.CreateTicketOperation:                           &api.CreateTicketForbidden{},
+	api.UpdateTicketByIDOperation:                       &api.UpdateTicketByIDForbidden{},
+	api.TicketsTicketIdNotesPostOperation:               &api.TicketsTicketIdNotesPostForbidden{},
+	api.TicketsTicketIdAiGeneratePostOperation:          &api.TicketsTicketIdAiGeneratePostForbidden{},
+	api.TicketsTicketIdNotesNoteIdAiReviewPostOperation: &api.TicketsTicketIdNotesNoteIdAiReviewPostForbidden{},
+	api.TicketsTicketIdNotesNoteIdPutOperation:          &api.TicketsTicketIdNotesNoteIdPutForbidden{},
+	api.TicketsTicketIdNotesNoteIdDeleteOperation:       &api.TicketsTicketIdNotesNoteIdDeleteForbidden{},
+	api.CreateReviewOperation:                           &api.CreateReviewForbidden{},
+	api.UpdateReviewOperation:                           &api.UpdateReviewForbidden{},
+	api.RequestReviewsOperation:                         &api.RequestReviewsForbidden{},
+	api.SubmitNoteOperation:                             &api.SubmitNoteForbidden{},
+	api.WithdrawNoteOperation:                           &api.WithdrawNoteForbidden{},
+	api.MarkNoteSentOperation:                           &api.MarkNoteSentForbidden{},
+	api.CancelNoteOperation:                             &api.CancelNoteForbidden{},
+	api.DeleteReviewOperation:                           &api.DeleteReviewForbidden{},
+	api.DeleteTicketByIDOperation:                       &api.DeleteTicketByIDForbidden{},
+	api.GetTrashedTicketsOperation:                      &api.GetTrashedTicketsForbidden{},
+	api.RestoreTicketOperation:                          &api.RestoreTicketForbidden{},
+	api.PurgeTicketOperation:                            &api.PurgeTicketForbidden{},
+	api.CreateUserOperation:                             &api.CreateUserForbidden{},
+	api.UsersPutOperation:                               &api.UsersPutForbidden{},
+	api.SyncUsersOperation:                              &api.SyncUsersForbidden{},
+	api.UpdateUserOperation:                             &api.UpdateUserForbidden{},
+	api.DeleteUserOperation:                             &api.DeleteUserForbidden{},
+	api.ConfigGetOperation:                              &api.ConfigGetForbidden{},
+	api.ConfigPostOperation:                             &api.ConfigPostForbidden{},
+	api.GetCensorPolicyOperation:                        &api.GetCensorPolicyForbidden{},
+	api.UpdateCensorPolicyOperation:                     &api.UpdateCensorPolicyForbidden{},
+	api.ScanPIIOperation:                                &api.ScanPIIForbidden{},
+	api.GetUncensoredViewsOperation:                     &api.GetUncensoredViewsForbidden{},
+	api.GetRoleChangesOperation:                         &api.GetRoleChangesForbidden{},
+}
+
+// notFoundResponses : 操作対象のチケットが公開範囲外の場合に返すレスポンス (ここにない操作は ErrNotFound を返す)
+var notFoundResponses = map[string]any{
+	api.GetTicketByIDOperation:                          &api.GetTicketByIDNotFound{},
+	api.UpdateTicketByIDOperation:                       &api.UpdateTicketByIDNotFound{},
+	api.DeleteTicketByIDOperation:                       &api.DeleteTicketByIDNotFound{},
+	api.GetTicketHistoryOperation:                       &api.GetTicketHistoryNotFound{},
+	api.GetTicketTransitionsOperation:                   &api.GetTicketTransitionsNotFound{},
+	api.RestoreTicketOperation:                          &api.RestoreTicketNotFound{},
+	api.PurgeTicketOperation:                            &api.PurgeTicketNotFound{},
+	api.TicketsTicketIdNotesPostOperation:               &api.TicketsTicketIdNotesPostNotFound{},
+	api.TicketsTicketIdNotesNoteIdPutOperation:          &api.TicketsTicketIdNotesNoteIdPutNotFound{},
+	api.TicketsTicketIdNotesNoteIdDeleteOperation:       &api.TicketsTicketIdNotesNoteIdDeleteNotFound{},
+	api.RequestReviewsOperation:                         &api.RequestReviewsNotFound{},
+	api.SubmitNoteOperation:                             &api.SubmitNoteNotFound{},
+	api.WithdrawNoteOperation:                           &api.WithdrawNoteNotFound{},
+	api.MarkNoteSentOperation:                           &api.MarkNoteSentNotFound{},
+	api.CancelNoteOperation:                             &api.CancelNoteNotFound{},
+	api.CreateReviewOperation:                           &api.CreateReviewNotFound{},
+	api.UpdateReviewOperation:                           &api.UpdateReviewNotFound{},
+	api.DeleteReviewOperation:                           &api.DeleteReviewNotFound{},
+	api.GetReviewDiffOperation:                          &api.GetReviewDiffNotFound{},
+	api.GetNoteRevisionsOperation:                       &api.GetNoteRevisionsNotFound{},
+	api.GetNoteRevisionDiffOperation:                    &api.GetNoteRevisionDiffNotFound{},
+	api.TicketsTicketIdAiGeneratePostOperation:          &api.TicketsTicketIdAiGeneratePostNotFound{},
+	api.TicketsTicketIdNotesNoteIdAiReviewPostOperation: &api.TicketsTicketIdNotesNoteIdAiReviewPostNotFound{},
+}
+
+// AuthorizationMiddleware : ユーザーのロールを取得してコンテキストに保存し、操作を実行できるか確認する
+// 操作対象のチケット・ノートが見つからない場合はロールだけで確認してハンドラーに任せ、公開範囲外のチケットは見つからないものとして扱う
+func (h *Handler) AuthorizationMiddleware(req middleware.Request, next middleware.Next) (middleware.Response, error) {
+	ctx := req.Context
+	userID := getUserID(ctx)
+
+	role := ""
+	if userID != "" {
+		var err error
+		role, err = h.repo.GetUserRoleByTraqID(ctx, userID)
+		if err != nil {
+			return middleware.Response{Type: nil}, fmt.Errorf("get user role from repository: %w", err)
+		}
+	}
+	req.SetContext(context.WithValue(ctx, roleKey, role))
+
+	action, ok := operationActions[req.OperationName]
+	if !ok {
+		return forbidden(req.OperationName)
+	}
+
+	var relations authz.Relation
+	if role != authz.RoleManager || authz.NeedsRelations(role, action) {
+		var visibility string
+		var found bool
+		var err error
+		relations, visibility, found, err = h.requestRelations(req, userID)
+		if err != nil {
+			return middleware.Response{Type: nil}, err
+		}
+		if !found {
+			// ゴミ箱のチケットなどは見つからないので、関係によらない操作はロールだけで確認する
+			if !authz.NeedsRelations(role, action) && !authz.Allowed(role, 0, action) {
+				return forbidden(req.OperationName)
+			}
+
+			return next(req)
+		}
+		if !authz.TicketVisible(role, relations, visibility) {
+			return notFound(req.OperationName)
+		}
+	}
+
+	if action == authz.ActionManageUsers && role != authz.RoleManager {
+		// 初期設定時は本職がいないので、誰でもユーザーを登録できる
+		hasManager, err := h.repo.HasManager(ctx)
+		if err != nil {
+			return middleware.Response{Type: nil}, fmt.Errorf("check manager existence in repository: %w", err)
+		}
+		if !hasManager {
+			return next(req)
+		}
+	}
+
+	if !authz.Allowed(role, relations, action) {
+		return forbidden(req.OperationName)
+	}
+
+	return next(req)
+}
+
+// requestRelations : リクエストのパスのチケット・ノートとユーザーの関係、チケットの公開範囲
+// パスにチケットがない場合の公開範囲は public になり、チケット・ノートが見つからない場合は found が false になる
+func (h *Handler) requestRelations(req middleware.Request, userID string) (relations authz.Relation, visibility string, found bool, err error) {
+	ticketID, ok := req.Params.Path("ticketId")
+	if !ok {
+		return 0, authz.VisibilityPublic, true, nil
+	}
+
+	ticket, err := h.repo.GetTicketByID(req.Context, ticketID.(int64))
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return 0, "", false, nil
+		}
+
+		return 0, "", false, fmt.Errorf("get ticket from repository: %w", err)
+	}
+	relations = ticketRelations(userID, ticket)
+
+	noteID, ok := req.Params.Path("noteId")
+	if !ok {
+		return relations, ticket.Visibility, true, nil
+	}
+
+	note, err := h.repo.GetNoteByID(req.Context, ticket.ID, noteID.(int64))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, "", false, nil
+		}
+
+		return 0, "", false, fmt.Errorf("get note from repository: %w", err)
+	}
+	if note.UserID == userID {
+		relations |= authz.RelationAuthor
+	}
+
+	return relations, ticket.Visibility, true, nil
+}
+
+// ErrorHandler : 権限・APIトークンのスコープが足りない場合は 403、公開範囲外の場合は 404 を返し、それ以外は ogen のデフォルトに任せる
+func ErrorHandler(ctx context.Context, w http.ResponseWriter, r *http.Request, err error) {
+	status := http.StatusForbidden
+	var message string
+	switch {
+	case errors.Is(err, ErrForbidden):
+		message = ErrForbidden.Error()
+	case errors.Is(err, ErrInsufficientScope):
+		message = ErrInsufficientScope.Error()
+	case errors.Is(err, ErrNotFound):
+		status = http.StatusNotFound
+		message = ErrNotFound.Error()
+	case errors.Is(err, ErrLoginDisabled):
+		status = http.StatusNotFound
+		message = ErrLoginDisabled.Error()
+	default:
+		ogenerrors.DefaultErrorHandler(ctx, w, r, err)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error_message": message})
+}
+
+func forbidden(operationName string) (middleware.Response, error) {
+	if res, ok := forbiddenResponses[operationName]; ok {
+		return middleware.Response{Type: res}, nil
+	}
+
+	return middleware.Response{Type: nil}, ErrForbidden
+}
+
+func notFound(operationName string) (middleware.Response, error) {
+	if res, ok := notFoundResponses[operationName]; ok {
+		return middleware.Response{Type: res}, nil
+	}
+
+	return middleware.Response{Type: nil}, ErrNotFound
+}
+
+// ticketRelations : チケットとユーザーの関係
+func ticketRelations(userID string, ticket *repository.Ticket) authz.Relation {
+	return authz.TicketRelations(userID, ticket.Assignee, ticket.SubAssignees, ticket.Stakeholders)
+}
+
+// getUserRole : AuthorizationMiddleware が取得したユーザーのロールをコンテキストから取得
+func getUserRole(ctx context.Context) string {
+	if v, ok := ctx.Value(roleKey).(string); ok {
+		return v
+	}
+
+	return ""
+}
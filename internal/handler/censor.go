@@ -1,23 +1,92 @@
 package handler
 
 import (
-	"regexp"
-)
+	"context"
+	"errors"
+	"fmt"
+	"slices"
 
-var censorRegex = regexp.MustCompile(`!!(.*?)!!`)
+	"github.com/traP-jp/anshin-techo-backend/internal/api"
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/authz"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/censor"
+)
 
 // CensorReplacement: 伏せ字の置換後フォーマット
-const CensorReplacement = "!!■■■!!"
+const CensorReplacement = censor.Replacement
 
 // CensorContent : 文字列内の !!text!! を !!■■■!! に置換
 func CensorContent(input string) string {
-	return censorRegex.ReplaceAllString(input, CensorReplacement)
+	return censor.Content(input)
+}
+
+// ApplyCensorIfNeed : 閲覧できないカテゴリの伏字を置換する
+// 伏字を伏せずに返す場合は、監査のためにテキストの場所を記録する
+func ApplyCensorIfNeed(ctx context.Context, viewer censor.Viewer, target repository.UncensoredViewTarget, input string) string {
+	if viewer.Reveals(input) {
+		recordUncensoredView(ctx, target)
+	}
+
+	return viewer.Apply(input)
+}
+
+// loadCensorPolicy : 伏字の閲覧ルールを取得する (本職はすべて閲覧できるので取得しない)
+func (h *Handler) loadCensorPolicy(ctx context.Context, role string) (censor.Policy, error) {
+	if role == authz.RoleManager {
+		return censor.Policy{}, nil
+	}
+
+	policy, err := h.repo.GetCensorPolicy(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get censor policy from repository: %w", err)
+	}
+
+	return policy, nil
+}
+
+// censorViewer : チケット内のテキストを閲覧するユーザー
+func censorViewer(policy censor.Policy, userID, role string, ticket *repository.Ticket) censor.Viewer {
+	return censor.Viewer{
+		Role:     role,
+		Assignee: ticket != nil && (ticket.Assignee == userID || slices.Contains(ticket.SubAssignees, userID)),
+		Policy:   policy,
+	}
 }
 
-func ApplyCensorIfNeed(role string, input string) string {
-	if role == "manager" {
-		return input
+// getCensorViewer : チケット内のテキストを閲覧するユーザーを伏字の閲覧ルールとともに返す
+func (h *Handler) getCensorViewer(ctx context.Context, userID, role string, ticket *repository.Ticket) (censor.Viewer, error) {
+	policy, err := h.loadCensorPolicy(ctx, role)
+	if err != nil {
+		return censor.Viewer{}, err
+	}
+
+	return censorViewer(policy, userID, role, ticket), nil
+}
+
+// restoreCensoredEdit : 伏字のまま編集したテキストの伏字を元のテキストの伏字部分に戻す
+// 伏字の数・カテゴリが合わない場合は 409 で返す内容を返す
+func restoreCensoredEdit(viewer censor.Viewer, field, original, edited string) (string, *api.CensorConflict) {
+	if viewer.Role == authz.RoleManager {
+		return edited, nil
+	}
+
+	restored, err := viewer.Restore(original, edited)
+	if ambiguous := (*censor.AmbiguousEditError)(nil); errors.As(err, &ambiguous) {
+		message := "the number of censored placeholders does not match the original text"
+		if ambiguous.CategoryMismatch {
+			message = "the categories of censored placeholders do not match the original text"
+		}
+		if ambiguous.DelimiterMismatch {
+			message = "the text around censored placeholders changes where they start or end"
+		}
+
+		return "", &api.CensorConflict{
+			Message:              message,
+			Field:                field,
+			ExpectedPlaceholders: ambiguous.Expected,
+			ActualPlaceholders:   ambiguous.Actual,
+		}
 	}
 
-	return CensorContent(input)
+	return restored, nil
 }
@@ -9,20 +9,9 @@ import (
 	"github.com/traP-jp/anshin-techo-backend/internal/repository"
 )
 
+// GET /config
+// 本職のみ
 func (h *Handler) ConfigGet(ctx context.Context) (api.ConfigGetRes, error) {
-	userID := getUserID(ctx)
-	role, err := h.repo.GetUserRoleByTraqID(ctx, userID)
-	if err != nil {
-		if errors.Is(err, repository.ErrUserNotFound) {
-			return &api.ConfigGetForbidden{}, nil
-		}
-
-		return nil, fmt.Errorf("get user role from repository: %w", err)
-	}
-	if role != "manager" {
-		return &api.ConfigGetForbidden{}, nil
-	}
-
 	cfg, err := h.repo.GetConfig(ctx)
 	if err != nil {
 		if errors.Is(err, repository.ErrConfigNotFound) {
@@ -35,21 +24,33 @@ func (h *Handler) ConfigGet(ctx context.Context) (api.ConfigGetRes, error) {
 	return toAPIConfig(cfg), nil
 }
 
+// POST /config
+// 本職のみ
 func (h *Handler) ConfigPost(ctx context.Context, req *api.Config) (api.ConfigPostRes, error) {
-	userID := getUserID(ctx)
-	role, err := h.repo.GetUserRoleByTraqID(ctx, userID)
-	if err != nil {
-		if errors.Is(err, repository.ErrUserNotFound) {
-			return &api.ConfigPostForbidden{}, nil
-		}
-
-		return nil, fmt.Errorf("get user role from repository: %w", err)
-	}
-	if role != "manager" {
-		return &api.ConfigPostForbidden{}, nil
+	currentCfg, err := h.repo.GetConfig(ctx)
+	if err != nil && !errors.Is(err, repository.ErrConfigNotFound) {
+		return nil, fmt.Errorf("get config from repository: %w", err)
 	}
 
 	repoCfg := toRepositoryConfig(req)
+	if !req.DuePolicy.Set && currentCfg != nil {
+		repoCfg.DuePolicy = currentCfg.DuePolicy
+	}
+	if !req.ApprovalPolicy.Set {
+		repoCfg.ApprovalPolicy = repository.DefaultApprovalPolicy()
+		if currentCfg != nil {
+			repoCfg.ApprovalPolicy = currentCfg.ApprovalPolicy
+		}
+	}
+	if err := repoCfg.ApprovalPolicy.Validate(); err != nil {
+		return &api.ConfigPostBadRequest{}, nil
+	}
+	if !req.TrashRetentionDays.Set && currentCfg != nil {
+		repoCfg.TrashRetentionDays = currentCfg.TrashRetentionDays
+	}
+	if !req.PiiAutoCensor.Set && currentCfg != nil {
+		repoCfg.PIIAutoCensor = currentCfg.PIIAutoCensor
+	}
 	if err := h.repo.UpsertConfig(ctx, repoCfg); err != nil {
 		return nil, fmt.Errorf("upsert config in repository: %w", err)
 	}
@@ -68,12 +69,55 @@ func toAPIConfig(cfg *repository.Config) *api.Config {
 		overdueDay = []int{}
 	}
 
+	tagBusinessDays := make([]api.DuePolicyTagBusinessDaysItem, 0, len(cfg.DuePolicy.TagBusinessDays))
+	for _, rule := range cfg.DuePolicy.TagBusinessDays {
+		tagBusinessDays = append(tagBusinessDays, api.DuePolicyTagBusinessDaysItem{
+			Tag:          rule.Tag,
+			BusinessDays: rule.BusinessDays,
+		})
+	}
+
 	return &api.Config{
 		ReminderInterval: api.ConfigReminderInterval{
 			OverdueDay:   overdueDay,
 			NotesentHour: cfg.ReminderInterval.NotesentHour,
 		},
 		RevisePrompt: cfg.RevisePrompt,
+		DuePolicy: api.NewOptDuePolicy(api.DuePolicy{
+			DefaultBusinessDays: cfg.DuePolicy.DefaultBusinessDays,
+			TagBusinessDays:     tagBusinessDays,
+		}),
+		ApprovalPolicy:     api.NewOptApprovalPolicy(toAPIApprovalPolicy(cfg.ApprovalPolicy)),
+		TrashRetentionDays: api.NewOptInt(cfg.TrashRetentionDays),
+		PiiAutoCensor:      api.NewOptBool(cfg.PIIAutoCensor),
+	}
+}
+
+func toAPIApprovalPolicy(policy repository.ApprovalPolicy) api.ApprovalPolicy {
+	tagRules := make([]api.ApprovalPolicyTagRulesItem, 0, len(policy.TagRules))
+	for _, rule := range policy.TagRules {
+		tagRules = append(tagRules, api.ApprovalPolicyTagRulesItem{
+			Tag:  rule.Tag,
+			Rule: toAPIApprovalRule(rule.Rule),
+		})
+	}
+
+	return api.ApprovalPolicy{
+		Default:  toAPIApprovalRule(policy.Default),
+		TagRules: tagRules,
+	}
+}
+
+func toAPIApprovalRule(rule repository.ApprovalRule) api.ApprovalRule {
+	return api.ApprovalRule{
+		Threshold: rule.Threshold,
+		MaxWeights: api.ApprovalRuleMaxWeights{
+			Manager:   rule.MaxWeights.Manager,
+			Assistant: rule.MaxWeights.Assistant,
+			Member:    rule.MaxWeights.Member,
+		},
+		RequireManagerApproval: rule.RequireManagerApproval,
+		BlockOnChangeRequests:  rule.BlockOnChangeRequests,
 	}
 }
 
@@ -83,11 +127,117 @@ func toRepositoryConfig(cfg *api.Config) repository.Config {
 		overdueDay = []int{}
 	}
 
+	tagBusinessDays := make([]repository.DuePolicyTagRule, 0, len(cfg.DuePolicy.Value.TagBusinessDays))
+	for _, rule := range cfg.DuePolicy.Value.TagBusinessDays {
+		tagBusinessDays = append(tagBusinessDays, repository.DuePolicyTagRule{
+			Tag:          rule.Tag,
+			BusinessDays: rule.BusinessDays,
+		})
+	}
+
 	return repository.Config{
 		ReminderInterval: repository.ConfigReminderInterval{
 			OverdueDay:   overdueDay,
 			NotesentHour: cfg.ReminderInterval.NotesentHour,
 		},
 		RevisePrompt: cfg.RevisePrompt,
+		DuePolicy: repository.DuePolicy{
+			DefaultBusinessDays: cfg.DuePolicy.Value.DefaultBusinessDays,
+			TagBusinessDays:     tagBusinessDays,
+		},
+		ApprovalPolicy:     toRepositoryApprovalPolicy(cfg.ApprovalPolicy.Value),
+		TrashRetentionDays: cfg.TrashRetentionDays.Value,
+		PIIAutoCensor:      cfg.PiiAutoCensor.Value,
 	}
 }
+
+func toRepositoryApprovalPolicy(policy api.ApprovalPolicy) repository.ApprovalPolicy {
+	tagRules := make([]repository.ApprovalPolicyTagRule, 0, len(policy.TagRules))
+	for _, rule := range policy.TagRules {
+		tagRules = append(tagRules, repository.ApprovalPolicyTagRule{
+			Tag:  rule.Tag,
+			Rule: toRepositoryApprovalRule(rule.Rule),
+		})
+	}
+
+	return repository.ApprovalPolicy{
+		Default:  toRepositoryApprovalRule(policy.Default),
+		TagRules: tagRules,
+	}
+}
+
+func toRepositoryApprovalRule(rule api.ApprovalRule) repository.ApprovalRule {
+	return repository.ApprovalRule{
+		Threshold: rule.Threshold,
+		MaxWeights: repository.ApprovalMaxWeights{
+			Manager:   rule.MaxWeights.Manager,
+			Assistant: rule.MaxWeights.Assistant,
+			Member:    rule.MaxWeights.Member,
+		},
+		RequireManagerApproval: rule.RequireManagerApproval,
+		BlockOnChangeRequests:  rule.BlockOnChangeRequests,
+	}
+}
+
+// GET /config/censor-policy
+// 本職のみ
+func (h *Handler) GetCensorPolicy(ctx context.Context) (api.GetCensorPolicyRes, error) {
+	rules, err := h.repo.GetCensorPolicyRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get censor policy rules from repository: %w", err)
+	}
+
+	res := api.GetCensorPolicyOKApplicationJSON(toAPICensorPolicyRules(rules))
+
+	return &res, nil
+}
+
+// PUT /config/censor-policy
+// 本職のみ
+func (h *Handler) UpdateCensorPolicy(ctx context.Context, req []api.CensorPolicyRule) (api.UpdateCensorPolicyRes, error) {
+	rules := make([]*repository.CensorPolicyRule, 0, len(req))
+	for _, rule := range req {
+		roles := make([]string, 0, len(rule.Roles))
+		for _, r := range rule.Roles {
+			roles = append(roles, string(r))
+		}
+		rules = append(rules, &repository.CensorPolicyRule{
+			Category:  rule.Category,
+			Roles:     roles,
+			Assignees: rule.Assignees,
+		})
+	}
+	if err := h.repo.ReplaceCensorPolicyRules(ctx, rules); err != nil {
+		if errors.Is(err, repository.ErrInvalidCensorPolicy) {
+			return &api.UpdateCensorPolicyBadRequest{}, nil
+		}
+
+		return nil, fmt.Errorf("replace censor policy rules in repository: %w", err)
+	}
+
+	updated, err := h.repo.GetCensorPolicyRules(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get censor policy rules from repository: %w", err)
+	}
+
+	res := api.UpdateCensorPolicyOKApplicationJSON(toAPICensorPolicyRules(updated))
+
+	return &res, nil
+}
+
+func toAPICensorPolicyRules(rules []*repository.CensorPolicyRule) []api.CensorPolicyRule {
+	res := make([]api.CensorPolicyRule, 0, len(rules))
+	for _, rule := range rules {
+		roles := make([]api.CensorPolicyRuleRolesItem, 0, len(rule.Roles))
+		for _, r := range rule.Roles {
+			roles = append(roles, api.CensorPolicyRuleRolesItem(r))
+		}
+		res = append(res, api.CensorPolicyRule{
+			Category:  rule.Category,
+			Roles:     roles,
+			Assignees: rule.Assignees,
+		})
+	}
+
+	return res
+}
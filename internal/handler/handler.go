@@ -10,18 +10,34 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/traP-jp/anshin-techo-backend/internal/api"
 	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/auth"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/pii"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/userdir"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/usersync"
 )
 
 type Handler struct {
-	repo *repository.Repository
+	repo     *repository.Repository
+	pii      pii.Detector
+	auth     auth.Config
+	userSync *usersync.Syncer
+	users    *userdir.Directory
 }
 
 func New(
 	repo *repository.Repository,
+	piiDetector pii.Detector,
+	authConfig auth.Config,
+	userSyncer *usersync.Syncer,
+	users *userdir.Directory,
 ) *Handler {
 	return &Handler{
 		//photo,
-		repo: repo,
+		repo:     repo,
+		pii:      piiDetector,
+		auth:     authConfig,
+		userSync: userSyncer,
+		users:    users,
 	}
 }
 
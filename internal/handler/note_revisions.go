@@ -0,0 +1,119 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/traP-jp/anshin-techo-backend/internal/api"
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/textdiff"
+)
+
+// GET /tickets/{ticketId}/notes/{noteId}/revisions
+func (h *Handler) GetNoteRevisions(ctx context.Context, params api.GetNoteRevisionsParams) (api.GetNoteRevisionsRes, error) {
+	ticket, err := h.repo.GetTicketByID(ctx, params.TicketId)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return &api.GetNoteRevisionsNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("get ticket: %w", err)
+	}
+
+	revisions, err := h.repo.GetNoteRevisions(ctx, params.TicketId, params.NoteId)
+	if err != nil {
+		return nil, fmt.Errorf("get note revisions from repository: %w", err)
+	}
+	// 版は作成時に必ず記録されるので、版がなければノートがない
+	if len(revisions) == 0 {
+		return &api.GetNoteRevisionsNotFound{}, nil
+	}
+
+	viewer, err := h.getCensorViewer(ctx, getUserID(ctx), getUserRole(ctx), ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	target := noteViewTarget(params.TicketId, params.NoteId)
+	res := make(api.GetNoteRevisionsOKApplicationJSON, 0, len(revisions))
+	for _, revision := range revisions {
+		res = append(res, api.NoteRevision{
+			Revision:  revision.Revision,
+			Author:    revision.Author,
+			Content:   ApplyCensorIfNeed(ctx, viewer, target, revision.Content),
+			CreatedAt: revision.CreatedAt,
+		})
+	}
+
+	return &res, nil
+}
+
+// GET /tickets/{ticketId}/notes/{noteId}/revisions/{fromRevision}/diff/{toRevision}
+func (h *Handler) GetNoteRevisionDiff(ctx context.Context, params api.GetNoteRevisionDiffParams) (api.GetNoteRevisionDiffRes, error) {
+	from, err := h.repo.GetNoteRevision(ctx, params.TicketId, params.NoteId, params.FromRevision)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoteRevisionNotFound) {
+			return &api.GetNoteRevisionDiffNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("get note revision from repository: %w", err)
+	}
+	to, err := h.repo.GetNoteRevision(ctx, params.TicketId, params.NoteId, params.ToRevision)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoteRevisionNotFound) {
+			return &api.GetNoteRevisionDiffNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("get note revision from repository: %w", err)
+	}
+
+	lines, err := h.diffNoteRevisions(ctx, params.TicketId, params.NoteId, from, to)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return &api.GetNoteRevisionDiffNotFound{}, nil
+		}
+
+		return nil, err
+	}
+
+	return &api.NoteRevisionDiff{
+		FromRevision: from.Revision,
+		ToRevision:   to.Revision,
+		Changed:      textdiff.Changed(lines),
+		Lines:        toAPIDiffLines(lines),
+	}, nil
+}
+
+// diffNoteRevisions : 閲覧者の権限に応じて伏字を適用した 2 つの版の差分を返す
+// 伏字の中の変更は差分に現れない
+func (h *Handler) diffNoteRevisions(ctx context.Context, ticketID, noteID int64, from, to *repository.NoteRevision) ([]textdiff.Line, error) {
+	ticket, err := h.repo.GetTicketByID(ctx, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("get ticket: %w", err)
+	}
+	viewer, err := h.getCensorViewer(ctx, getUserID(ctx), getUserRole(ctx), ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	target := noteViewTarget(ticketID, noteID)
+
+	return textdiff.Lines(
+		ApplyCensorIfNeed(ctx, viewer, target, from.Content),
+		ApplyCensorIfNeed(ctx, viewer, target, to.Content),
+	), nil
+}
+
+func toAPIDiffLines(lines []textdiff.Line) []api.DiffLine {
+	res := make([]api.DiffLine, 0, len(lines))
+	for _, line := range lines {
+		var segments []api.DiffSegment
+		for _, segment := range line.Segments {
+			segments = append(segments, api.DiffSegment{Type: api.DiffSegmentType(segment.Op), Text: segment.Text})
+		}
+		res = append(res, api.DiffLine{Type: api.DiffLineType(line.Op), Text: line.Text, Segments: segments})
+	}
+
+	return res
+}
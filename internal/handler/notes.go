@@ -2,12 +2,16 @@ package handler
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 
 	"github.com/traP-jp/anshin-techo-backend/internal/api"
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
 )
 
 // POST /tickets/{ticketId}/notes
+// 本職・補佐・関係者のみ
 //
 //nolint:revive
 func (h *Handler) TicketsTicketIdNotesPost(ctx context.Context, req *api.TicketsTicketIdNotesPostReq, params api.TicketsTicketIdNotesPostParams) (api.TicketsTicketIdNotesPostRes, error) {
@@ -16,17 +20,33 @@ func (h *Handler) TicketsTicketIdNotesPost(ctx context.Context, req *api.Tickets
 		return nil, fmt.Errorf("user not found in context (unauthorized)")
 	}
 
-	role, err := h.repo.GetUserRoleByTraqID(ctx, userID)
+	role := getUserRole(ctx)
+
+	ticket, err := h.repo.GetTicketByID(ctx, params.TicketId)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return &api.TicketsTicketIdNotesPostNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("get ticket: %w", err)
+	}
+
+	piiCheck, err := h.newPIICheck(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("get user role: %w", err)
+		return nil, err
 	}
 
-	note, err := h.repo.CreateNote(ctx, params.TicketId, userID, req.Content, string(req.Type))
+	note, err := h.repo.CreateNote(ctx, params.TicketId, userID, piiCheck.check("content", req.Content), string(req.Type))
 	if err != nil {
 		return nil, fmt.Errorf("create note: %w", err)
 	}
 
-	safeContent := ApplyCensorIfNeed(role, note.Content)
+	viewer, err := h.getCensorViewer(ctx, userID, role, ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	safeContent := ApplyCensorIfNeed(ctx, viewer, noteViewTarget(note.TicketID, note.ID), note.Content)
 
 	return &api.Note{
 		ID:       note.ID,
@@ -37,29 +57,231 @@ func (h *Handler) TicketsTicketIdNotesPost(ctx context.Context, req *api.Tickets
 		Status:   api.NoteStatus(note.Status),
 		Reviews:  []api.Review{},
 
+		ReviewRequests:          []api.ReviewRequest{},
+		RequireRequestedReviews: note.RequireRequestedReviews,
+		SubmittedAt:             toAPINilDateTime(note.SubmittedAt),
+		SentAt:                  toAPINilDateTime(note.SentAt),
+
+		PiiWarnings: piiCheck.createdWarnings(),
+
 		CreatedAt: note.CreatedAt,
 		UpdatedAt: note.UpdatedAt,
 	}, nil
 }
 
 // PUT /tickets/{ticketId}/notes/{noteId}
+// 本職・ノートの作成者のみ
 //
 //nolint:revive
 func (h *Handler) TicketsTicketIdNotesNoteIdPut(ctx context.Context, req *api.TicketsTicketIdNotesNoteIdPutReq, params api.TicketsTicketIdNotesNoteIdPutParams) (api.TicketsTicketIdNotesNoteIdPutRes, error) {
-	if err := h.repo.UpdateNote(ctx, params.TicketId, params.NoteId, req.Content, string(req.Status)); err != nil {
+	updater := getUserID(ctx)
+	role := getUserRole(ctx)
+
+	note, err := h.repo.GetNoteByID(ctx, params.TicketId, params.NoteId)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &api.TicketsTicketIdNotesNoteIdPutNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("get note: %w", err)
+	}
+
+	// ステータスは submit/withdraw/send/cancel で変更するため、変更しようとするリクエストは受け付けない
+	if status, ok := req.Status.Get(); ok && string(status) != note.Status {
+		return &api.TicketsTicketIdNotesNoteIdPutBadRequest{}, nil
+	}
+	// 送信済み・破棄済みのノートは記録として残すため、本文も編集できない
+	if !repository.NoteEditable(note.Status) {
+		res := api.NewNoteNotEditableTicketsTicketIdNotesNoteIdPutConflict(api.NoteNotEditable{Status: api.NoteStatus(note.Status)})
+
+		return &res, nil
+	}
+
+	ticket, err := h.repo.GetTicketByID(ctx, params.TicketId)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return &api.TicketsTicketIdNotesNoteIdPutNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("get ticket: %w", err)
+	}
+	viewer, err := h.getCensorViewer(ctx, updater, role, ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	content, conflict := restoreCensoredEdit(viewer, "content", note.Content, req.Content)
+	if conflict != nil {
+		res := api.NewCensorConflictTicketsTicketIdNotesNoteIdPutConflict(*conflict)
+
+		return &res, nil
+	}
+
+	piiCheck, err := h.newPIICheck(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := h.repo.UpdateNote(ctx, params.TicketId, params.NoteId, updater, piiCheck.check("content", content)); err != nil {
+		var notEditable *repository.NoteNotEditableError
+		if errors.As(err, &notEditable) {
+			res := api.NewNoteNotEditableTicketsTicketIdNotesNoteIdPutConflict(api.NoteNotEditable{Status: api.NoteStatus(notEditable.Status)})
+
+			return &res, nil
+		}
+
 		return nil, fmt.Errorf("update note: %w", err)
 	}
 
-	return &api.TicketsTicketIdNotesNoteIdPutOK{}, nil
+	return &api.PIIReport{PiiWarnings: piiCheck.warnings}, nil
 }
 
 // DELETE /tickets/{ticketId}/notes/{noteId}
+// 本職・ノートの作成者のみ
 //
 //nolint:revive
 func (h *Handler) TicketsTicketIdNotesNoteIdDelete(ctx context.Context, params api.TicketsTicketIdNotesNoteIdDeleteParams) (api.TicketsTicketIdNotesNoteIdDeleteRes, error) {
-	if err := h.repo.DeleteNote(ctx, params.TicketId, params.NoteId); err != nil {
+	if err := h.repo.DeleteNote(ctx, params.TicketId, params.NoteId, getUserID(ctx)); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return &api.TicketsTicketIdNotesNoteIdDeleteNotFound{}, nil
+		}
+
 		return nil, fmt.Errorf("delete note: %w", err)
 	}
 
 	return &api.TicketsTicketIdNotesNoteIdDeleteNoContent{}, nil
 }
+
+// POST /tickets/{ticketId}/notes/{noteId}/review-requests
+// 本職・ノートの作成者のみ
+func (h *Handler) RequestReviews(ctx context.Context, req *api.RequestReviewsReq, params api.RequestReviewsParams) (api.RequestReviewsRes, error) {
+	requests, err := h.repo.RequestReviews(ctx, params.TicketId, params.NoteId, getUserID(ctx), req.Reviewers, req.RequireRequestedReviews)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNoteNotFound):
+			return &api.RequestReviewsNotFound{}, nil
+		case errors.Is(err, repository.ErrReviewRequestNotOutgoing), errors.Is(err, repository.ErrReviewerNotFound), errors.Is(err, repository.ErrReviewerCannotSeeTicket):
+			return &api.RequestReviewsBadRequest{}, nil
+		default:
+			return nil, fmt.Errorf("request reviews: %w", err)
+		}
+	}
+
+	res := api.RequestReviewsOKApplicationJSON(toAPIReviewRequests(requests))
+
+	return &res, nil
+}
+
+// POST /tickets/{ticketId}/notes/{noteId}/submit
+// 本職・ノートの作成者のみ
+func (h *Handler) SubmitNote(ctx context.Context, params api.SubmitNoteParams) (api.SubmitNoteRes, error) {
+	note, err := h.transitionNote(ctx, params.TicketId, params.NoteId, repository.NoteActionSubmit)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNoteNotFound):
+			return &api.SubmitNoteNotFound{}, nil
+		case errors.Is(err, repository.ErrInvalidNoteTransition):
+			return &api.SubmitNoteConflict{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return note, nil
+}
+
+// POST /tickets/{ticketId}/notes/{noteId}/withdraw
+// 本職・ノートの作成者のみ
+func (h *Handler) WithdrawNote(ctx context.Context, params api.WithdrawNoteParams) (api.WithdrawNoteRes, error) {
+	note, err := h.transitionNote(ctx, params.TicketId, params.NoteId, repository.NoteActionWithdraw)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNoteNotFound):
+			return &api.WithdrawNoteNotFound{}, nil
+		case errors.Is(err, repository.ErrInvalidNoteTransition):
+			return &api.WithdrawNoteConflict{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return note, nil
+}
+
+// POST /tickets/{ticketId}/notes/{noteId}/send
+// 本職・ノートの作成者のみ
+func (h *Handler) MarkNoteSent(ctx context.Context, params api.MarkNoteSentParams) (api.MarkNoteSentRes, error) {
+	note, err := h.transitionNote(ctx, params.TicketId, params.NoteId, repository.NoteActionSend)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNoteNotFound):
+			return &api.MarkNoteSentNotFound{}, nil
+		case errors.Is(err, repository.ErrInvalidNoteTransition):
+			return &api.MarkNoteSentConflict{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return note, nil
+}
+
+// POST /tickets/{ticketId}/notes/{noteId}/cancel
+// 本職・ノートの作成者のみ
+func (h *Handler) CancelNote(ctx context.Context, params api.CancelNoteParams) (api.CancelNoteRes, error) {
+	note, err := h.transitionNote(ctx, params.TicketId, params.NoteId, repository.NoteActionCancel)
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNoteNotFound):
+			return &api.CancelNoteNotFound{}, nil
+		case errors.Is(err, repository.ErrInvalidNoteTransition):
+			return &api.CancelNoteConflict{}, nil
+		default:
+			return nil, err
+		}
+	}
+
+	return note, nil
+}
+
+// transitionNote : ノートのステータスを操作に応じて変更し、変更後のノートを閲覧者の権限に応じた伏字を適用して返す
+func (h *Handler) transitionNote(ctx context.Context, ticketID, noteID int64, action string) (*api.Note, error) {
+	ticket, err := h.repo.GetTicketByID(ctx, ticketID)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return nil, repository.ErrNoteNotFound
+		}
+
+		return nil, fmt.Errorf("get ticket: %w", err)
+	}
+
+	userID := getUserID(ctx)
+	if err := h.repo.TransitionNote(ctx, ticketID, noteID, userID, action); err != nil {
+		return nil, fmt.Errorf("transition note: %w", err)
+	}
+
+	note, err := h.repo.GetNoteByID(ctx, ticketID, noteID)
+	if err != nil {
+		return nil, fmt.Errorf("get note: %w", err)
+	}
+	reviews, err := h.repo.GetReviewsByNoteIDs(ctx, ticketID, []int64{noteID})
+	if err != nil {
+		return nil, fmt.Errorf("get note reviews from repository: %w", err)
+	}
+	requests, err := h.repo.GetReviewRequestsByNoteIDs(ctx, ticketID, []int64{noteID})
+	if err != nil {
+		return nil, fmt.Errorf("get note review requests from repository: %w", err)
+	}
+
+	viewer, err := h.getCensorViewer(ctx, userID, getUserRole(ctx), ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := convertRepositoryNote(ctx, note, reviews, requests, viewer)
+	if err != nil {
+		return nil, fmt.Errorf("convert note: %w", err)
+	}
+
+	return &res, nil
+}
@@ -0,0 +1,120 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/traP-jp/anshin-techo-backend/internal/api"
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/censor"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/pii"
+)
+
+// piiCheck : チケット・ノートの作成・更新時に伏字になっていない個人情報を検出する
+type piiCheck struct {
+	detector   pii.Detector
+	autoCensor bool
+	warnings   []api.PIIWarning
+}
+
+func (h *Handler) newPIICheck(ctx context.Context) (*piiCheck, error) {
+	cfg, err := h.repo.GetConfig(ctx)
+	if err != nil && !errors.Is(err, repository.ErrConfigNotFound) {
+		return nil, fmt.Errorf("get config from repository: %w", err)
+	}
+
+	return &piiCheck{
+		detector:   h.pii,
+		autoCensor: cfg != nil && cfg.PIIAutoCensor,
+		warnings:   []api.PIIWarning{},
+	}, nil
+}
+
+// check : 個人情報を検出して警告に追加し、自動で伏字にする設定の場合は !!kind:text!! で囲んだテキストを返す
+func (c *piiCheck) check(field, text string) string {
+	findings := unmaskedPII(c.detector, text)
+	if len(findings) == 0 {
+		return text
+	}
+
+	var b strings.Builder
+	last := 0
+	for _, f := range findings {
+		c.warnings = append(c.warnings, api.PIIWarning{
+			Field:    field,
+			Kind:     api.PIIKind(f.Kind),
+			Text:     text[f.Start:f.End],
+			Censored: c.autoCensor,
+		})
+		b.WriteString(text[last:f.Start])
+		b.WriteString("!!" + f.Kind + ":" + text[f.Start:f.End] + "!!")
+		last = f.End
+	}
+	b.WriteString(text[last:])
+
+	if !c.autoCensor {
+		return text
+	}
+
+	return b.String()
+}
+
+// createdWarnings : 作成時のレスポンスには検出した場合のみ警告を含める
+func (c *piiCheck) createdWarnings() []api.PIIWarning {
+	if len(c.warnings) == 0 {
+		return nil
+	}
+
+	return c.warnings
+}
+
+// unmaskedPII : 伏字の外側にある個人情報のみを返す
+func unmaskedPII(detector pii.Detector, text string) []pii.Finding {
+	spans := censor.Spans(text)
+	findings := []pii.Finding{}
+	for _, f := range detector.Detect(text) {
+		masked := false
+		for _, span := range spans {
+			if f.Start < span[1] && span[0] < f.End {
+				masked = true
+
+				break
+			}
+		}
+		if !masked {
+			findings = append(findings, f)
+		}
+	}
+
+	return findings
+}
+
+// GET /pii/scan
+// 本職のみ
+func (h *Handler) ScanPII(ctx context.Context) (api.ScanPIIRes, error) {
+	texts, err := h.repo.GetPIIScanTexts(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get texts from repository: %w", err)
+	}
+
+	res := api.ScanPIIOKApplicationJSON{}
+	for _, t := range texts {
+		for _, f := range unmaskedPII(h.pii, t.Text) {
+			//nolint:exhaustruct
+			result := api.PIIScanResult{
+				TicketID: t.TicketID,
+				Field:    t.Field,
+				Kind:     api.PIIKind(f.Kind),
+				Text:     t.Text[f.Start:f.End],
+			}
+			if t.NoteID.Valid {
+				result.NoteID = api.NewOptInt64(t.NoteID.Int64)
+			}
+			res = append(res, result)
+		}
+	}
+
+	return &res, nil
+}
@@ -3,21 +3,22 @@ package handler
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"net/http"
 
 	"github.com/labstack/echo/v4"
 	"github.com/traP-jp/anshin-techo-backend/internal/api"
 	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/censor"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/textdiff"
 )
 
+// CreateReview implements POST /tickets/{ticketId}/notes/{noteId}/reviews operation.
+// ユーザー登録されている人のみ
 func (h *Handler) CreateReview(ctx context.Context, req *api.CreateReviewReq, params api.CreateReviewParams) (api.CreateReviewRes, error) {
 	reviewer := getUserID(ctx)
-
-	role, err := h.repo.GetUserRoleByTraqID(ctx, reviewer)
-	if err != nil {
-		return nil, fmt.Errorf("get user role: %w", err)
-	}
+	role := getUserRole(ctx)
 
 	repoType, err := toRepositoryReviewType(req.Type)
 	if err != nil {
@@ -46,7 +47,16 @@ func (h *Handler) CreateReview(ctx context.Context, req *api.CreateReviewReq, pa
 		}
 	}
 
-	apiReview, err := convertRepositoryReview(repoReview, role)
+	ticket, err := h.repo.GetTicketByID(ctx, params.TicketId)
+	if err != nil {
+		return nil, fmt.Errorf("get ticket: %w", err)
+	}
+	viewer, err := h.getCensorViewer(ctx, reviewer, role, ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	apiReview, err := convertRepositoryReview(ctx, params.TicketId, repoReview, viewer)
 	if err != nil {
 		return nil, fmt.Errorf("convert review: %w", err)
 	}
@@ -72,6 +82,46 @@ func (h *Handler) DeleteReview(ctx context.Context, params api.DeleteReviewParam
 	return &api.DeleteReviewNoContent{}, nil
 }
 
+// GetReviewDiff implements GET /tickets/{ticketId}/notes/{noteId}/reviews/{reviewId}/diff operation.
+func (h *Handler) GetReviewDiff(ctx context.Context, params api.GetReviewDiffParams) (api.GetReviewDiffRes, error) {
+	review, err := h.repo.GetReviewByID(ctx, params.TicketId, params.NoteId, params.ReviewId)
+	if err != nil {
+		if errors.Is(err, repository.ErrReviewNotFound) {
+			return &api.GetReviewDiffNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("get review from repository: %w", err)
+	}
+	// 版の記録前のレビューはレビューした本文が分からない
+	if !review.Revision.Valid {
+		return &api.GetReviewDiffNotFound{}, nil
+	}
+
+	from, err := h.repo.GetNoteRevision(ctx, params.TicketId, params.NoteId, review.Revision.Int64)
+	if err != nil {
+		return nil, fmt.Errorf("get reviewed note revision: %w", err)
+	}
+	to, err := h.repo.GetLatestNoteRevision(ctx, params.TicketId, params.NoteId)
+	if err != nil {
+		return nil, fmt.Errorf("get latest note revision: %w", err)
+	}
+
+	lines, err := h.diffNoteRevisions(ctx, params.TicketId, params.NoteId, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &api.ReviewDiff{
+		ReviewID:       review.ID,
+		Revision:       from.Revision,
+		LatestRevision: to.Revision,
+		Status:         api.ReviewDiffStatus(review.Status),
+		ReviewedAt:     review.CreatedAt,
+		Changed:        textdiff.Changed(lines),
+		Lines:          toAPIDiffLines(lines),
+	}, nil
+}
+
 // UpdateReview implements PUT /tickets/{ticketId}/notes/{noteId}/reviews/{reviewId} operation.
 func (h *Handler) UpdateReview(ctx context.Context, req api.OptUpdateReviewReq, params api.UpdateReviewParams) (api.UpdateReviewRes, error) {
 	reviewer := getUserID(ctx)
@@ -124,7 +174,7 @@ func toRepositoryReviewType(t api.ReviewType) (string, error) {
 	}
 }
 
-func convertRepositoryReview(review *repository.Review, role string) (*api.Review, error) {
+func convertRepositoryReview(ctx context.Context, ticketID int64, review *repository.Review, viewer censor.Viewer) (*api.Review, error) {
 	reviewType, err := toAPIReviewType(review.Type)
 	if err != nil {
 		return nil, err
@@ -135,11 +185,12 @@ func convertRepositoryReview(review *repository.Review, role string) (*api.Revie
 		return nil, err
 	}
 
-	safeComment := ApplyCensorIfNeed(role, review.Comment.String)
+	safeComment := ApplyCensorIfNeed(ctx, viewer, noteViewTarget(ticketID, review.NoteID), review.Comment.String)
 
 	return &api.Review{
 		ID:        review.ID,
 		NoteID:    review.NoteID,
+		Revision:  api.NilInt64{Value: review.Revision.Int64, Null: !review.Revision.Valid},
 		Reviewer:  review.Author,
 		Type:      reviewType,
 		Weight:    review.Weight,
@@ -0,0 +1,96 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/traP-jp/anshin-techo-backend/internal/api"
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/authz"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/censor"
+)
+
+// snippetRadius : 抜粋に含めるヒット箇所の前後の文字数
+const snippetRadius = 30
+
+// GET /search
+// 誰でも (本職以外は閲覧できるカテゴリの伏字の中のみ検索でき、閲覧できないカテゴリの伏字は抜粋でも伏せる)
+// 公開範囲外のチケットは結果に含めない
+func (h *Handler) Search(ctx context.Context, params api.SearchParams) (api.SearchRes, error) {
+	userID := getUserID(ctx)
+	role := getUserRole(ctx)
+
+	policy, err := h.loadCensorPolicy(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	hits, err := h.repo.Search(ctx, repository.SearchParams{
+		Query:      params.Q,
+		Censored:   role != authz.RoleManager,
+		Role:       role,
+		Policy:     policy,
+		Viewer:     userID,
+		Restricted: role != authz.RoleManager,
+		Limit:      params.Limit.Or(repository.DefaultSearchLimit),
+	})
+	if err != nil {
+		if errors.Is(err, repository.ErrEmptySearchQuery) {
+			return &api.SearchBadRequest{}, nil
+		}
+
+		return nil, fmt.Errorf("search in repository: %w", err)
+	}
+
+	res := make(api.SearchOKApplicationJSON, 0, len(hits))
+	for _, hit := range hits {
+		viewer := censor.Viewer{Role: role, Assignee: hit.Assigned, Policy: policy}
+		//nolint:exhaustruct
+		apiHit := api.SearchHit{
+			Type:     api.SearchHitType(hit.Kind),
+			TicketID: hit.TicketID,
+			Score:    hit.Score,
+			Snippet:  searchSnippet(ApplyCensorIfNeed(ctx, viewer, repository.UncensoredViewTarget{TicketID: hit.TicketID, NoteID: hit.NoteID}, hit.Text), params.Q),
+		}
+		if hit.NoteID.Valid {
+			apiHit.NoteID = api.NewOptInt64(hit.NoteID.Int64)
+		}
+		if hit.ReviewID.Valid {
+			apiHit.ReviewID = api.NewOptInt64(hit.ReviewID.Int64)
+		}
+		res = append(res, apiHit)
+	}
+
+	return &res, nil
+}
+
+// searchSnippet : 検索語が最初に現れる箇所の前後を切り出す
+func searchSnippet(text string, query string) string {
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	lower := []rune(strings.ToLower(string(runes)))
+
+	start := 0
+	for _, term := range strings.Fields(strings.ToLower(query)) {
+		if i := strings.Index(string(lower), term); i >= 0 {
+			start = len([]rune(string(lower)[:i]))
+
+			break
+		}
+	}
+
+	// 小文字に変換して文字数が変わった場合でも範囲外にならないようにする
+	start = min(start, len(runes))
+	from := max(start-snippetRadius, 0)
+	to := min(start+snippetRadius, len(runes))
+	snippet := string(runes[from:to])
+	if from > 0 {
+		snippet = "…" + snippet
+	}
+	if to < len(runes) {
+		snippet += "…"
+	}
+
+	return snippet
+}
@@ -9,24 +9,25 @@ import (
 
 	"github.com/traP-jp/anshin-techo-backend/internal/api"
 	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/authz"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/censor"
 )
 
 // POST /tickets
 // 本職・補佐のみ
 func (h *Handler) CreateTicket(ctx context.Context, req *api.CreateTicketReq) (api.CreateTicketRes, error) {
 	creator := getUserID(ctx)
-	role, err := h.repo.GetUserRoleByTraqID(ctx, creator)
-	if err != nil {
-		return nil, fmt.Errorf("get user role from repository: %w", err)
-	}
+	role := getUserRole(ctx)
 
-	if role != "manager" && role != "assistant" {
-		return &api.CreateTicketForbidden{}, nil
+	piiCheck, err := h.newPIICheck(ctx)
+	if err != nil {
+		return nil, err
 	}
 
+	title := piiCheck.check("title", req.Title)
 	description := sql.NullString{String: "", Valid: false}
 	if req.Description.Set {
-		description = sql.NullString{String: req.Description.Value, Valid: true}
+		description = sql.NullString{String: piiCheck.check("description", req.Description.Value), Valid: true}
 	}
 
 	due := sql.NullTime{Time: time.Time{}, Valid: false}
@@ -34,18 +35,25 @@ func (h *Handler) CreateTicket(ctx context.Context, req *api.CreateTicketReq) (a
 		due = sql.NullTime{Time: req.Due.Value, Valid: true}
 	}
 
+	visibility := string(req.Visibility.Or(api.TicketVisibilityPublic))
+	if !authz.CanSetVisibility(role, visibility) {
+		return &api.CreateTicketForbidden{}, nil
+	}
+
 	repoTicket := repository.CreateTicketParams{
-		Title:        req.Title,
+		Title:        title,
 		Description:  description,
 		Status:       string(req.Status),
+		ManualStatus: req.ManualStatus.Or(false),
 		Assignee:     req.Assignee,
 		SubAssignees: req.SubAssignees,
 		Stakeholders: req.Stakeholders,
 		Due:          due,
 		Tags:         req.Tags,
+		Visibility:   visibility,
 	}
 
-	ticketID, err := h.repo.CreateTicket(ctx, repoTicket)
+	ticketID, err := h.repo.CreateTicket(ctx, creator, repoTicket)
 	if err != nil {
 		if errors.Is(err, repository.ErrInvalidStatus) {
 			return &api.CreateTicketBadRequest{}, nil
@@ -53,6 +61,9 @@ func (h *Handler) CreateTicket(ctx context.Context, req *api.CreateTicketReq) (a
 		if errors.Is(err, repository.ErrTagContainsComma) {
 			return &api.CreateTicketBadRequest{}, nil
 		}
+		if errors.Is(err, repository.ErrInvalidVisibility) {
+			return &api.CreateTicketBadRequest{}, nil
+		}
 
 		return nil, fmt.Errorf("create ticket in repository: %w", err)
 	}
@@ -60,49 +71,49 @@ func (h *Handler) CreateTicket(ctx context.Context, req *api.CreateTicketReq) (a
 	if err != nil {
 		return nil, fmt.Errorf("get created ticket from repository: %w", err)
 	}
-
-	res := &api.Ticket{
-		ID:           ticket.ID,
-		Title:        ApplyCensorIfNeed(role, ticket.Title),
-		Description:  ApplyCensorIfNeed(role, ticket.Description.String),
-		Due:          api.NilDate{Value: ticket.Due.Time, Null: !ticket.Due.Valid},
-		Status:       api.TicketStatus(ticket.Status),
-		Assignee:     ticket.Assignee,
-		SubAssignees: ticket.SubAssignees,
-		Stakeholders: ticket.Stakeholders,
-		Tags:         ticket.Tags,
-		CreatedAt:    ticket.CreatedAt,
-		UpdatedAt:    ticket.UpdatedAt,
+	viewer, err := h.getCensorViewer(ctx, creator, role, ticket)
+	if err != nil {
+		return nil, err
 	}
 
-	return res, nil
+	res := toAPITicket(ctx, ticket, viewer)
+	res.PiiWarnings = piiCheck.createdWarnings()
+
+	return &res, nil
 }
 
 // GET /tickets
-// 誰でも
+// 誰でも (公開範囲外のチケットは含めない)
 func (h *Handler) GetTickets(ctx context.Context, params api.GetTicketsParams) (api.GetTicketsRes, error) {
 	userID := getUserID(ctx)
+	role := getUserRole(ctx)
 
-	role, err := h.repo.GetUserRoleByTraqID(ctx, userID)
-	if err != nil {
-		return nil, fmt.Errorf("get user role: %w", err)
+	statuses := make([]string, 0, len(params.Status))
+	for _, status := range params.Status {
+		statuses = append(statuses, string(status))
 	}
-
 	repoParams := repository.GetTicketsParams{
-		Assignee: "",
-		Status:   "",
-		Sort:     "",
-	}
-	if params.Assignee.Set {
-		repoParams.Assignee = params.Assignee.Value
-	}
-	if params.Status.Set {
-		repoParams.Status = string(params.Status.Value)
+		Filter: repository.TicketFilter{
+			Assignee:     params.Assignee.Or(""),
+			Statuses:     statuses,
+			Tags:         params.Tag,
+			SubAssignees: params.SubAssignee,
+			Stakeholders: params.Stakeholder,
+			Involves:     params.Involves.Or(""),
+			DueBefore:    sql.NullTime{Time: params.DueBefore.Value, Valid: params.DueBefore.Set},
+			DueAfter:     sql.NullTime{Time: params.DueAfter.Value, Valid: params.DueAfter.Set},
+			UpdatedSince: sql.NullTime{Time: params.UpdatedSince.Value, Valid: params.UpdatedSince.Set},
+			Overdue:      sql.NullBool{Bool: params.Overdue.Value, Valid: params.Overdue.Set},
+			VisibleTo:    sql.NullString{String: userID, Valid: role != authz.RoleManager},
+		},
+		Sort:   "",
+		Limit:  params.Limit.Or(0),
+		Cursor: params.Cursor.Or(""),
 	}
 	if params.Sort.Set {
 		repoParams.Sort = string(params.Sort.Value)
 	}
-	tickets, err := h.repo.GetTickets(ctx, repoParams)
+	page, err := h.repo.GetTickets(ctx, repoParams)
 	if err != nil {
 		if errors.Is(err, repository.ErrInvalidStatus) {
 			return &api.GetTicketsBadRequest{}, nil
@@ -110,30 +121,31 @@ func (h *Handler) GetTickets(ctx context.Context, params api.GetTicketsParams) (
 		if errors.Is(err, repository.ErrInvalidSort) {
 			return &api.GetTicketsBadRequest{}, nil
 		}
+		if errors.Is(err, repository.ErrInvalidCursor) {
+			return &api.GetTicketsBadRequest{}, nil
+		}
 
 		return nil, fmt.Errorf("get tickets from repository: %w", err)
 	}
 
-	res := make([]api.Ticket, 0, len(tickets))
-	for _, ticket := range tickets {
-		res = append(res, api.Ticket{
-			ID:          ticket.ID,
-			Title:       ApplyCensorIfNeed(role, ticket.Title),
-			Description: ApplyCensorIfNeed(role, ticket.Description.String),
-			Due: api.NilDate{
-				Value: ticket.Due.Time,
-				Null:  !ticket.Due.Valid,
-			},
-			Status:       api.TicketStatus(ticket.Status),
-			Assignee:     ticket.Assignee,
-			SubAssignees: ticket.SubAssignees,
-			Stakeholders: ticket.Stakeholders,
-			Tags:         ticket.Tags,
-			CreatedAt:    ticket.CreatedAt,
-			UpdatedAt:    ticket.UpdatedAt,
-		})
+	policy, err := h.loadCensorPolicy(ctx, role)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]api.Ticket, 0, len(page.Tickets))
+	for _, ticket := range page.Tickets {
+		viewer := censorViewer(policy, userID, role, ticket)
+		res = append(res, toAPITicket(ctx, ticket, viewer))
+	}
+	//nolint:exhaustruct
+	result := api.GetTicketsOKHeaders{
+		XTotalCount: page.Total,
+		Response:    res,
+	}
+	if page.NextCursor != "" {
+		result.XNextCursor = api.NewOptString(page.NextCursor)
 	}
-	result := api.GetTicketsOKApplicationJSON(res)
 
 	return &result, nil
 }
@@ -143,16 +155,8 @@ func (h *Handler) GetTickets(ctx context.Context, params api.GetTicketsParams) (
 func (h *Handler) DeleteTicketByID(ctx context.Context, params api.DeleteTicketByIDParams) (api.DeleteTicketByIDRes, error) {
 	deleter := getUserID(ctx)
 
-	role, err := h.repo.GetUserRoleByTraqID(ctx, deleter)
-	if err != nil {
-		return nil, fmt.Errorf("get user role from repository: %w", err)
-	}
-	if role != "manager" {
-		return &api.DeleteTicketByIDForbidden{}, nil
-	}
-
 	id := params.TicketId
-	if err := h.repo.DeleteTicket(ctx, id); err != nil {
+	if err := h.repo.DeleteTicket(ctx, id, deleter); err != nil {
 		if errors.Is(err, repository.ErrTicketNotFound) {
 			return &api.DeleteTicketByIDNotFound{}, nil
 		}
@@ -167,11 +171,7 @@ func (h *Handler) DeleteTicketByID(ctx context.Context, params api.DeleteTicketB
 // GET /tickets/{ticketId}
 func (h *Handler) GetTicketByID(ctx context.Context, params api.GetTicketByIDParams) (api.GetTicketByIDRes, error) {
 	userID := getUserID(ctx)
-
-	role, err := h.repo.GetUserRoleByTraqID(ctx, userID)
-	if err != nil {
-		return nil, fmt.Errorf("get user role: %w", err)
-	}
+	role := getUserRole(ctx)
 
 	id := params.TicketId
 	ticket, err := h.repo.GetTicketByID(ctx, id)
@@ -183,6 +183,11 @@ func (h *Handler) GetTicketByID(ctx context.Context, params api.GetTicketByIDPar
 		return nil, fmt.Errorf("get ticket from repository: %w", err)
 	}
 
+	viewer, err := h.getCensorViewer(ctx, userID, role, ticket)
+	if err != nil {
+		return nil, err
+	}
+
 	notes, err := h.repo.GetNotes(ctx, id)
 	if err != nil {
 		return nil, fmt.Errorf("get notes from repository: %w", err)
@@ -205,27 +210,43 @@ func (h *Handler) GetTicketByID(ctx context.Context, params api.GetTicketByIDPar
 		}
 	}
 
+	requestsByNoteID := map[int64][]*repository.ReviewRequest{}
+	if len(noteIDs) > 0 {
+		requests, getRequestsErr := h.repo.GetReviewRequestsByNoteIDs(ctx, id, noteIDs)
+		if getRequestsErr != nil {
+			return nil, fmt.Errorf("get note review requests from repository: %w", getRequestsErr)
+		}
+
+		for _, request := range requests {
+			requestsByNoteID[request.NoteID] = append(requestsByNoteID[request.NoteID], request)
+		}
+	}
+
 	apiNotes := make([]api.Note, 0, len(notes))
 	for _, note := range notes {
-		apiNote, convertErr := convertRepositoryNote(note, reviewsByNoteID[note.ID], role)
+		apiNote, convertErr := convertRepositoryNote(ctx, note, reviewsByNoteID[note.ID], requestsByNoteID[note.ID], viewer)
 		if convertErr != nil {
 			return nil, fmt.Errorf("convert note: %w", convertErr)
 		}
 
 		apiNotes = append(apiNotes, apiNote)
 	}
+	apiTicket := toAPITicket(ctx, ticket, viewer)
 	res := &api.GetTicketByIDOK{
-		ID:           ticket.ID,
-		Title:        ApplyCensorIfNeed(role, ticket.Title),
-		Description:  ApplyCensorIfNeed(role, ticket.Description.String),
-		Due:          api.NilDate{Value: ticket.Due.Time, Null: !ticket.Due.Valid},
-		Status:       api.TicketStatus(ticket.Status),
-		Assignee:     ticket.Assignee,
-		SubAssignees: ticket.SubAssignees,
-		Stakeholders: ticket.Stakeholders,
-		Tags:         ticket.Tags,
-		CreatedAt:    ticket.CreatedAt,
-		UpdatedAt:    ticket.UpdatedAt,
+		ID:           apiTicket.ID,
+		Title:        apiTicket.Title,
+		Description:  apiTicket.Description,
+		Assignee:     apiTicket.Assignee,
+		SubAssignees: apiTicket.SubAssignees,
+		Stakeholders: apiTicket.Stakeholders,
+		Status:       apiTicket.Status,
+		ManualStatus: apiTicket.ManualStatus,
+		Tags:         apiTicket.Tags,
+		Visibility:   apiTicket.Visibility,
+		Due:          apiTicket.Due,
+		CreatedAt:    apiTicket.CreatedAt,
+		UpdatedAt:    apiTicket.UpdatedAt,
+		PiiWarnings:  apiTicket.PiiWarnings,
 		Notes:        apiNotes,
 	}
 
@@ -250,39 +271,41 @@ func (h *Handler) UpdateTicketByID(ctx context.Context, req api.OptUpdateTicketB
 	}
 
 	updater := getUserID(ctx)
+	role := getUserRole(ctx)
 
-	role, err := h.repo.GetUserRoleByTraqID(ctx, updater)
+	viewer, err := h.getCensorViewer(ctx, updater, role, ticket)
 	if err != nil {
-		return nil, fmt.Errorf("get user role from repository: %w", err)
+		return nil, err
 	}
 
-	ok := false
-	if role == "manager" || role == "assistant" {
-		ok = true
-	} else {
-		for _, authorized := range append(append(ticket.Stakeholders, ticket.SubAssignees...), ticket.Assignee) {
-			if authorized == updater {
-				ok = true
-
-				break
-			}
-		}
-	}
-	if !ok {
-		return &api.UpdateTicketByIDForbidden{}, nil
+	piiCheck, err := h.newPIICheck(ctx)
+	if err != nil {
+		return nil, err
 	}
 
 	title := ticket.Title
 	if req.Value.Title.Set {
-		title = req.Value.Title.Value
+		restored, conflict := restoreCensoredEdit(viewer, "title", ticket.Title, req.Value.Title.Value)
+		if conflict != nil {
+			res := api.NewCensorConflictUpdateTicketByIDConflict(*conflict)
+
+			return &res, nil
+		}
+		title = piiCheck.check("title", restored)
 	}
 	description := ticket.Description
 	if req.Value.Description.Set {
-		if req.Value.Description.Value == "" {
+		restored, conflict := restoreCensoredEdit(viewer, "description", ticket.Description.String, req.Value.Description.Value)
+		if conflict != nil {
+			res := api.NewCensorConflictUpdateTicketByIDConflict(*conflict)
+
+			return &res, nil
+		}
+		if restored == "" {
 			description = sql.NullString{String: "", Valid: false}
 		} else {
 			description = sql.NullString{
-				String: req.Value.Description.Value,
+				String: piiCheck.check("description", restored),
 				Valid:  true,
 			}
 		}
@@ -291,6 +314,10 @@ func (h *Handler) UpdateTicketByID(ctx context.Context, req api.OptUpdateTicketB
 	if req.Value.Status.Set {
 		status = string(req.Value.Status.Value)
 	}
+	manualStatus := ticket.ManualStatus
+	if req.Value.ManualStatus.Set {
+		manualStatus = req.Value.ManualStatus.Value
+	}
 	assignee := ticket.Assignee
 	if req.Value.Assignee.Set {
 		assignee = req.Value.Assignee.Value
@@ -318,31 +345,174 @@ func (h *Handler) UpdateTicketByID(ctx context.Context, req api.OptUpdateTicketB
 	if req.Value.Tags != nil {
 		tags = req.Value.Tags
 	}
+	visibility := ticket.Visibility
+	if req.Value.Visibility.Set {
+		visibility = string(req.Value.Visibility.Value)
+		if visibility != ticket.Visibility && !authz.CanSetVisibility(role, visibility) {
+			return &api.UpdateTicketByIDForbidden{}, nil
+		}
+	}
 	updateParams := repository.CreateTicketParams{
 		Title:        title,
 		Description:  description,
 		Status:       status,
+		ManualStatus: manualStatus,
 		Assignee:     assignee,
 		SubAssignees: subAssignees,
 		Stakeholders: stakeholders,
 		Due:          due,
 		Tags:         tags,
+		Visibility:   visibility,
 	}
-	if err := h.repo.UpdateTicket(ctx, id, updateParams); err != nil {
+	if err := h.repo.UpdateTicket(ctx, id, updater, role, updateParams); err != nil {
 		if errors.Is(err, repository.ErrInvalidStatus) {
 			return &api.UpdateTicketByIDBadRequest{}, nil
 		}
+		if errors.Is(err, repository.ErrInvalidStatusTransition) {
+			res := api.NewTicketTransitionsUpdateTicketByIDConflict(*toAPITicketTransitions(ticket.Status, repository.AllowedTicketStatuses(ticket.Status, role)))
+
+			return &res, nil
+		}
 		if errors.Is(err, repository.ErrTagContainsComma) {
 			return &api.UpdateTicketByIDBadRequest{}, nil
 		}
+		if errors.Is(err, repository.ErrInvalidVisibility) {
+			return &api.UpdateTicketByIDBadRequest{}, nil
+		}
 
 		return nil, fmt.Errorf("update ticket in repository: %w", err)
 	}
 
-	return &api.UpdateTicketByIDOK{}, nil
+	return &api.PIIReport{PiiWarnings: piiCheck.warnings}, nil
 }
 
-func convertRepositoryNote(note *repository.Note, reviews []*repository.Review, role string) (api.Note, error) {
+// GET /tickets/{ticketId}/transitions
+// 編集権限がない場合は遷移先が空になる
+func (h *Handler) GetTicketTransitions(ctx context.Context, params api.GetTicketTransitionsParams) (api.GetTicketTransitionsRes, error) {
+	userID := getUserID(ctx)
+
+	ticket, err := h.repo.GetTicketByID(ctx, params.TicketId)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return &api.GetTicketTransitionsNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("get ticket from repository: %w", err)
+	}
+
+	role := getUserRole(ctx)
+
+	allowed := []string{}
+	if authz.Allowed(role, ticketRelations(userID, ticket), authz.ActionEditTicket) {
+		allowed = repository.AllowedTicketStatuses(ticket.Status, role)
+	}
+
+	return toAPITicketTransitions(ticket.Status, allowed), nil
+}
+
+// GET /tickets/{ticketId}/history
+func (h *Handler) GetTicketHistory(ctx context.Context, params api.GetTicketHistoryParams) (api.GetTicketHistoryRes, error) {
+	ticket, err := h.repo.GetTicketByID(ctx, params.TicketId)
+	if err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return &api.GetTicketHistoryNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("get ticket from repository: %w", err)
+	}
+
+	userID := getUserID(ctx)
+	role := getUserRole(ctx)
+
+	viewer, err := h.getCensorViewer(ctx, userID, role, ticket)
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := h.repo.GetTicketEvents(ctx, params.TicketId)
+	if err != nil {
+		return nil, fmt.Errorf("get ticket events from repository: %w", err)
+	}
+
+	res := make(api.GetTicketHistoryOKApplicationJSON, 0, len(events))
+	for _, event := range events {
+		res = append(res, toAPITicketEvent(ctx, event, viewer))
+	}
+
+	return &res, nil
+}
+
+// toAPITicketEvent : 変更前後の値には閲覧者の権限に応じて伏字を適用する
+func toAPITicketEvent(ctx context.Context, event *repository.TicketEvent, viewer censor.Viewer) api.TicketEvent {
+	target := repository.UncensoredViewTarget{TicketID: event.TicketID, NoteID: event.NoteID}
+	changes := make([]api.FieldChange, 0, len(event.Changes))
+	for _, change := range event.Changes {
+		changes = append(changes, api.FieldChange{
+			Field:  change.Field,
+			Before: toAPICensoredNilString(ctx, target, change.Before, viewer),
+			After:  toAPICensoredNilString(ctx, target, change.After, viewer),
+		})
+	}
+
+	//nolint:exhaustruct
+	res := api.TicketEvent{
+		ID:        event.ID,
+		TicketID:  event.TicketID,
+		Actor:     event.Actor,
+		Action:    api.TicketEventAction(event.Action),
+		Changes:   changes,
+		CreatedAt: event.CreatedAt,
+	}
+	if event.NoteID.Valid {
+		res.NoteID = api.NewOptInt64(event.NoteID.Int64)
+	}
+	if event.ReviewID.Valid {
+		res.ReviewID = api.NewOptInt64(event.ReviewID.Int64)
+	}
+
+	return res
+}
+
+func toAPICensoredNilString(ctx context.Context, target repository.UncensoredViewTarget, value *string, viewer censor.Viewer) api.NilString {
+	if value == nil {
+		//nolint:exhaustruct
+		return api.NilString{Null: true}
+	}
+
+	return api.NewNilString(ApplyCensorIfNeed(ctx, viewer, target, *value))
+}
+
+func toAPITicket(ctx context.Context, ticket *repository.Ticket, viewer censor.Viewer) api.Ticket {
+	return api.Ticket{
+		ID:           ticket.ID,
+		Title:        ApplyCensorIfNeed(ctx, viewer, ticketViewTarget(ticket.ID), ticket.Title),
+		Description:  ApplyCensorIfNeed(ctx, viewer, ticketViewTarget(ticket.ID), ticket.Description.String),
+		Due:          api.NilDate{Value: ticket.Due.Time, Null: !ticket.Due.Valid},
+		Status:       api.TicketStatus(ticket.Status),
+		ManualStatus: ticket.ManualStatus,
+		Visibility:   api.TicketVisibility(ticket.Visibility),
+		Assignee:     ticket.Assignee,
+		SubAssignees: ticket.SubAssignees,
+		Stakeholders: ticket.Stakeholders,
+		Tags:         ticket.Tags,
+		CreatedAt:    ticket.CreatedAt,
+		UpdatedAt:    ticket.UpdatedAt,
+	}
+}
+
+func toAPITicketTransitions(current string, allowed []string) *api.TicketTransitions {
+	apiAllowed := make([]api.TicketStatus, 0, len(allowed))
+	for _, status := range allowed {
+		apiAllowed = append(apiAllowed, api.TicketStatus(status))
+	}
+
+	return &api.TicketTransitions{
+		Current: api.TicketStatus(current),
+		Allowed: apiAllowed,
+	}
+}
+
+func convertRepositoryNote(ctx context.Context, note *repository.Note, reviews []*repository.Review, requests []*repository.ReviewRequest, viewer censor.Viewer) (api.Note, error) {
 	noteType, err := toAPINoteType(note.Type)
 	if err != nil {
 		return api.Note{}, err
@@ -355,7 +525,7 @@ func convertRepositoryNote(note *repository.Note, reviews []*repository.Review,
 
 	apiReviews := make([]api.Review, 0, len(reviews))
 	for _, review := range reviews {
-		apiReview, convertErr := convertRepositoryReview(review, role)
+		apiReview, convertErr := convertRepositoryReview(ctx, note.TicketID, review, viewer)
 		if convertErr != nil {
 			return api.Note{}, convertErr
 		}
@@ -364,18 +534,40 @@ func convertRepositoryNote(note *repository.Note, reviews []*repository.Review,
 	}
 
 	return api.Note{
-		ID:        note.ID,
-		TicketID:  note.TicketID,
-		Type:      noteType,
-		Status:    noteStatus,
-		Author:    note.UserID,
-		Content:   ApplyCensorIfNeed(role, note.Content),
-		Reviews:   apiReviews,
-		CreatedAt: note.CreatedAt,
-		UpdatedAt: note.UpdatedAt,
+		ID:                      note.ID,
+		TicketID:                note.TicketID,
+		Type:                    noteType,
+		Status:                  noteStatus,
+		Author:                  note.UserID,
+		Content:                 ApplyCensorIfNeed(ctx, viewer, noteViewTarget(note.TicketID, note.ID), note.Content),
+		Reviews:                 apiReviews,
+		ReviewRequests:          toAPIReviewRequests(requests),
+		RequireRequestedReviews: note.RequireRequestedReviews,
+		SubmittedAt:             toAPINilDateTime(note.SubmittedAt),
+		SentAt:                  toAPINilDateTime(note.SentAt),
+		CreatedAt:               note.CreatedAt,
+		UpdatedAt:               note.UpdatedAt,
 	}, nil
 }
 
+func toAPINilDateTime(t sql.NullTime) api.NilDateTime {
+	return api.NilDateTime{Value: t.Time, Null: !t.Valid}
+}
+
+func toAPIReviewRequests(requests []*repository.ReviewRequest) []api.ReviewRequest {
+	res := make([]api.ReviewRequest, 0, len(requests))
+	for _, request := range requests {
+		res = append(res, api.ReviewRequest{
+			Reviewer:    request.Reviewer,
+			RequestedBy: request.RequestedBy,
+			Status:      api.ReviewRequestStatus(request.Status),
+			RequestedAt: request.CreatedAt,
+		})
+	}
+
+	return res
+}
+
 func toAPINoteType(noteType string) (api.NoteType, error) {
 	switch noteType {
 	case "outgoing":
@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/traP-jp/anshin-techo-backend/internal/api"
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/auth"
+)
+
+// ErrInsufficientScope : APIトークンのスコープが足りない
+var ErrInsufficientScope = fmt.Errorf("insufficient token scope")
+
+// tokenScopes : 操作ごとに必要なAPIトークンのスコープ (ここにない操作は admin が必要)
+var tokenScopes = map[string]auth.Scope{
+	api.ConfigGetOperation:            auth.ScopeRead,
+	api.GetCensorPolicyOperation:      auth.ScopeRead,
+	api.GetMyTokensOperation:          auth.ScopeRead,
+	api.GetRoleChangesOperation:       auth.ScopeRead,
+	api.GetTicketByIDOperation:        auth.ScopeRead,
+	api.GetTicketHistoryOperation:     auth.ScopeRead,
+	api.GetTicketTransitionsOperation: auth.ScopeRead,
+	api.GetTicketsOperation:           auth.ScopeRead,
+	api.GetTrashedTicketsOperation:    auth.ScopeRead,
+	api.GetUncensoredViewsOperation:   auth.ScopeRead,
+	api.MeGetOperation:                auth.ScopeRead,
+	api.ScanPIIOperation:              auth.ScopeRead,
+	api.SearchOperation:               auth.ScopeRead,
+	api.UsersGetOperation:             auth.ScopeRead,
+	api.GetUserOperation:              auth.ScopeRead,
+	api.GetReviewDiffOperation:        auth.ScopeRead,
+	api.GetNoteRevisionsOperation:     auth.ScopeRead,
+	api.GetNoteRevisionDiffOperation:  auth.ScopeRead,
+
+	api.CreateReviewOperation:                           auth.ScopeTicketsWrite,
+	api.CreateTicketOperation:                           auth.ScopeTicketsWrite,
+	api.DeleteReviewOperation:                           auth.ScopeTicketsWrite,
+	api.DeleteTicketByIDOperation:                       auth.ScopeTicketsWrite,
+	api.TicketsTicketIdAiGeneratePostOperation:          auth.ScopeTicketsWrite,
+	api.TicketsTicketIdNotesNoteIdAiReviewPostOperation: auth.ScopeTicketsWrite,
+	api.TicketsTicketIdNotesNoteIdDeleteOperation:       auth.ScopeTicketsWrite,
+	api.TicketsTicketIdNotesNoteIdPutOperation:          auth.ScopeTicketsWrite,
+	api.RequestReviewsOperation:                         auth.ScopeTicketsWrite,
+	api.SubmitNoteOperation:                             auth.ScopeTicketsWrite,
+	api.WithdrawNoteOperation:                           auth.ScopeTicketsWrite,
+	api.MarkNoteSentOperation:                           auth.ScopeTicketsWrite,
+	api.CancelNoteOperation:                             auth.ScopeTicketsWrite,
+	api.TicketsTicketIdNotesPostOperation:               auth.ScopeTicketsWrite,
+	api.UpdateReviewOperation:                           auth.ScopeTicketsWrite,
+	api.UpdateTicketByIDOperation:                       auth.ScopeTicketsWrite,
+}
+
+// requiredTokenScope : 操作に必要なAPIトークンのスコープ
+func requiredTokenScope(operationName string) auth.Scope {
+	if scope, ok := tokenScopes[operationName]; ok {
+		return scope
+	}
+
+	return auth.ScopeAdmin
+}
+
+// HandleBearerAuth : 個人用APIトークンで認証し、操作に必要なスコープを持つか確認する
+func (h *Handler) HandleBearerAuth(ctx context.Context, operationName string, t api.BearerAuth) (context.Context, error) {
+	token, err := h.repo.UseAPIToken(ctx, auth.HashToken(t.Token))
+	if err != nil {
+		if errors.Is(err, repository.ErrAPITokenNotFound) {
+			return nil, echo.NewHTTPError(http.StatusUnauthorized, "unauthorized: invalid or expired token")
+		}
+
+		return nil, fmt.Errorf("use api token in repository: %w", err)
+	}
+	if !auth.Scope(token.Scope).Allows(requiredTokenScope(operationName)) {
+		return nil, ErrInsufficientScope
+	}
+
+	return context.WithValue(ctx, userKey, token.TraqID), nil
+}
+
+// GET /me/tokens
+func (h *Handler) GetMyTokens(ctx context.Context) (api.GetMyTokensRes, error) {
+	tokens, err := h.repo.GetAPITokens(ctx, getUserID(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("get api tokens from repository: %w", err)
+	}
+
+	res := make(api.GetMyTokensOKApplicationJSON, 0, len(tokens))
+	for _, token := range tokens {
+		res = append(res, toAPIToken(token))
+	}
+
+	return &res, nil
+}
+
+// POST /me/tokens
+func (h *Handler) CreateMyToken(ctx context.Context, req *api.APITokenCreate) (api.CreateMyTokenRes, error) {
+	if !req.ExpiresAt.After(time.Now()) {
+		return &api.CreateMyTokenBadRequest{}, nil
+	}
+
+	secret, err := auth.NewToken()
+	if err != nil {
+		return nil, err
+	}
+	secret = auth.APITokenPrefix + secret
+
+	token, err := h.repo.CreateAPIToken(ctx, repository.CreateAPITokenParams{
+		TraqID:    getUserID(ctx),
+		Name:      req.Name,
+		TokenHash: auth.HashToken(secret),
+		Scope:     string(req.Scope),
+		ExpiresAt: req.ExpiresAt,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("create api token in repository: %w", err)
+	}
+
+	apiToken := toAPIToken(token)
+
+	return &api.CreatedAPIToken{
+		ID:         apiToken.ID,
+		Name:       apiToken.Name,
+		Scope:      apiToken.Scope,
+		ExpiresAt:  apiToken.ExpiresAt,
+		LastUsedAt: apiToken.LastUsedAt,
+		CreatedAt:  apiToken.CreatedAt,
+		Token:      secret,
+	}, nil
+}
+
+// DELETE /me/tokens/{tokenId}
+func (h *Handler) DeleteMyToken(ctx context.Context, params api.DeleteMyTokenParams) (api.DeleteMyTokenRes, error) {
+	if err := h.repo.DeleteAPIToken(ctx, getUserID(ctx), params.TokenId); err != nil {
+		if errors.Is(err, repository.ErrAPITokenNotFound) {
+			return &api.DeleteMyTokenNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("delete api token in repository: %w", err)
+	}
+
+	return &api.DeleteMyTokenNoContent{}, nil
+}
+
+func toAPIToken(token *repository.APIToken) api.APIToken {
+	//nolint:exhaustruct
+	apiToken := api.APIToken{
+		ID:        token.ID,
+		Name:      token.Name,
+		Scope:     api.APITokenScope(token.Scope),
+		ExpiresAt: token.ExpiresAt,
+		CreatedAt: token.CreatedAt,
+	}
+	if token.LastUsedAt.Valid {
+		apiToken.LastUsedAt = api.NewOptDateTime(token.LastUsedAt.Time)
+	}
+
+	return apiToken
+}
@@ -0,0 +1,80 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/traP-jp/anshin-techo-backend/internal/api"
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/censor"
+)
+
+// GET /tickets/trash
+// 本職のみ
+func (h *Handler) GetTrashedTickets(ctx context.Context) (api.GetTrashedTicketsRes, error) {
+	userID := getUserID(ctx)
+	role := getUserRole(ctx)
+
+	cfg, err := h.repo.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get config from repository: %w", err)
+	}
+
+	tickets, err := h.repo.GetTrashedTickets(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get trashed tickets from repository: %w", err)
+	}
+
+	res := make(api.GetTrashedTicketsOKApplicationJSON, 0, len(tickets))
+	for _, ticket := range tickets {
+		//nolint:exhaustruct
+		trashed := api.TrashedTicket{
+			Ticket:    toAPITicket(ctx, ticket, censorViewer(censor.Policy{}, userID, role, ticket)),
+			DeletedAt: ticket.DeletedAt.Time,
+		}
+		if cfg.TrashRetentionDays > 0 {
+			trashed.PurgeAt = api.NewOptDateTime(ticket.DeletedAt.Time.AddDate(0, 0, cfg.TrashRetentionDays))
+		}
+		res = append(res, trashed)
+	}
+
+	return &res, nil
+}
+
+// POST /tickets/{ticketId}/restore
+// 本職のみ
+func (h *Handler) RestoreTicket(ctx context.Context, params api.RestoreTicketParams) (api.RestoreTicketRes, error) {
+	restorer := getUserID(ctx)
+	role := getUserRole(ctx)
+
+	if err := h.repo.RestoreTicket(ctx, params.TicketId, restorer); err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return &api.RestoreTicketNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("restore ticket in repository: %w", err)
+	}
+
+	ticket, err := h.repo.GetTicketByID(ctx, params.TicketId)
+	if err != nil {
+		return nil, fmt.Errorf("get restored ticket from repository: %w", err)
+	}
+	res := toAPITicket(ctx, ticket, censorViewer(censor.Policy{}, restorer, role, ticket))
+
+	return &res, nil
+}
+
+// DELETE /tickets/{ticketId}/purge
+// 本職のみ
+func (h *Handler) PurgeTicket(ctx context.Context, params api.PurgeTicketParams) (api.PurgeTicketRes, error) {
+	if err := h.repo.PurgeTicket(ctx, params.TicketId); err != nil {
+		if errors.Is(err, repository.ErrTicketNotFound) {
+			return &api.PurgeTicketNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("purge ticket in repository: %w", err)
+	}
+
+	return &api.PurgeTicketNoContent{}, nil
+}
@@ -2,10 +2,13 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/traP-jp/anshin-techo-backend/internal/api"
 	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/userdir"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/usersync"
 )
 
 // GET /users
@@ -26,7 +29,50 @@ func (h *Handler) UsersGet(ctx context.Context) (api.UsersGetRes, error) {
 	return &res, nil
 }
 
+// GET /users/{traqId}
+func (h *Handler) GetUser(ctx context.Context, params api.GetUserParams) (api.GetUserRes, error) {
+	profile, err := h.users.ByTraqID(ctx, params.TraqId)
+	if err != nil {
+		if errors.Is(err, userdir.ErrUserNotFound) {
+			return &api.GetUserNotFound{}, nil
+		}
+
+		return nil, fmt.Errorf("get traq user from directory: %w", err)
+	}
+
+	role, err := h.repo.GetUserRoleByTraqID(ctx, params.TraqId)
+	if err != nil {
+		return nil, fmt.Errorf("get user role from repository: %w", err)
+	}
+
+	return &api.UserProfile{
+		TraqID:      profile.TraqID,
+		UserID:      profile.ID,
+		DisplayName: profile.DisplayName,
+		IconFileID:  profile.IconFileID,
+		Bot:         profile.Bot,
+		Suspended:   profile.Suspended,
+		Role:        api.NilString{Value: role, Null: role == ""},
+	}, nil
+}
+
+// POST /users
+// 本職のみ (本職が登録されていない初期設定時は誰でも)
+func (h *Handler) CreateUser(ctx context.Context, req *api.User) (api.CreateUserRes, error) {
+	user := &repository.User{TraqID: req.TraqID, Role: string(req.Role)}
+	if _, err := h.repo.CreateUser(ctx, getUserID(ctx), user); err != nil {
+		if errors.Is(err, repository.ErrUserAlreadyExists) {
+			return &api.CreateUserConflict{}, nil
+		}
+
+		return nil, fmt.Errorf("create user in repository: %w", err)
+	}
+
+	return req, nil
+}
+
 // PUT /users
+// 本職のみ (本職が登録されていない初期設定時は誰でも)
 func (h *Handler) UsersPut(ctx context.Context, req []api.User) (api.UsersPutRes, error) {
 	// Empty request means sync to empty set; proceed.
 	repoUsers := make([]*repository.User, 0, len(req))
@@ -37,11 +83,117 @@ func (h *Handler) UsersPut(ctx context.Context, req []api.User) (api.UsersPutRes
 		})
 	}
 
-	if err := h.repo.UpdateUsers(ctx, repoUsers); err != nil {
+	changes, err := h.repo.SyncUsers(ctx, getUserID(ctx), repoUsers)
+	if err != nil {
+		if errors.Is(err, repository.ErrNoManager) {
+			return &api.UsersPutConflict{}, nil
+		}
+
 		return nil, fmt.Errorf("sync users to repository: %w", err)
 	}
 
-	return &api.UsersPutOK{}, nil
+	res := make(api.UsersPutOKApplicationJSON, 0, len(changes))
+	for _, change := range changes {
+		res = append(res, toAPIRoleChange(change))
+	}
+
+	return &res, nil
+}
+
+// POST /users/sync
+// 本職のみ (本職が登録されていない初期設定時は誰でも)
+func (h *Handler) SyncUsers(ctx context.Context, params api.SyncUsersParams) (api.SyncUsersRes, error) {
+	dryRun := params.DryRun.Or(false)
+	changes, err := h.userSync.RunOnce(ctx, getUserID(ctx), dryRun)
+	if err != nil {
+		if errors.Is(err, usersync.ErrNotConfigured) {
+			return &api.SyncUsersBadRequest{}, nil
+		}
+		if errors.Is(err, usersync.ErrNoManager) || errors.Is(err, repository.ErrNoManager) {
+			return &api.SyncUsersConflict{}, nil
+		}
+
+		return nil, fmt.Errorf("sync users from traq groups: %w", err)
+	}
+
+	res := &api.UserSyncResult{
+		DryRun:  dryRun,
+		Changes: make([]api.UserChange, 0, len(changes)),
+	}
+	for _, change := range changes {
+		res.Changes = append(res.Changes, api.UserChange{
+			TraqID:     change.TraqID,
+			BeforeRole: api.NilString{Value: change.BeforeRole.String, Null: !change.BeforeRole.Valid},
+			AfterRole:  api.NilString{Value: change.AfterRole.String, Null: !change.AfterRole.Valid},
+		})
+	}
+
+	return res, nil
+}
+
+// PATCH /users/{traqId}
+// 本職のみ (本職が登録されていない初期設定時は誰でも)
+func (h *Handler) UpdateUser(ctx context.Context, req *api.UpdateUserReq, params api.UpdateUserParams) (api.UpdateUserRes, error) {
+	user := &repository.User{TraqID: params.TraqId, Role: string(req.Role)}
+	if _, err := h.repo.UpdateUserRole(ctx, getUserID(ctx), user); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return &api.UpdateUserNotFound{}, nil
+		}
+		if errors.Is(err, repository.ErrNoManager) {
+			return &api.UpdateUserConflict{}, nil
+		}
+
+		return nil, fmt.Errorf("update user role in repository: %w", err)
+	}
+
+	return &api.User{TraqID: user.TraqID, Role: req.Role}, nil
+}
+
+// DELETE /users/{traqId}
+// 本職のみ (本職が登録されていない初期設定時は誰でも)
+func (h *Handler) DeleteUser(ctx context.Context, params api.DeleteUserParams) (api.DeleteUserRes, error) {
+	if err := h.repo.DeleteUser(ctx, getUserID(ctx), params.TraqId); err != nil {
+		if errors.Is(err, repository.ErrUserNotFound) {
+			return &api.DeleteUserNotFound{}, nil
+		}
+		if errors.Is(err, repository.ErrNoManager) {
+			return &api.DeleteUserConflict{}, nil
+		}
+
+		return nil, fmt.Errorf("delete user in repository: %w", err)
+	}
+
+	return &api.DeleteUserNoContent{}, nil
+}
+
+// GET /audit/role-changes
+// 本職のみ
+func (h *Handler) GetRoleChanges(ctx context.Context, params api.GetRoleChangesParams) (api.GetRoleChangesRes, error) {
+	changes, err := h.repo.GetRoleChanges(ctx, repository.GetRoleChangesParams{
+		TraqID: params.TraqID.Or(""),
+		Limit:  params.Limit.Or(repository.DefaultRoleChangesLimit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get role changes from repository: %w", err)
+	}
+
+	res := make(api.GetRoleChangesOKApplicationJSON, 0, len(changes))
+	for _, change := range changes {
+		res = append(res, toAPIRoleChange(change))
+	}
+
+	return &res, nil
+}
+
+func toAPIRoleChange(change *repository.RoleChange) api.RoleChange {
+	return api.RoleChange{
+		ID:         change.ID,
+		TraqID:     change.TraqID,
+		Actor:      change.Actor,
+		BeforeRole: api.NilString{Value: change.BeforeRole.String, Null: !change.BeforeRole.Valid},
+		AfterRole:  api.NilString{Value: change.AfterRole.String, Null: !change.AfterRole.Valid},
+		CreatedAt:  change.CreatedAt,
+	}
 }
 
 // GET /me
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+var ErrAPITokenNotFound = fmt.Errorf("api token not found")
+
+type (
+	// APIToken : 個人用APIトークン (トークンそのものは保存しない)
+	APIToken struct {
+		ID         int64        `db:"id"`
+		TraqID     string       `db:"traq_id"`
+		Name       string       `db:"name"`
+		Scope      string       `db:"scope"`
+		ExpiresAt  time.Time    `db:"expires_at"`
+		LastUsedAt sql.NullTime `db:"last_used_at"`
+		CreatedAt  time.Time    `db:"created_at"`
+	}
+
+	CreateAPITokenParams struct {
+		TraqID    string
+		Name      string
+		TokenHash string
+		Scope     string
+		ExpiresAt time.Time
+	}
+)
+
+// CreateAPIToken : 個人用APIトークンを作成する (トークンはハッシュ化して渡す)
+func (r *Repository) CreateAPIToken(ctx context.Context, params CreateAPITokenParams) (*APIToken, error) {
+	res, err := r.db.ExecContext(ctx, `
+		INSERT INTO api_tokens (traq_id, name, token_hash, scope, expires_at) VALUES (?, ?, ?, ?, ?)
+	`, params.TraqID, params.Name, params.TokenHash, params.Scope, params.ExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("insert api token: %w", err)
+	}
+	id, err := res.LastInsertId()
+	if err != nil {
+		return nil, fmt.Errorf("get last insert id: %w", err)
+	}
+
+	token := &APIToken{}
+	if err := r.db.GetContext(ctx, token, `
+		SELECT id, traq_id, name, scope, expires_at, last_used_at, created_at FROM api_tokens WHERE id = ?
+	`, id); err != nil {
+		return nil, fmt.Errorf("select api token: %w", err)
+	}
+
+	return token, nil
+}
+
+// GetAPITokens : ユーザーの個人用APIトークンを新しい順に取得 (期限切れのものも含む)
+func (r *Repository) GetAPITokens(ctx context.Context, traqID string) ([]*APIToken, error) {
+	tokens := []*APIToken{}
+	if err := r.db.SelectContext(ctx, &tokens, `
+		SELECT id, traq_id, name, scope, expires_at, last_used_at, created_at
+		FROM api_tokens WHERE traq_id = ? ORDER BY created_at DESC, id DESC
+	`, traqID); err != nil {
+		return nil, fmt.Errorf("select api tokens: %w", err)
+	}
+
+	return tokens, nil
+}
+
+// UseAPIToken : 有効期限内の個人用APIトークンを取得し、最終使用日時を更新する
+func (r *Repository) UseAPIToken(ctx context.Context, tokenHash string) (*APIToken, error) {
+	token := &APIToken{}
+	if err := r.db.GetContext(ctx, token, `
+		SELECT id, traq_id, name, scope, expires_at, last_used_at, created_at FROM api_tokens WHERE token_hash = ?
+	`, tokenHash); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrAPITokenNotFound
+		}
+
+		return nil, fmt.Errorf("select api token: %w", err)
+	}
+
+	now := time.Now()
+	if !token.ExpiresAt.After(now) {
+		return nil, ErrAPITokenNotFound
+	}
+
+	if _, err := r.db.ExecContext(ctx, `UPDATE api_tokens SET last_used_at = ? WHERE id = ?`, now, token.ID); err != nil {
+		return nil, fmt.Errorf("update api token last used at: %w", err)
+	}
+	token.LastUsedAt = sql.NullTime{Time: now, Valid: true}
+
+	return token, nil
+}
+
+// DeleteAPIToken : ユーザーの個人用APIトークンを削除する
+func (r *Repository) DeleteAPIToken(ctx context.Context, traqID string, id int64) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM api_tokens WHERE id = ? AND traq_id = ?`, id, traqID)
+	if err != nil {
+		return fmt.Errorf("delete api token: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return ErrAPITokenNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,224 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/authz"
+)
+
+type (
+	// ApprovalMaxWeights : ロールごとの承認の重みの上限
+	ApprovalMaxWeights struct {
+		Manager   int `json:"manager"`
+		Assistant int `json:"assistant"`
+		Member    int `json:"member"`
+	}
+
+	// ApprovalRule : ノートが送信待ちになる条件
+	ApprovalRule struct {
+		// Threshold : 必要な承認の重みの合計
+		Threshold  int                `json:"threshold"`
+		MaxWeights ApprovalMaxWeights `json:"max_weights"`
+		// RequireManagerApproval : true の場合は本職の承認が 1 件以上必要
+		RequireManagerApproval bool `json:"require_manager_approval"`
+		// BlockOnChangeRequests : true の場合は有効な修正依頼が残っている間は送信待ちにしない
+		BlockOnChangeRequests bool `json:"block_on_change_requests"`
+	}
+
+	ApprovalPolicyTagRule struct {
+		Tag  string       `json:"tag"`
+		Rule ApprovalRule `json:"rule"`
+	}
+
+	// ApprovalPolicy : ノートの承認のルール (タグごとに上書きできる)
+	ApprovalPolicy struct {
+		Default  ApprovalRule            `json:"default"`
+		TagRules []ApprovalPolicyTagRule `json:"tag_rules"`
+	}
+
+	// approvalReview : 承認の判定に用いる有効なレビュー
+	approvalReview struct {
+		Type   string `db:"type"`
+		Weight int    `db:"weight"`
+		Role   string `db:"role"`
+	}
+)
+
+var ErrInvalidApprovalPolicy = fmt.Errorf("invalid approval policy")
+
+// DefaultApprovalPolicy : 承認ポリシーが設定されていない場合のルール
+func DefaultApprovalPolicy() ApprovalPolicy {
+	return ApprovalPolicy{
+		Default: ApprovalRule{
+			Threshold:              5,
+			MaxWeights:             ApprovalMaxWeights{Manager: 5, Assistant: 4, Member: 0},
+			RequireManagerApproval: false,
+			BlockOnChangeRequests:  false,
+		},
+		TagRules: []ApprovalPolicyTagRule{},
+	}
+}
+
+// RuleFor : タグに応じたルールを返す
+// 複数のタグが該当する場合はルールの並び順で先のものを優先する
+func (p ApprovalPolicy) RuleFor(tags []string) ApprovalRule {
+	for _, rule := range p.TagRules {
+		for _, tag := range tags {
+			if rule.Tag == tag {
+				return rule.Rule
+			}
+		}
+	}
+
+	return p.Default
+}
+
+// Validate : 満たせないルールや重複したタグがあれば ErrInvalidApprovalPolicy を返す
+func (p ApprovalPolicy) Validate() error {
+	if err := p.Default.validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(p.TagRules))
+	for _, rule := range p.TagRules {
+		if rule.Tag == "" {
+			return fmt.Errorf("%w: empty tag", ErrInvalidApprovalPolicy)
+		}
+		if _, ok := seen[rule.Tag]; ok {
+			return fmt.Errorf("%w: duplicated tag %s", ErrInvalidApprovalPolicy, rule.Tag)
+		}
+		seen[rule.Tag] = struct{}{}
+
+		if err := rule.Rule.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r ApprovalRule) validate() error {
+	if r.Threshold < 1 {
+		return fmt.Errorf("%w: threshold must be positive", ErrInvalidApprovalPolicy)
+	}
+	if r.MaxWeights.Manager < 0 || r.MaxWeights.Assistant < 0 || r.MaxWeights.Member < 0 {
+		return fmt.Errorf("%w: max weights must not be negative", ErrInvalidApprovalPolicy)
+	}
+	if max(r.MaxWeights.Manager, r.MaxWeights.Assistant, r.MaxWeights.Member) == 0 {
+		return fmt.Errorf("%w: no role can approve", ErrInvalidApprovalPolicy)
+	}
+	if r.RequireManagerApproval && r.MaxWeights.Manager == 0 {
+		return fmt.Errorf("%w: managers cannot approve", ErrInvalidApprovalPolicy)
+	}
+
+	return nil
+}
+
+// MaxWeight : ロールの承認の重みの上限を返す
+func (r ApprovalRule) MaxWeight(role string) int {
+	switch role {
+	case authz.RoleManager:
+		return r.MaxWeights.Manager
+	case authz.RoleAssistant:
+		return r.MaxWeights.Assistant
+	default:
+		return r.MaxWeights.Member
+	}
+}
+
+// approved : 有効なレビューがルールを満たしているか
+func (r ApprovalRule) approved(reviews []approvalReview) bool {
+	totalWeight := 0
+	managerApproved := false
+	for _, review := range reviews {
+		switch review.Type {
+		case "approve":
+			totalWeight += review.Weight
+			if review.Role == authz.RoleManager {
+				managerApproved = true
+			}
+		case "cr":
+			if r.BlockOnChangeRequests {
+				return false
+			}
+		}
+	}
+
+	if r.RequireManagerApproval && !managerApproved {
+		return false
+	}
+
+	return totalWeight >= r.Threshold
+}
+
+func unmarshalApprovalPolicy(data []byte) (ApprovalPolicy, error) {
+	policy := DefaultApprovalPolicy()
+	if len(data) == 0 {
+		return policy, nil
+	}
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return ApprovalPolicy{}, fmt.Errorf("unmarshal approval_policy: %w", err)
+	}
+	if policy.TagRules == nil {
+		policy.TagRules = []ApprovalPolicyTagRule{}
+	}
+
+	return policy, nil
+}
+
+func getApprovalPolicy(ctx context.Context, q sqlx.QueryerContext) (ApprovalPolicy, error) {
+	var data []byte
+	if err := sqlx.GetContext(ctx, q, &data, `SELECT approval_policy FROM configs WHERE id = 1`); err != nil {
+		if err == sql.ErrNoRows {
+			return unmarshalApprovalPolicy(nil)
+		}
+
+		return ApprovalPolicy{}, fmt.Errorf("select approval_policy: %w", err)
+	}
+
+	return unmarshalApprovalPolicy(data)
+}
+
+// approvalRuleForNote : ノートのチケットのタグに応じた承認のルールを返す
+func approvalRuleForNote(ctx context.Context, tx *sqlx.Tx, noteID int64) (ApprovalRule, error) {
+	policy, err := getApprovalPolicy(ctx, tx)
+	if err != nil {
+		return ApprovalRule{}, err
+	}
+
+	var tags []string
+	if err := tx.SelectContext(ctx, &tags, `
+		SELECT tt.tag FROM ticket_tags tt JOIN notes n ON tt.ticket_id = n.ticket_id WHERE n.id = ?
+	`, noteID); err != nil {
+		return ApprovalRule{}, fmt.Errorf("select ticket tags: %w", err)
+	}
+
+	return policy.RuleFor(tags), nil
+}
+
+// noteApproved : ノートの有効なレビューが承認のルールとレビュー依頼の条件を満たしているか
+func noteApproved(ctx context.Context, tx *sqlx.Tx, noteID int64) (bool, error) {
+	rule, err := approvalRuleForNote(ctx, tx, noteID)
+	if err != nil {
+		return false, err
+	}
+
+	var reviews []approvalReview
+	if err := tx.SelectContext(ctx, &reviews, `
+		SELECT r.type, r.weight, COALESCE(u.role, 'member') AS role
+		FROM reviews r
+		LEFT JOIN users u ON r.author = u.traq_id
+		WHERE r.note_id = ? AND r.status = 'active' AND r.deleted_at IS NULL
+	`, noteID); err != nil {
+		return false, fmt.Errorf("select active reviews: %w", err)
+	}
+	if !rule.approved(reviews) {
+		return false, nil
+	}
+
+	return requestedReviewsApproved(ctx, tx, noteID)
+}
@@ -0,0 +1,111 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+
+	"github.com/traP-jp/anshin-techo-backend/internal/service/censor"
+)
+
+// CensorPolicyRule : 伏字のカテゴリを閲覧できるユーザー
+type CensorPolicyRule struct {
+	Category  string
+	Roles     []string
+	Assignees bool
+}
+
+var ErrInvalidCensorPolicy = fmt.Errorf("invalid censor policy")
+
+// GetCensorPolicyRules : 伏字のカテゴリごとの閲覧ルールをカテゴリ名順に取得
+func (r *Repository) GetCensorPolicyRules(ctx context.Context) ([]*CensorPolicyRule, error) {
+	var rows []struct {
+		Category  string `db:"category"`
+		Roles     []byte `db:"roles"`
+		Assignees bool   `db:"assignees"`
+	}
+	if err := r.db.SelectContext(ctx, &rows, `SELECT category, roles, assignees FROM censor_policies ORDER BY category`); err != nil {
+		return nil, fmt.Errorf("select censor policies: %w", err)
+	}
+
+	rules := make([]*CensorPolicyRule, 0, len(rows))
+	for _, row := range rows {
+		roles := []string{}
+		if err := json.Unmarshal(row.Roles, &roles); err != nil {
+			return nil, fmt.Errorf("unmarshal roles: %w", err)
+		}
+		rules = append(rules, &CensorPolicyRule{
+			Category:  row.Category,
+			Roles:     roles,
+			Assignees: row.Assignees,
+		})
+	}
+
+	return rules, nil
+}
+
+// GetCensorPolicy : 伏字の閲覧判定に使うポリシーを取得
+func (r *Repository) GetCensorPolicy(ctx context.Context) (censor.Policy, error) {
+	rules, err := r.GetCensorPolicyRules(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := make(censor.Policy, len(rules))
+	for _, rule := range rules {
+		policy[rule.Category] = censor.Rule{Roles: rule.Roles, Assignees: rule.Assignees}
+	}
+
+	return policy, nil
+}
+
+// ReplaceCensorPolicyRules : 伏字のカテゴリごとの閲覧ルールをすべて置き換える
+func (r *Repository) ReplaceCensorPolicyRules(ctx context.Context, rules []*CensorPolicyRule) error {
+	seen := make(map[string]struct{}, len(rules))
+	for _, rule := range rules {
+		if !censor.CategoryPattern.MatchString(rule.Category) {
+			return fmt.Errorf("%w: invalid category %q", ErrInvalidCensorPolicy, rule.Category)
+		}
+		if _, ok := seen[rule.Category]; ok {
+			return fmt.Errorf("%w: duplicate category %q", ErrInvalidCensorPolicy, rule.Category)
+		}
+		seen[rule.Category] = struct{}{}
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			fmt.Printf("failed to rollback: %v\n", err)
+		}
+	}()
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM censor_policies`); err != nil {
+		return fmt.Errorf("delete censor policies: %w", err)
+	}
+
+	for _, rule := range rules {
+		roles := rule.Roles
+		if roles == nil {
+			roles = []string{}
+		}
+		rolesJSON, err := json.Marshal(roles)
+		if err != nil {
+			return fmt.Errorf("marshal roles: %w", err)
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO censor_policies (category, roles, assignees) VALUES (?, ?, ?)
+		`, rule.Category, rolesJSON, rule.Assignees); err != nil {
+			return fmt.Errorf("insert censor policy: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -15,18 +15,28 @@ type ConfigReminderInterval struct {
 type Config struct {
 	ReminderInterval ConfigReminderInterval
 	RevisePrompt     string `db:"revise_prompt"`
+	DuePolicy        DuePolicy
+	ApprovalPolicy   ApprovalPolicy
+	// TrashRetentionDays : 削除したチケットを完全に削除するまでの日数 (0 の場合は自動で削除しない)
+	TrashRetentionDays int `db:"trash_retention_days"`
+	// PIIAutoCensor : true の場合は検出した個人情報を自動で伏字にする (false の場合は警告のみ)
+	PIIAutoCensor bool `db:"pii_auto_censor"`
 }
 
 var ErrConfigNotFound = fmt.Errorf("config not found")
 
 func (r *Repository) GetConfig(ctx context.Context) (*Config, error) {
 	var row struct {
-		RevisePrompt string `db:"revise_prompt"`
-		NotesentHour int    `db:"notesent_hour"`
-		OverdueDay   []byte `db:"overdue_day"`
+		RevisePrompt       string `db:"revise_prompt"`
+		NotesentHour       int    `db:"notesent_hour"`
+		OverdueDay         []byte `db:"overdue_day"`
+		DuePolicy          []byte `db:"due_policy"`
+		ApprovalPolicy     []byte `db:"approval_policy"`
+		TrashRetentionDays int    `db:"trash_retention_days"`
+		PIIAutoCensor      bool   `db:"pii_auto_censor"`
 	}
 
-	if err := r.db.GetContext(ctx, &row, `SELECT revise_prompt, notesent_hour, overdue_day FROM configs WHERE id = 1`); err != nil {
+	if err := r.db.GetContext(ctx, &row, `SELECT revise_prompt, notesent_hour, overdue_day, due_policy, approval_policy, trash_retention_days, pii_auto_censor FROM configs WHERE id = 1`); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, ErrConfigNotFound
 		}
@@ -41,12 +51,26 @@ func (r *Repository) GetConfig(ctx context.Context) (*Config, error) {
 		}
 	}
 
+	duePolicy, err := unmarshalDuePolicy(row.DuePolicy)
+	if err != nil {
+		return nil, err
+	}
+
+	approvalPolicy, err := unmarshalApprovalPolicy(row.ApprovalPolicy)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Config{
 		ReminderInterval: ConfigReminderInterval{
 			OverdueDay:   overdueDay,
 			NotesentHour: row.NotesentHour,
 		},
-		RevisePrompt: row.RevisePrompt,
+		RevisePrompt:       row.RevisePrompt,
+		DuePolicy:          duePolicy,
+		ApprovalPolicy:     approvalPolicy,
+		TrashRetentionDays: row.TrashRetentionDays,
+		PIIAutoCensor:      row.PIIAutoCensor,
 	}, nil
 }
 
@@ -60,14 +84,36 @@ func (r *Repository) UpsertConfig(ctx context.Context, cfg Config) error {
 		return fmt.Errorf("marshal overdue_day: %w", err)
 	}
 
+	if cfg.DuePolicy.TagBusinessDays == nil {
+		cfg.DuePolicy.TagBusinessDays = []DuePolicyTagRule{}
+	}
+
+	duePolicyJSON, err := json.Marshal(cfg.DuePolicy)
+	if err != nil {
+		return fmt.Errorf("marshal due_policy: %w", err)
+	}
+
+	if cfg.ApprovalPolicy.TagRules == nil {
+		cfg.ApprovalPolicy.TagRules = []ApprovalPolicyTagRule{}
+	}
+
+	approvalPolicyJSON, err := json.Marshal(cfg.ApprovalPolicy)
+	if err != nil {
+		return fmt.Errorf("marshal approval_policy: %w", err)
+	}
+
 	if _, err := r.db.ExecContext(ctx, `
-        INSERT INTO configs (id, revise_prompt, notesent_hour, overdue_day)
-        VALUES (1, ?, ?, ?)
+        INSERT INTO configs (id, revise_prompt, notesent_hour, overdue_day, due_policy, approval_policy, trash_retention_days, pii_auto_censor)
+        VALUES (1, ?, ?, ?, ?, ?, ?, ?)
         ON DUPLICATE KEY UPDATE
             revise_prompt = VALUES(revise_prompt),
             notesent_hour = VALUES(notesent_hour),
-            overdue_day = VALUES(overdue_day)
-    `, cfg.RevisePrompt, cfg.ReminderInterval.NotesentHour, overdueJSON); err != nil {
+            overdue_day = VALUES(overdue_day),
+            due_policy = VALUES(due_policy),
+            approval_policy = VALUES(approval_policy),
+            trash_retention_days = VALUES(trash_retention_days),
+            pii_auto_censor = VALUES(pii_auto_censor)
+    `, cfg.RevisePrompt, cfg.ReminderInterval.NotesentHour, overdueJSON, duePolicyJSON, approvalPolicyJSON, cfg.TrashRetentionDays, cfg.PIIAutoCensor); err != nil {
 		return fmt.Errorf("upsert config: %w", err)
 	}
 
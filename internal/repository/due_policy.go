@@ -0,0 +1,118 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+type (
+	DuePolicyTagRule struct {
+		Tag          string `json:"tag"`
+		BusinessDays int    `json:"business_days"`
+	}
+
+	// DuePolicy : 期日未指定のチケットに設定する期日のルール
+	DuePolicy struct {
+		DefaultBusinessDays int                `json:"default_business_days"`
+		TagBusinessDays     []DuePolicyTagRule `json:"tag_business_days"`
+	}
+)
+
+// JST : 期日の計算・判定に用いるタイムゾーン
+var JST = time.FixedZone("Asia/Tokyo", 9*60*60)
+
+// closedTicketStatuses : 対応が終了しているチケットのステータス
+var closedTicketStatuses = map[string]struct{}{
+	"completed": {},
+	"forgotten": {},
+}
+
+func isClosedTicketStatus(status string) bool {
+	_, ok := closedTicketStatuses[status]
+
+	return ok
+}
+
+// BusinessDaysFor : タグに応じた営業日数を返す
+// 複数のタグが該当する場合はルールの並び順で先のものを優先する
+func (p DuePolicy) BusinessDaysFor(tags []string) int {
+	for _, rule := range p.TagBusinessDays {
+		for _, tag := range tags {
+			if rule.Tag == tag {
+				return rule.BusinessDays
+			}
+		}
+	}
+
+	return p.DefaultBusinessDays
+}
+
+// DueFrom : from から起算した期日を返す
+// 営業日数が 0 の場合は期日を設定しない
+func (p DuePolicy) DueFrom(from time.Time, tags []string) sql.NullTime {
+	days := p.BusinessDaysFor(tags)
+	if days <= 0 {
+		return sql.NullTime{Time: time.Time{}, Valid: false}
+	}
+
+	return sql.NullTime{Time: addBusinessDays(from, days), Valid: true}
+}
+
+// addBusinessDays : 土日を除いて days 営業日後の日付を返す (祝日は考慮しない)
+func addBusinessDays(from time.Time, days int) time.Time {
+	from = from.In(JST)
+	date := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	for days > 0 {
+		date = date.AddDate(0, 0, 1)
+		if date.Weekday() == time.Saturday || date.Weekday() == time.Sunday {
+			continue
+		}
+		days--
+	}
+
+	return date
+}
+
+func unmarshalDuePolicy(data []byte) (DuePolicy, error) {
+	policy := DuePolicy{DefaultBusinessDays: 0, TagBusinessDays: []DuePolicyTagRule{}}
+	if len(data) == 0 {
+		return policy, nil
+	}
+	if err := json.Unmarshal(data, &policy); err != nil {
+		return DuePolicy{}, fmt.Errorf("unmarshal due_policy: %w", err)
+	}
+	if policy.TagBusinessDays == nil {
+		policy.TagBusinessDays = []DuePolicyTagRule{}
+	}
+
+	return policy, nil
+}
+
+func getDuePolicy(ctx context.Context, q sqlx.QueryerContext) (DuePolicy, error) {
+	var data []byte
+	if err := sqlx.GetContext(ctx, q, &data, `SELECT due_policy FROM configs WHERE id = 1`); err != nil {
+		if err == sql.ErrNoRows {
+			return unmarshalDuePolicy(nil)
+		}
+
+		return DuePolicy{}, fmt.Errorf("select due_policy: %w", err)
+	}
+
+	return unmarshalDuePolicy(data)
+}
+
+func sameDate(a, b sql.NullTime) bool {
+	if a.Valid != b.Valid {
+		return false
+	}
+	if !a.Valid {
+		return true
+	}
+
+	return a.Time.Format(time.DateOnly) == b.Time.Format(time.DateOnly)
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var ErrNoteRevisionNotFound = fmt.Errorf("note revision not found")
+
+// NoteRevision : ノートの本文の版
+type NoteRevision struct {
+	ID     int64 `db:"id"`
+	NoteID int64 `db:"note_id"`
+	// Revision : ノートごとに 1 から振る通し番号
+	Revision  int64     `db:"revision"`
+	Author    string    `db:"author"`
+	Content   string    `db:"content"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+// insertNoteRevision : ノートの本文の新しい版を追加する (ノートの行をロックした状態で呼び出す)
+func insertNoteRevision(ctx context.Context, tx *sqlx.Tx, noteID int64, author, content string) error {
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO note_revisions (note_id, revision, author, content)
+		SELECT ?, COALESCE(MAX(revision), 0) + 1, ?, ? FROM note_revisions WHERE note_id = ?
+	`, noteID, author, content, noteID); err != nil {
+		return fmt.Errorf("insert note revision: %w", err)
+	}
+
+	return nil
+}
+
+// latestNoteRevisionID : ノートの最新の版の ID を返す (版が記録されていない場合は NULL)
+func latestNoteRevisionID(ctx context.Context, tx *sqlx.Tx, noteID int64) (sql.NullInt64, error) {
+	var id sql.NullInt64
+	if err := tx.GetContext(ctx, &id, `
+		SELECT MAX(id) FROM note_revisions WHERE note_id = ?
+	`, noteID); err != nil {
+		return sql.NullInt64{}, fmt.Errorf("select latest note revision: %w", err)
+	}
+
+	return id, nil
+}
+
+// GetNoteRevisions : ノートの本文の版を古い順に返す
+func (r *Repository) GetNoteRevisions(ctx context.Context, ticketID, noteID int64) ([]*NoteRevision, error) {
+	revisions := []*NoteRevision{}
+	if err := r.db.SelectContext(ctx, &revisions, `
+		SELECT nr.id, nr.note_id, nr.revision, nr.author, nr.content, nr.created_at
+		FROM note_revisions nr
+		JOIN notes n ON nr.note_id = n.id
+		WHERE nr.note_id = ? AND n.ticket_id = ? AND n.deleted_at IS NULL
+		ORDER BY nr.revision
+	`, noteID, ticketID); err != nil {
+		return nil, fmt.Errorf("select note revisions: %w", err)
+	}
+
+	return revisions, nil
+}
+
+// GetLatestNoteRevision : ノートの最新の版を取得する
+func (r *Repository) GetLatestNoteRevision(ctx context.Context, ticketID, noteID int64) (*NoteRevision, error) {
+	//nolint:exhaustruct
+	rev := &NoteRevision{}
+	if err := r.db.GetContext(ctx, rev, `
+		SELECT nr.id, nr.note_id, nr.revision, nr.author, nr.content, nr.created_at
+		FROM note_revisions nr
+		JOIN notes n ON nr.note_id = n.id
+		WHERE nr.note_id = ? AND n.ticket_id = ? AND n.deleted_at IS NULL
+		ORDER BY nr.revision DESC
+		LIMIT 1
+	`, noteID, ticketID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoteRevisionNotFound
+		}
+
+		return nil, fmt.Errorf("select latest note revision: %w", err)
+	}
+
+	return rev, nil
+}
+
+// GetNoteRevision : ノートの本文の版を通し番号で取得する
+func (r *Repository) GetNoteRevision(ctx context.Context, ticketID, noteID, revision int64) (*NoteRevision, error) {
+	//nolint:exhaustruct
+	rev := &NoteRevision{}
+	if err := r.db.GetContext(ctx, rev, `
+		SELECT nr.id, nr.note_id, nr.revision, nr.author, nr.content, nr.created_at
+		FROM note_revisions nr
+		JOIN notes n ON nr.note_id = n.id
+		WHERE nr.note_id = ? AND nr.revision = ? AND n.ticket_id = ? AND n.deleted_at IS NULL
+	`, noteID, revision, ticketID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoteRevisionNotFound
+		}
+
+		return nil, fmt.Errorf("select note revision: %w", err)
+	}
+
+	return rev, nil
+}
@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	NoteActionSubmit   = "submit"
+	NoteActionWithdraw = "withdraw"
+	NoteActionSend     = "send"
+	NoteActionCancel   = "cancel"
+)
+
+// noteStatusAction : ノートのステータスを変更する操作
+type noteStatusAction struct {
+	from []string
+	to   string
+	// outgoingOnly : 発信ノートのみ実行できる
+	outgoingOnly bool
+	// timestamps : ステータスとともに更新する日時 (UPDATE の SET 句に追加する)
+	timestamps string
+	// notifyReviewers : レビューを依頼したユーザーに通知するか (false の場合は関係者に通知する)
+	notifyReviewers bool
+	// title, verb : 通知の見出しと本文の動詞
+	title string
+	verb  string
+}
+
+// noteStatusActions : ノートのステータス遷移
+// 送信待ちへの遷移はレビューの承認によってのみ行われる
+var noteStatusActions = map[string]noteStatusAction{
+	NoteActionSubmit: {
+		from:            []string{"draft"},
+		to:              "waiting_review",
+		outgoingOnly:    true,
+		timestamps:      "submitted_at = CURRENT_TIMESTAMP",
+		notifyReviewers: true,
+		title:           "ノートがレビューに出されました",
+		verb:            "レビューに出しました",
+	},
+	NoteActionWithdraw: {
+		from:            []string{"waiting_review", "waiting_sent"},
+		to:              "draft",
+		outgoingOnly:    true,
+		timestamps:      "submitted_at = NULL",
+		notifyReviewers: true,
+		title:           "ノートのレビューが取り下げられました",
+		verb:            "下書きに戻しました",
+	},
+	NoteActionSend: {
+		from:            []string{"waiting_sent"},
+		to:              "sent",
+		outgoingOnly:    true,
+		timestamps:      "sent_at = CURRENT_TIMESTAMP",
+		notifyReviewers: false,
+		title:           "ノートが送信されました",
+		verb:            "送信済みにしました",
+	},
+	NoteActionCancel: {
+		from:            []string{"draft", "waiting_review", "waiting_sent"},
+		to:              "canceled",
+		outgoingOnly:    false,
+		timestamps:      "",
+		notifyReviewers: true,
+		title:           "ノートが破棄されました",
+		verb:            "破棄しました",
+	},
+}
+
+var ErrInvalidNoteTransition = fmt.Errorf("invalid note status transition")
+
+// TransitionNote : ノートのステータスを操作に応じて変更し、関係するユーザーに通知する
+// レビューに出したノートがすでに承認の条件を満たしている場合は送信待ちにする
+func (r *Repository) TransitionNote(ctx context.Context, ticketID, noteID int64, actor, action string) error {
+	def, ok := noteStatusActions[action]
+	if !ok {
+		return fmt.Errorf("%w: unknown action %s", ErrInvalidNoteTransition, action)
+	}
+
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			fmt.Printf("failed to rollback: %v\n", err)
+		}
+	}()
+
+	var current struct {
+		Type   string `db:"type"`
+		Status string `db:"status"`
+	}
+	if err := tx.GetContext(ctx, &current, `
+		SELECT type, status FROM notes WHERE id = ? AND ticket_id = ? AND deleted_at IS NULL FOR UPDATE
+	`, noteID, ticketID); err != nil {
+		if err == sql.ErrNoRows {
+			return ErrNoteNotFound
+		}
+
+		return fmt.Errorf("select note: %w", err)
+	}
+	if def.outgoingOnly && current.Type != "outgoing" {
+		return fmt.Errorf("%w: %s is not allowed for %s notes", ErrInvalidNoteTransition, action, current.Type)
+	}
+	if !slices.Contains(def.from, current.Status) {
+		return fmt.Errorf("%w: %s is not allowed from %s", ErrInvalidNoteTransition, action, current.Status)
+	}
+
+	query := `UPDATE notes SET status = ?, updated_at = CURRENT_TIMESTAMP`
+	if def.timestamps != "" {
+		query += ", " + def.timestamps
+	}
+	if _, err := tx.ExecContext(ctx, query+` WHERE id = ?`, def.to, noteID); err != nil {
+		return fmt.Errorf("update note status: %w", err)
+	}
+
+	if action == NoteActionSubmit {
+		if err := maybeUpdateNoteStatus(ctx, tx, noteID); err != nil {
+			return err
+		}
+	}
+
+	var status string
+	if err := tx.GetContext(ctx, &status, `SELECT status FROM notes WHERE id = ?`, noteID); err != nil {
+		return fmt.Errorf("select note status: %w", err)
+	}
+
+	changes := changeBuilder{}
+	changes.addString("status", current.Status, status)
+	if err := recordTicketEvent(ctx, tx, ticketID, actor, TicketEventNoteUpdated, noteTarget(noteID), changes); err != nil {
+		return err
+	}
+
+	if err := syncTicketStatus(ctx, tx, ticketID, actor, false); err != nil {
+		return err
+	}
+
+	recipients, err := noteActionRecipients(ctx, tx, ticketID, noteID, def.notifyReviewers)
+	if err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	message := fmt.Sprintf("## %s\n@%s がチケット(ID: %d)のノート(ID: %d)を%s", def.title, actor, ticketID, noteID, def.verb)
+	r.sendDirectMessages(ctx, ticketID, actor, recipients, message)
+
+	return nil
+}
+
+// noteActionRecipients : ノートの操作を通知するユーザー
+// チケットの担当者・副担当者に加えて、withReviewers が true の場合はレビューを依頼したユーザー、false の場合はチケットの関係者を返す
+func noteActionRecipients(ctx context.Context, tx *sqlx.Tx, ticketID, noteID int64, withReviewers bool) ([]string, error) {
+	var recipients []string
+	if err := tx.SelectContext(ctx, &recipients, `
+		SELECT assignee FROM tickets WHERE id = ?
+		UNION ALL
+		SELECT sub_assignee FROM ticket_sub_assignees WHERE ticket_id = ?
+	`, ticketID, ticketID); err != nil {
+		return nil, fmt.Errorf("select ticket assignees: %w", err)
+	}
+
+	var others []string
+	if withReviewers {
+		if err := tx.SelectContext(ctx, &others, `
+			SELECT assignee FROM note_review_assignees WHERE note_id = ? ORDER BY created_at, assignee
+		`, noteID); err != nil {
+			return nil, fmt.Errorf("select review requests: %w", err)
+		}
+	} else {
+		if err := tx.SelectContext(ctx, &others, `
+			SELECT stakeholder FROM ticket_stakeholders WHERE ticket_id = ?
+		`, ticketID); err != nil {
+			return nil, fmt.Errorf("select ticket stakeholders: %w", err)
+		}
+	}
+
+	return append(recipients, others...), nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"slices"
 	"time"
 
 	"github.com/jmoiron/sqlx"
@@ -19,14 +20,29 @@ type Note struct {
 	CreatedAt time.Time    `db:"created_at"`
 	UpdatedAt time.Time    `db:"updated_at"`
 	DeletedAt sql.NullTime `db:"deleted_at"`
+	// RequireRequestedReviews : 送信待ちになるためにレビューを依頼した全員の承認を必要とするか
+	RequireRequestedReviews bool `db:"require_requested_reviews"`
+	// SubmittedAt : 最後にレビューに出した日時 (下書きに戻すと NULL になる)
+	SubmittedAt sql.NullTime `db:"submitted_at"`
+	SentAt      sql.NullTime `db:"sent_at"`
 }
 
 func (r *Repository) CreateNote(ctx context.Context, ticketID int64, author, content, noteType string) (*Note, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			fmt.Printf("failed to rollback: %v\n", err)
+		}
+	}()
+
 	query := `
 		INSERT INTO notes (ticket_id, author, content, type, status)
 		VALUES (?, ?, ?, ?, 'draft')`
 
-	result, err := r.db.ExecContext(ctx, query, ticketID, author, content, noteType)
+	result, err := tx.ExecContext(ctx, query, ticketID, author, content, noteType)
 	if err != nil {
 		return nil, fmt.Errorf("insert note: %w", err)
 	}
@@ -36,6 +52,28 @@ func (r *Repository) CreateNote(ctx context.Context, ticketID int64, author, con
 		return nil, fmt.Errorf("get last insert id: %w", err)
 	}
 
+	if err := indexNote(ctx, tx, ticketID, id, content); err != nil {
+		return nil, err
+	}
+
+	if err := insertNoteRevision(ctx, tx, id, author, content); err != nil {
+		return nil, err
+	}
+
+	status := "draft"
+	changes := changeBuilder{}
+	changes.add("type", nil, &noteType)
+	changes.add("status", nil, &status)
+	changes.add("content", nil, &content)
+	if err := recordTicketEvent(ctx, tx, ticketID, author, TicketEventNoteCreated, noteTarget(id), changes); err != nil {
+		return nil, err
+	}
+
+	// 完了済みのチケットは受信ノートが追加された場合のみ再開する
+	if err := syncTicketStatus(ctx, tx, ticketID, author, noteType == "incoming"); err != nil {
+		return nil, err
+	}
+
 	note := &Note{
 		ID:        0,
 		TicketID:  0,
@@ -46,12 +84,20 @@ func (r *Repository) CreateNote(ctx context.Context, ticketID int64, author, con
 		CreatedAt: time.Time{},
 		UpdatedAt: time.Time{},
 		DeletedAt: sql.NullTime{Time: time.Time{}, Valid: false},
+
+		RequireRequestedReviews: false,
+		SubmittedAt:             sql.NullTime{Time: time.Time{}, Valid: false},
+		SentAt:                  sql.NullTime{Time: time.Time{}, Valid: false},
 	}
 	getQuery := `SELECT * FROM notes WHERE id = ?`
-	if err := r.db.GetContext(ctx, note, getQuery, id); err != nil {
+	if err := tx.GetContext(ctx, note, getQuery, id); err != nil {
 		return nil, fmt.Errorf("get created note: %w", err)
 	}
 
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
 	return note, nil
 }
 
@@ -71,9 +117,7 @@ func (r *Repository) GetReviewsByNoteIDs(ctx context.Context, ticketID int64, no
 		return []*Review{}, nil
 	}
 
-	query, args, err := sqlx.In(`
-		SELECT r.id, r.note_id, r.type, r.status, r.weight, r.author, r.comment, r.created_at, r.updated_at
-		FROM reviews r
+	query, args, err := sqlx.In(reviewSelectQuery+`
 		JOIN notes n ON r.note_id = n.id
 		WHERE r.note_id IN (?) AND n.ticket_id = ? AND r.deleted_at IS NULL AND n.deleted_at IS NULL
 		ORDER BY r.created_at ASC
@@ -92,42 +136,132 @@ func (r *Repository) GetReviewsByNoteIDs(ctx context.Context, ticketID int64, no
 	return reviews, nil
 }
 
-func (r *Repository) UpdateNote(ctx context.Context, ticketID, noteID int64, content string, status string) error {
-	query := `UPDATE notes SET content = ?, status = ?, updated_at = NOW() WHERE id = ? AND ticket_id = ?`
+// NoteNotEditableError : 送信済み・破棄済みのノートの本文は編集できない
+type NoteNotEditableError struct {
+	// Status : ノートの現在のステータス
+	Status string
+}
+
+func (e *NoteNotEditableError) Error() string {
+	return fmt.Sprintf("note is not editable in status %s", e.Status)
+}
 
-	result, err := r.db.ExecContext(ctx, query, content, status, noteID, ticketID)
+// editableNoteStatuses : 本文を編集できるノートのステータス (送信済み・破棄済みのノートは編集できない)
+var editableNoteStatuses = []string{"draft", "waiting_review", "waiting_sent"}
+
+// NoteEditable : ステータスのノートの本文を編集できるか
+func NoteEditable(status string) bool {
+	return slices.Contains(editableNoteStatuses, status)
+}
+
+// UpdateNote : ノートの本文を編集する (ステータスは TransitionNote で変更する)
+// 送信済み・破棄済みのノートの場合は NoteNotEditableError を返す
+func (r *Repository) UpdateNote(ctx context.Context, ticketID, noteID int64, updater, content string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			fmt.Printf("failed to rollback: %v\n", err)
+		}
+	}()
+
+	var current struct {
+		Content string `db:"content"`
+		Status  string `db:"status"`
+	}
+	if err := tx.GetContext(ctx, &current, `SELECT content, status FROM notes WHERE id = ? AND ticket_id = ? FOR UPDATE`, noteID, ticketID); err != nil {
 		return err
 	}
+	if !NoteEditable(current.Status) {
+		return &NoteNotEditableError{Status: current.Status}
+	}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
+	// 本文が変わった場合、古い本文へのレビューは承認の重みに数えない
+	status := current.Status
+	var staleReviewers []string
+	if content != current.Content {
+		staleReviewers, err = staleReviews(ctx, tx, ticketID, noteID, updater)
+		if err != nil {
+			return err
+		}
+		if status == "waiting_sent" {
+			status = "waiting_review"
+		}
+		if err := insertNoteRevision(ctx, tx, noteID, updater, content); err != nil {
+			return err
+		}
+	}
+
+	query := `UPDATE notes SET content = ?, status = ?, updated_at = NOW() WHERE id = ? AND ticket_id = ?`
+
+	if _, err := tx.ExecContext(ctx, query, content, status, noteID, ticketID); err != nil {
+		return err
+	}
+
+	if err := indexNote(ctx, tx, ticketID, noteID, content); err != nil {
+		return err
+	}
+
+	changes := changeBuilder{}
+	changes.addString("status", current.Status, status)
+	changes.addString("content", current.Content, content)
+	if len(changes) > 0 {
+		if err := recordTicketEvent(ctx, tx, ticketID, updater, TicketEventNoteUpdated, noteTarget(noteID), changes); err != nil {
+			return err
+		}
+	}
+
+	if err := syncTicketStatus(ctx, tx, ticketID, updater, false); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
 		return err
 	}
-	if rows == 0 {
-		return sql.ErrNoRows
+
+	if len(staleReviewers) > 0 {
+		message := fmt.Sprintf("## ノートが編集されたため、レビューが無効になりました\nチケット(ID: %d)のノート(ID: %d)の本文が変更されました。レビュー時からの差分を確認して、もう一度レビューしてください", ticketID, noteID)
+		r.sendDirectMessages(ctx, ticketID, updater, staleReviewers, message)
 	}
 
 	return nil
 }
 
-func (r *Repository) DeleteNote(ctx context.Context, ticketID, noteID int64) error {
+func (r *Repository) DeleteNote(ctx context.Context, ticketID, noteID int64, deleter string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			fmt.Printf("failed to rollback: %v\n", err)
+		}
+	}()
+
+	var content string
+	if err := tx.GetContext(ctx, &content, `SELECT content FROM notes WHERE id = ? AND ticket_id = ? FOR UPDATE`, noteID, ticketID); err != nil {
+		return err
+	}
+
 	query := `DELETE FROM notes WHERE id = ? AND ticket_id = ?`
 
-	result, err := r.db.ExecContext(ctx, query, noteID, ticketID)
-	if err != nil {
+	if _, err := tx.ExecContext(ctx, query, noteID, ticketID); err != nil {
 		return err
 	}
 
-	rows, err := result.RowsAffected()
-	if err != nil {
+	changes := changeBuilder{}
+	changes.add("content", &content, nil)
+	if err := recordTicketEvent(ctx, tx, ticketID, deleter, TicketEventNoteDeleted, noteTarget(noteID), changes); err != nil {
 		return err
 	}
-	if rows == 0 {
-		return sql.ErrNoRows
+
+	if err := syncTicketStatus(ctx, tx, ticketID, deleter, false); err != nil {
+		return err
 	}
 
-	return nil
+	return tx.Commit()
 }
 
 func (r *Repository) GetNoteByID(ctx context.Context, ticketID, noteID int64) (*Note, error) {
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/traP-jp/anshin-techo-backend/internal/service/authz"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/censor"
+)
+
+// mentions : traQ ID を traQ のメンション (@traQ ID) に変換して空白区切りで返す
+func mentions(traqIDs []string) string {
+	if len(traqIDs) == 0 {
+		return "なし"
+	}
+
+	parts := make([]string, 0, len(traqIDs))
+	for _, traqID := range traqIDs {
+		parts = append(parts, "@"+traqID)
+	}
+
+	return strings.Join(parts, " ")
+}
+
+// DirectMessageEndpoint : Bot の DM で伏字を伏せずに送った場合に、閲覧した API の代わりに記録する名前
+const DirectMessageEndpoint = "traQ DM"
+
+// sendDirectMessages : 重複と操作したユーザー自身を除き、チケットの公開範囲内の宛先それぞれに DM を送る
+// 本文の伏字は宛先ごとに、宛先が閲覧できないカテゴリを伏せる
+// 宛先のユーザー UUID の解決や送信に失敗しても他の宛先への送信は続ける
+// チケットまたは伏字の閲覧ルールを取得できない場合は、公開範囲・伏字を確認できないので送らない
+func (r *Repository) sendDirectMessages(ctx context.Context, ticketID int64, actor string, traqIDs []string, message string) {
+	ticket, err := r.GetTicketByID(ctx, ticketID)
+	if err != nil {
+		fmt.Printf("failed to get ticket %d for direct messages: %v\n", ticketID, err)
+
+		return
+	}
+	policy, err := r.GetCensorPolicy(ctx)
+	if err != nil {
+		fmt.Printf("failed to get censor policy for direct messages: %v\n", err)
+
+		return
+	}
+
+	recipients := make([]string, 0, len(traqIDs))
+	for _, traqID := range traqIDs {
+		if slices.Contains(recipients, traqID) || traqID == "" || traqID == actor {
+			continue
+		}
+		recipients = append(recipients, traqID)
+	}
+
+	for _, traqID := range recipients {
+		role, err := r.GetUserRoleByTraqID(ctx, traqID)
+		if err != nil {
+			fmt.Printf("failed to get role of %s: %v\n", traqID, err)
+
+			continue
+		}
+		if !ticketVisibleTo(role, traqID, ticket) {
+			continue
+		}
+
+		userID, err := r.users.ResolveUserID(ctx, traqID)
+		if err != nil {
+			fmt.Printf("failed to resolve user %s: %v\n", traqID, err)
+
+			continue
+		}
+
+		viewer := censor.Viewer{
+			Role:     role,
+			Assignee: ticketRelations(traqID, ticket)&authz.RelationAssignee != 0,
+			Policy:   policy,
+		}
+		content := r.CensorDirectMessage(ctx, traqID, viewer, ticket.ID, message)
+		if err := r.bot.PostDirectMessage(ctx, userID, content); err != nil {
+			fmt.Printf("failed to send direct message to %s: %v\n", traqID, err)
+		}
+	}
+}
+
+// CensorDirectMessage : DM の本文のうち、宛先が閲覧できないカテゴリの伏字を伏せる
+// 伏字を伏せずに送る場合は宛先が閲覧したものとして記録し、記録に失敗した場合はすべての伏字を伏せる
+func (r *Repository) CensorDirectMessage(ctx context.Context, recipient string, viewer censor.Viewer, ticketID int64, message string) string {
+	if !viewer.Reveals(message) {
+		return viewer.Apply(message)
+	}
+
+	target := UncensoredViewTarget{TicketID: ticketID, NoteID: sql.NullInt64{Int64: 0, Valid: false}}
+	if err := r.RecordUncensoredViews(ctx, recipient, DirectMessageEndpoint, []UncensoredViewTarget{target}); err != nil {
+		fmt.Printf("failed to record uncensored direct message to %s: %v\n", recipient, err)
+
+		return censor.Content(message)
+	}
+
+	return viewer.Apply(message)
+}
+
+// ticketRelations : チケットとユーザーの関係
+func ticketRelations(traqID string, ticket *Ticket) authz.Relation {
+	return authz.TicketRelations(traqID, ticket.Assignee, ticket.SubAssignees, ticket.Stakeholders)
+}
+
+// ticketVisibleTo : ロールとチケットとの関係から、ユーザーがチケットを閲覧できるか
+func ticketVisibleTo(role, traqID string, ticket *Ticket) bool {
+	return authz.TicketVisible(role, ticketRelations(traqID, ticket), ticket.Visibility)
+}
@@ -0,0 +1,39 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// PIIScanText : 個人情報の検出対象のテキスト
+type PIIScanText struct {
+	TicketID int64         `db:"ticket_id"`
+	NoteID   sql.NullInt64 `db:"note_id"`
+	// Field : title, description, content のいずれか
+	Field string `db:"field"`
+	Text  string `db:"text"`
+}
+
+// GetPIIScanTexts : 削除されていないチケットのタイトル・説明とノートの本文を、チケット・ノートの古い順に取得
+func (r *Repository) GetPIIScanTexts(ctx context.Context) ([]*PIIScanText, error) {
+	texts := []*PIIScanText{}
+	if err := r.db.SelectContext(ctx, &texts, `
+		SELECT ticket_id, note_id, field, text FROM (
+			SELECT t.id AS ticket_id, NULL AS note_id, 'title' AS field, t.title AS text, 0 AS ord
+			FROM tickets t WHERE t.deleted_at IS NULL AND t.title IS NOT NULL
+			UNION ALL
+			SELECT t.id, NULL, 'description', t.description, 1
+			FROM tickets t WHERE t.deleted_at IS NULL AND t.description IS NOT NULL
+			UNION ALL
+			SELECT n.ticket_id, n.id, 'content', n.content, 2
+			FROM notes n JOIN tickets t ON n.ticket_id = t.id
+			WHERE n.deleted_at IS NULL AND t.deleted_at IS NULL AND n.content IS NOT NULL
+		) texts
+		ORDER BY ticket_id, note_id IS NOT NULL, note_id, ord
+	`); err != nil {
+		return nil, fmt.Errorf("select texts: %w", err)
+	}
+
+	return texts, nil
+}
@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+const (
+	ReminderKindTicketOverdue   = "ticket_overdue"
+	ReminderKindNoteWaitingSent = "note_waiting_sent"
+)
+
+type WaitingSentNote struct {
+	Note
+	Assignee     string   `db:"assignee"`
+	SubAssignees []string `db:"-"`
+	// Visibility : ノートのチケットの公開範囲
+	Visibility string `db:"visibility"`
+}
+
+// GetOverdueTickets : 期日が day より前で、完了していないチケットを取得
+func (r *Repository) GetOverdueTickets(ctx context.Context, day time.Time) ([]*Ticket, error) {
+	rows, err := r.db.QueryxContext(ctx, `
+		SELECT
+			t.id, t.title, t.status, t.visibility, t.assignee, t.due, t.description, t.created_at, t.updated_at, t.deleted_at,
+			GROUP_CONCAT(DISTINCT tsa.sub_assignee) AS sub_assignees
+		FROM tickets t
+		LEFT JOIN ticket_sub_assignees tsa ON t.id = tsa.ticket_id
+		WHERE t.deleted_at IS NULL
+			AND t.due IS NOT NULL
+			AND t.due < ?
+			AND t.status NOT IN ('completed', 'forgotten')
+		GROUP BY t.id
+		ORDER BY t.due ASC`, day.Format(time.DateOnly))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get overdue tickets: %w", err)
+	}
+	defer rows.Close()
+
+	tickets := []*Ticket{}
+	for rows.Next() {
+		//nolint:exhaustruct
+		t := Ticket{}
+		var subAssignees sql.NullString
+		if err := rows.Scan(
+			&t.ID, &t.Title, &t.Status, &t.Visibility, &t.Assignee, &t.Due, &t.Description, &t.CreatedAt, &t.UpdatedAt, &t.DeletedAt,
+			&subAssignees,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan ticket: %w", err)
+		}
+		t.SubAssignees = splitGroupConcat(subAssignees)
+		t.Stakeholders = []string{}
+		t.Tags = []string{}
+		tickets = append(tickets, &t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate tickets: %w", err)
+	}
+
+	return tickets, nil
+}
+
+// GetWaitingSentNotes : before 以前から送信待ちのまま更新されていないノートを取得
+func (r *Repository) GetWaitingSentNotes(ctx context.Context, before time.Time) ([]*WaitingSentNote, error) {
+	rows, err := r.db.QueryxContext(ctx, `
+		SELECT
+			n.id, n.ticket_id, n.author, n.content, n.type, n.status, n.created_at, n.updated_at, n.deleted_at,
+			t.assignee, t.visibility,
+			GROUP_CONCAT(DISTINCT tsa.sub_assignee) AS sub_assignees
+		FROM notes n
+		JOIN tickets t ON n.ticket_id = t.id
+		LEFT JOIN ticket_sub_assignees tsa ON t.id = tsa.ticket_id
+		WHERE n.deleted_at IS NULL
+			AND t.deleted_at IS NULL
+			AND n.status = 'waiting_sent'
+			AND n.updated_at <= ?
+		GROUP BY n.id
+		ORDER BY n.updated_at ASC`, before)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get waiting sent notes: %w", err)
+	}
+	defer rows.Close()
+
+	notes := []*WaitingSentNote{}
+	for rows.Next() {
+		//nolint:exhaustruct
+		n := WaitingSentNote{}
+		var subAssignees sql.NullString
+		if err := rows.Scan(
+			&n.ID, &n.TicketID, &n.UserID, &n.Content, &n.Type, &n.Status, &n.CreatedAt, &n.UpdatedAt, &n.DeletedAt,
+			&n.Assignee, &n.Visibility, &subAssignees,
+		); err != nil {
+			return nil, fmt.Errorf("failed to scan note: %w", err)
+		}
+		n.SubAssignees = splitGroupConcat(subAssignees)
+		notes = append(notes, &n)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to iterate notes: %w", err)
+	}
+
+	return notes, nil
+}
+
+// MarkReminderSent : リマインドの送信済みを記録する
+// baseTime はリマインドの基準日時で、基準日時が変わると同じ対象・間隔でも別のリマインドになる
+// すでに記録済みの場合は false を返す
+func (r *Repository) MarkReminderSent(ctx context.Context, kind string, targetID int64, offset int, baseTime time.Time) (bool, error) {
+	res, err := r.db.ExecContext(ctx, `
+		INSERT IGNORE INTO reminder_logs (kind, target_id, offset_value, base_time) VALUES (?, ?, ?, ?)
+	`, kind, targetID, offset, baseTime)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert reminder log: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf("failed to get rows affected: %w", err)
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// UnmarkReminderSent : 送信に失敗したリマインドの記録を消し、次回に再送できるようにする
+func (r *Repository) UnmarkReminderSent(ctx context.Context, kind string, targetID int64, offset int, baseTime time.Time) error {
+	if _, err := r.db.ExecContext(ctx, `
+		DELETE FROM reminder_logs WHERE kind = ? AND target_id = ? AND offset_value = ? AND base_time = ?
+	`, kind, targetID, offset, baseTime); err != nil {
+		return fmt.Errorf("failed to delete reminder log: %w", err)
+	}
+
+	return nil
+}
@@ -6,10 +6,12 @@ import (
 )
 
 type Repository struct {
-	db  *sqlx.DB
-	bot bot.Client
+	db    *sqlx.DB
+	bot   bot.Client
+	users bot.UserResolver
 }
 
-func New(db *sqlx.DB, botClient bot.Client) *Repository {
-	return &Repository{db: db, bot: botClient}
+// New : users は通知の宛先の traQ ID をユーザー UUID に解決するのに使う
+func New(db *sqlx.DB, botClient bot.Client, users bot.UserResolver) *Repository {
+	return &Repository{db: db, bot: botClient, users: users}
 }
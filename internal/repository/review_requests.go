@@ -0,0 +1,231 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+var (
+	ErrReviewRequestNotOutgoing = fmt.Errorf("review requests are only for outgoing notes")
+	ErrReviewerCannotSeeTicket  = fmt.Errorf("reviewer cannot see the ticket")
+)
+
+// ReviewRequest : ノートのレビュー依頼 (note_review_assignees)
+type ReviewRequest struct {
+	NoteID      int64     `db:"note_id"`
+	Reviewer    string    `db:"assignee"`
+	RequestedBy string    `db:"requested_by"`
+	CreatedAt   time.Time `db:"created_at"`
+	// Status : 依頼されたユーザーの有効なレビューがあれば complete、なければ pending
+	Status string `db:"status"`
+}
+
+// reviewRequestSelectQuery : レビューの状況とともにレビュー依頼を取得するクエリ (レビュー依頼の別名は nra)
+const reviewRequestSelectQuery = `
+	SELECT nra.note_id, nra.assignee, nra.requested_by, nra.created_at,
+		CASE WHEN EXISTS (
+			SELECT 1 FROM reviews r
+			WHERE r.note_id = nra.note_id AND r.author = nra.assignee AND r.status = 'active' AND r.deleted_at IS NULL
+		) THEN 'complete' ELSE 'pending' END AS status
+	FROM note_review_assignees nra
+	JOIN notes n ON nra.note_id = n.id
+`
+
+// GetReviewRequestsByNoteIDs : ノートのレビュー依頼を依頼した順に返す
+func (r *Repository) GetReviewRequestsByNoteIDs(ctx context.Context, ticketID int64, noteIDs []int64) ([]*ReviewRequest, error) {
+	if len(noteIDs) == 0 {
+		return []*ReviewRequest{}, nil
+	}
+
+	query, args, err := sqlx.In(reviewRequestSelectQuery+`
+		WHERE nra.note_id IN (?) AND n.ticket_id = ? AND n.deleted_at IS NULL
+		ORDER BY nra.created_at ASC, nra.assignee ASC
+	`, noteIDs, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("build review request select query: %w", err)
+	}
+
+	requests := []*ReviewRequest{}
+	if err := r.db.SelectContext(ctx, &requests, r.db.Rebind(query), args...); err != nil {
+		return nil, fmt.Errorf("select review requests: %w", err)
+	}
+
+	return requests, nil
+}
+
+// RequestReviews : 送信するノートのレビューを依頼し、新たに依頼したユーザーに通知する
+// requireApproval が true の場合、送信待ちになるには依頼した全員の承認が必要になる
+func (r *Repository) RequestReviews(ctx context.Context, ticketID, noteID int64, requester string, reviewers []string, requireApproval bool) ([]*ReviewRequest, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			fmt.Printf("failed to rollback: %v\n", err)
+		}
+	}()
+
+	var note struct {
+		Type            string `db:"type"`
+		Status          string `db:"status"`
+		RequireApproval bool   `db:"require_requested_reviews"`
+	}
+	if err := tx.GetContext(ctx, &note, `
+		SELECT type, status, require_requested_reviews FROM notes
+		WHERE id = ? AND ticket_id = ? AND deleted_at IS NULL
+		FOR UPDATE
+	`, noteID, ticketID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrNoteNotFound
+		}
+
+		return nil, fmt.Errorf("select note: %w", err)
+	}
+	if note.Type != "outgoing" {
+		return nil, ErrReviewRequestNotOutgoing
+	}
+
+	if err := ensureUsersExist(ctx, tx, reviewers); err != nil {
+		return nil, err
+	}
+	if err := r.ensureTicketVisibleTo(ctx, ticketID, reviewers); err != nil {
+		return nil, err
+	}
+
+	var current []string
+	if err := tx.SelectContext(ctx, &current, `
+		SELECT assignee FROM note_review_assignees WHERE note_id = ?
+	`, noteID); err != nil {
+		return nil, fmt.Errorf("select review requests: %w", err)
+	}
+
+	var added []string
+	for _, reviewer := range reviewers {
+		if slices.Contains(current, reviewer) || slices.Contains(added, reviewer) {
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, `
+			INSERT INTO note_review_assignees (note_id, assignee, requested_by) VALUES (?, ?, ?)
+		`, noteID, reviewer, requester); err != nil {
+			return nil, fmt.Errorf("insert review request: %w", err)
+		}
+		added = append(added, reviewer)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE notes SET require_requested_reviews = ? WHERE id = ?
+	`, requireApproval, noteID); err != nil {
+		return nil, fmt.Errorf("update note review requirement: %w", err)
+	}
+
+	// 依頼した全員の承認が必要になった場合、承認がそろうまで送信待ちにしない
+	status := note.Status
+	if status == "waiting_sent" {
+		approved, err := requestedReviewsApproved(ctx, tx, noteID)
+		if err != nil {
+			return nil, err
+		}
+		if !approved {
+			status = "waiting_review"
+			if _, err := tx.ExecContext(ctx, `
+				UPDATE notes SET status = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
+			`, status, noteID); err != nil {
+				return nil, fmt.Errorf("update note status: %w", err)
+			}
+		}
+	}
+
+	changes := changeBuilder{}
+	changes.addString("review_requests", listValue(current), listValue(slices.Concat(current, added)))
+	changes.addString("require_requested_reviews", strconv.FormatBool(note.RequireApproval), strconv.FormatBool(requireApproval))
+	changes.addString("status", note.Status, status)
+	if len(changes) > 0 {
+		if err := recordTicketEvent(ctx, tx, ticketID, requester, TicketEventNoteUpdated, noteTarget(noteID), changes); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := syncTicketStatus(ctx, tx, ticketID, requester, false); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("commit transaction: %w", err)
+	}
+
+	if len(added) > 0 {
+		message := fmt.Sprintf("## レビューが依頼されました\n@%s からチケット(ID: %d)のノート(ID: %d)のレビューを依頼されました", requester, ticketID, noteID)
+		r.sendDirectMessages(ctx, ticketID, requester, added, message)
+	}
+
+	return r.GetReviewRequestsByNoteIDs(ctx, ticketID, []int64{noteID})
+}
+
+// requestedReviewsApproved : 依頼した全員の承認が必要なノートで、承認していないユーザーがいなければ true を返す
+func requestedReviewsApproved(ctx context.Context, tx *sqlx.Tx, noteID int64) (bool, error) {
+	var pending int
+	if err := tx.GetContext(ctx, &pending, `
+		SELECT COUNT(*)
+		FROM note_review_assignees nra
+		JOIN notes n ON nra.note_id = n.id
+		WHERE nra.note_id = ? AND n.require_requested_reviews AND NOT EXISTS (
+			SELECT 1 FROM reviews r
+			WHERE r.note_id = nra.note_id AND r.author = nra.assignee
+				AND r.type = 'approve' AND r.status = 'active' AND r.deleted_at IS NULL
+		)
+	`, noteID); err != nil {
+		return false, fmt.Errorf("count pending review requests: %w", err)
+	}
+
+	return pending == 0, nil
+}
+
+// ensureUsersExist : 登録されていないユーザーが含まれる場合は ErrReviewerNotFound を返す
+func ensureUsersExist(ctx context.Context, tx *sqlx.Tx, traqIDs []string) error {
+	if len(traqIDs) == 0 {
+		return nil
+	}
+
+	query, args, err := sqlx.In(`SELECT COUNT(DISTINCT traq_id) FROM users WHERE traq_id IN (?)`, traqIDs)
+	if err != nil {
+		return fmt.Errorf("build user select query: %w", err)
+	}
+
+	var count int
+	if err := tx.GetContext(ctx, &count, tx.Rebind(query), args...); err != nil {
+		return fmt.Errorf("count users: %w", err)
+	}
+	if count != len(slices.Compact(slices.Sorted(slices.Values(traqIDs)))) {
+		return ErrReviewerNotFound
+	}
+
+	return nil
+}
+
+// ensureTicketVisibleTo : チケットを閲覧できないユーザーが含まれる場合は ErrReviewerCannotSeeTicket を返す
+// 公開範囲外のユーザーにはレビューを依頼しても通知できず、レビューもできない
+func (r *Repository) ensureTicketVisibleTo(ctx context.Context, ticketID int64, traqIDs []string) error {
+	ticket, err := r.GetTicketByID(ctx, ticketID)
+	if err != nil {
+		return fmt.Errorf("get ticket: %w", err)
+	}
+
+	for _, traqID := range traqIDs {
+		role, err := r.GetUserRoleByTraqID(ctx, traqID)
+		if err != nil {
+			return err
+		}
+		if !ticketVisibleTo(role, traqID, ticket) {
+			return ErrReviewerCannotSeeTicket
+		}
+	}
+
+	return nil
+}
@@ -4,12 +4,16 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/jmoiron/sqlx"
 )
 
-const reviewStatusActive = "active"
+const (
+	reviewStatusActive = "active"
+	reviewStatusStale  = "stale"
+)
 
 var (
 	ErrNoteNotFound        = fmt.Errorf("note not found")
@@ -31,8 +35,17 @@ type Review struct {
 	Comment   sql.NullString `db:"comment"`
 	CreatedAt time.Time      `db:"created_at"`
 	UpdatedAt time.Time      `db:"updated_at"`
+	// Revision : レビューしたノートの版の通し番号 (版の記録前のレビューで分からない場合は NULL)
+	Revision sql.NullInt64 `db:"revision"`
 }
 
+// reviewSelectQuery : レビューしたノートの版の通し番号とともにレビューを取得するクエリ (レビューの別名は r)
+const reviewSelectQuery = `
+	SELECT r.id, r.note_id, r.type, r.status, r.weight, r.author, r.comment, r.created_at, r.updated_at, nr.revision
+	FROM reviews r
+	LEFT JOIN note_revisions nr ON r.revision_id = nr.id
+`
+
 type CreateReviewParams struct {
 	Type    string
 	Weight  int
@@ -83,7 +96,12 @@ func (r *Repository) CreateReview(ctx context.Context, ticketID, noteID int64, r
 		}
 	}
 
-	weight, err := normalizeReviewWeight(params, role)
+	rule, err := approvalRuleForNote(ctx, tx, noteID)
+	if err != nil {
+		return nil, err
+	}
+
+	weight, err := normalizeReviewWeight(params, rule.MaxWeight(role))
 	if err != nil {
 		return nil, err
 	}
@@ -92,10 +110,15 @@ func (r *Repository) CreateReview(ctx context.Context, ticketID, noteID int64, r
 		return nil, err
 	}
 
+	revisionID, err := latestNoteRevisionID(ctx, tx, noteID)
+	if err != nil {
+		return nil, err
+	}
+
 	res, err := tx.ExecContext(ctx, `
-		INSERT INTO reviews (note_id, type, status, weight, author, comment)
-		VALUES (?, ?, ?, ?, ?, ?)
-	`, noteID, params.Type, reviewStatusActive, weight, reviewer, params.Comment)
+		INSERT INTO reviews (note_id, type, status, weight, author, comment, revision_id)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, noteID, params.Type, reviewStatusActive, weight, reviewer, params.Comment, revisionID)
 	if err != nil {
 		return nil, fmt.Errorf("insert review: %w", err)
 	}
@@ -107,21 +130,39 @@ func (r *Repository) CreateReview(ctx context.Context, ticketID, noteID int64, r
 
 	if params.Type == "cr" {
 		if _, err := tx.ExecContext(ctx, `
-			UPDATE notes SET status = 'draft' WHERE id = ?
+			UPDATE notes SET status = 'draft', submitted_at = NULL WHERE id = ?
 		`, noteID); err != nil {
 			return nil, fmt.Errorf("update note status for CR: %w", err)
 		}
 	}
 
-	if err := maybeUpdateNoteStatus(ctx, tx, noteID, noteStatus); err != nil {
+	if err := maybeUpdateNoteStatus(ctx, tx, noteID); err != nil {
+		return nil, err
+	}
+
+	if err := indexReview(ctx, tx, ticketID, noteID, reviewID, params.Comment); err != nil {
+		return nil, err
+	}
+
+	weightValue := strconv.Itoa(weight)
+	changes := changeBuilder{}
+	changes.add("type", nil, &params.Type)
+	changes.add("weight", nil, &weightValue)
+	changes.add("comment", nil, nullStringValue(params.Comment))
+	if err := addNoteStatusChange(ctx, tx, &changes, noteID, noteStatus); err != nil {
+		return nil, err
+	}
+	if err := recordTicketEvent(ctx, tx, ticketID, reviewer, TicketEventReviewCreated, reviewTarget(noteID, reviewID), changes); err != nil {
+		return nil, err
+	}
+
+	if err := syncTicketStatus(ctx, tx, ticketID, reviewer, false); err != nil {
 		return nil, err
 	}
 
 	review := new(Review)
-	if err := tx.GetContext(ctx, review, `
-		SELECT id, note_id, type, status, weight, author, comment, created_at, updated_at
-		FROM reviews
-		WHERE id = ?
+	if err := tx.GetContext(ctx, review, reviewSelectQuery+`
+		WHERE r.id = ?
 	`, reviewID); err != nil {
 		return nil, fmt.Errorf("select review: %w", err)
 	}
@@ -142,21 +183,11 @@ func isValidReviewType(t string) bool {
 	}
 }
 
-func normalizeReviewWeight(params CreateReviewParams, role string) (int, error) {
+func normalizeReviewWeight(params CreateReviewParams, maxWeight int) (int, error) {
 	if params.Type != "approve" {
 		return 0, nil
 	}
 
-	maxWeight := 0
-	switch role {
-	case "manager":
-		maxWeight = 5
-	case "assistant":
-		maxWeight = 4
-	default:
-		maxWeight = 0
-	}
-
 	if params.Weight < 0 || params.Weight > maxWeight {
 		return 0, ErrInvalidReviewWeight
 	}
@@ -164,7 +195,7 @@ func normalizeReviewWeight(params CreateReviewParams, role string) (int, error)
 	return params.Weight, nil
 }
 
-func normalizeUpdateWeight(newType string, weightSet bool, weight int, currentWeight int, role string) (int, error) {
+func normalizeUpdateWeight(newType string, weightSet bool, weight int, currentWeight int, maxWeight int) (int, error) {
 	if newType != "approve" {
 		return 0, nil
 	}
@@ -174,16 +205,6 @@ func normalizeUpdateWeight(newType string, weightSet bool, weight int, currentWe
 		finalWeight = weight
 	}
 
-	maxWeight := 0
-	switch role {
-	case "manager":
-		maxWeight = 5
-	case "assistant":
-		maxWeight = 4
-	default:
-		maxWeight = 0
-	}
-
 	if finalWeight <= 0 || finalWeight > maxWeight {
 		return 0, ErrInvalidReviewWeight
 	}
@@ -239,10 +260,15 @@ func (r *Repository) UpdateReview(ctx context.Context, ticketID, noteID, reviewI
 		return nil, fmt.Errorf("select reviewer role: %w", err)
 	}
 
+	rule, err := approvalRuleForNote(ctx, tx, noteID)
+	if err != nil {
+		return nil, err
+	}
+
 	newWeight := current.Weight
 	var weightErr error
 	if newType == "approve" || params.TypeSet {
-		newWeight, weightErr = normalizeUpdateWeight(newType, params.WeightSet, params.Weight, current.Weight, role)
+		newWeight, weightErr = normalizeUpdateWeight(newType, params.WeightSet, params.Weight, current.Weight, rule.MaxWeight(role))
 		if weightErr != nil {
 			return nil, weightErr
 		}
@@ -261,15 +287,34 @@ func (r *Repository) UpdateReview(ctx context.Context, ticketID, noteID, reviewI
 		return nil, fmt.Errorf("update review: %w", err)
 	}
 
-	if err := maybeUpdateNoteStatus(ctx, tx, noteID, noteStatus); err != nil {
+	if err := maybeUpdateNoteStatus(ctx, tx, noteID); err != nil {
+		return nil, err
+	}
+
+	if err := indexReview(ctx, tx, ticketID, noteID, reviewID, newComment); err != nil {
+		return nil, err
+	}
+
+	changes := changeBuilder{}
+	changes.addString("type", current.Type, newType)
+	changes.addInt("weight", current.Weight, newWeight)
+	changes.addNullString("comment", current.Comment, newComment)
+	if err := addNoteStatusChange(ctx, tx, &changes, noteID, noteStatus); err != nil {
+		return nil, err
+	}
+	if len(changes) > 0 {
+		if err := recordTicketEvent(ctx, tx, ticketID, reviewer, TicketEventReviewUpdated, reviewTarget(noteID, reviewID), changes); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := syncTicketStatus(ctx, tx, ticketID, reviewer, false); err != nil {
 		return nil, err
 	}
 
 	updated := new(Review)
-	if err := tx.GetContext(ctx, updated, `
-		SELECT id, note_id, type, status, weight, author, comment, created_at, updated_at
-		FROM reviews
-		WHERE id = ?
+	if err := tx.GetContext(ctx, updated, reviewSelectQuery+`
+		WHERE r.id = ?
 	`, reviewID); err != nil {
 		return nil, fmt.Errorf("select updated review: %w", err)
 	}
@@ -281,6 +326,61 @@ func (r *Repository) UpdateReview(ctx context.Context, ticketID, noteID, reviewI
 	return updated, nil
 }
 
+// GetReviewByID : レビューをレビューしたノートの版とともに取得する
+func (r *Repository) GetReviewByID(ctx context.Context, ticketID, noteID, reviewID int64) (*Review, error) {
+	review := new(Review)
+	if err := r.db.GetContext(ctx, review, reviewSelectQuery+`
+		JOIN notes n ON r.note_id = n.id
+		WHERE r.id = ? AND r.note_id = ? AND n.ticket_id = ? AND r.deleted_at IS NULL AND n.deleted_at IS NULL
+	`, reviewID, noteID, ticketID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrReviewNotFound
+		}
+
+		return nil, fmt.Errorf("select review: %w", err)
+	}
+
+	return review, nil
+}
+
+// staleReviews : ノートの有効なレビューをすべて無効化し、無効化したレビューの作成者を返す
+// ノートの本文が編集された場合に呼び出す
+func staleReviews(ctx context.Context, tx *sqlx.Tx, ticketID, noteID int64, updater string) ([]string, error) {
+	var reviews []struct {
+		ID     int64  `db:"id"`
+		Author string `db:"author"`
+	}
+	if err := tx.SelectContext(ctx, &reviews, `
+		SELECT id, author FROM reviews
+		WHERE note_id = ? AND status = 'active' AND deleted_at IS NULL
+		ORDER BY id
+		FOR UPDATE
+	`, noteID); err != nil {
+		return nil, fmt.Errorf("select active reviews: %w", err)
+	}
+	if len(reviews) == 0 {
+		return nil, nil
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE reviews SET status = ? WHERE note_id = ? AND status = 'active' AND deleted_at IS NULL
+	`, reviewStatusStale, noteID); err != nil {
+		return nil, fmt.Errorf("update reviews to stale: %w", err)
+	}
+
+	authors := make([]string, 0, len(reviews))
+	for _, review := range reviews {
+		changes := changeBuilder{}
+		changes.addString("status", reviewStatusActive, reviewStatusStale)
+		if err := recordTicketEvent(ctx, tx, ticketID, updater, TicketEventReviewUpdated, reviewTarget(noteID, review.ID), changes); err != nil {
+			return nil, err
+		}
+		authors = append(authors, review.Author)
+	}
+
+	return authors, nil
+}
+
 func (r *Repository) DeleteReview(ctx context.Context, ticketID, noteID, reviewID int64, reviewer string) error {
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
@@ -317,6 +417,10 @@ func (r *Repository) DeleteReview(ctx context.Context, ticketID, noteID, reviewI
 		return fmt.Errorf("delete review: %w", err)
 	}
 
+	if err := recordTicketEvent(ctx, tx, ticketID, reviewer, TicketEventReviewDeleted, reviewTarget(noteID, reviewID), nil); err != nil {
+		return err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("commit transaction: %w", err)
 	}
@@ -324,6 +428,17 @@ func (r *Repository) DeleteReview(ctx context.Context, ticketID, noteID, reviewI
 	return nil
 }
 
+// addNoteStatusChange : レビューによってノートのステータスが変わった場合に変更として追加する
+func addNoteStatusChange(ctx context.Context, tx *sqlx.Tx, changes *changeBuilder, noteID int64, before string) error {
+	var after string
+	if err := tx.GetContext(ctx, &after, `SELECT status FROM notes WHERE id = ?`, noteID); err != nil {
+		return fmt.Errorf("select note status: %w", err)
+	}
+	changes.addString("note_status", before, after)
+
+	return nil
+}
+
 func ensureReviewerNotDuplicated(ctx context.Context, tx *sqlx.Tx, noteID int64, reviewer string) error {
 	var exists int
 	if err := tx.QueryRowContext(ctx, `
@@ -342,17 +457,22 @@ func ensureReviewerNotDuplicated(ctx context.Context, tx *sqlx.Tx, noteID int64,
 	return ErrReviewAlreadyExists
 }
 
-func maybeUpdateNoteStatus(ctx context.Context, tx *sqlx.Tx, noteID int64, currentStatus string) error {
-	var totalWeight int
-	if err := tx.QueryRowContext(ctx, `
-		SELECT COALESCE(SUM(weight), 0)
-		FROM reviews
-		WHERE note_id = ? AND status = 'active' AND deleted_at IS NULL AND type = 'approve'
-	`, noteID).Scan(&totalWeight); err != nil {
-		return fmt.Errorf("sum review weights: %w", err)
+// maybeUpdateNoteStatus : レビュー待ちのノートが承認のルールを満たした場合に送信待ちにする
+// 下書き・送信済み・破棄したノートはレビューによってステータスを変えない
+func maybeUpdateNoteStatus(ctx context.Context, tx *sqlx.Tx, noteID int64) error {
+	var status string
+	if err := tx.GetContext(ctx, &status, `SELECT status FROM notes WHERE id = ?`, noteID); err != nil {
+		return fmt.Errorf("select note status: %w", err)
+	}
+	if status != "waiting_review" {
+		return nil
 	}
 
-	if totalWeight < 5 || currentStatus == "waiting_sent" {
+	approved, err := noteApproved(ctx, tx, noteID)
+	if err != nil {
+		return err
+	}
+	if !approved {
 		return nil
 	}
 
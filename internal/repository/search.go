@@ -0,0 +1,283 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/censor"
+)
+
+const (
+	SearchKindTicket = "ticket"
+	SearchKindNote   = "note"
+	SearchKindReview = "review"
+
+	DefaultSearchLimit = 20
+	MaxSearchLimit     = 100
+)
+
+var ErrEmptySearchQuery = fmt.Errorf("empty search query")
+
+type (
+	SearchParams struct {
+		Query string
+		// Censored : true の場合は Role と Policy で閲覧できる伏字の中のみを検索する
+		Censored bool
+		Role     string
+		Policy   censor.Policy
+		// Viewer : 検索するユーザーの traQ ID (ヒットしたチケットの担当者かの判定に使う)
+		Viewer string
+		// Restricted : true の場合は Viewer が閲覧できる公開範囲のチケットのみを検索する
+		Restricted bool
+		Limit      int
+	}
+
+	// SearchHit : 検索にヒットしたチケット・ノート・レビュー
+	SearchHit struct {
+		Kind     string        `db:"kind"`
+		TicketID int64         `db:"ticket_id"`
+		NoteID   sql.NullInt64 `db:"note_id"`
+		ReviewID sql.NullInt64 `db:"review_id"`
+		Score    float64       `db:"score"`
+		// Assigned : 検索したユーザーがチケットの担当者・副担当者か
+		Assigned bool `db:"assigned"`
+		// Text : ヒットしたテキスト (伏字は適用されていない)
+		Text string `db:"text"`
+	}
+)
+
+// searchTerms : 検索用に文字・数字の連続ごとに小文字で分割する
+func searchTerms(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+}
+
+// ngramTokens : 単語を 2-gram に分割し、全文検索インデックスに載るトークンに変換する
+// InnoDB の最小トークン長やストップワードの影響を受けないよう、16 進数に接頭辞を付けた形にする
+func ngramTokens(term string) []string {
+	runes := []rune(term)
+	if len(runes) == 1 {
+		return []string{"ng" + hex.EncodeToString([]byte(term))}
+	}
+
+	tokens := make([]string, 0, len(runes)-1)
+	for i := 0; i+1 < len(runes); i++ {
+		tokens = append(tokens, "ng"+hex.EncodeToString([]byte(string(runes[i:i+2]))))
+	}
+
+	return tokens
+}
+
+func searchTokens(text string) string {
+	terms := searchTerms(text)
+	tokens := make([]string, 0, len(terms))
+	for _, term := range terms {
+		tokens = append(tokens, strings.Join(ngramTokens(term), " "))
+	}
+
+	return strings.Join(tokens, " ")
+}
+
+// matchesSearchQuery : テキストが検索語をすべて含むか (全文検索インデックスのフレーズ検索と同じ判定)
+func matchesSearchQuery(text string, query string) bool {
+	tokens := " " + searchTokens(text) + " "
+	for _, term := range searchTerms(query) {
+		if !strings.Contains(tokens, " "+strings.Join(ngramTokens(term), " ")+" ") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// booleanSearchQuery : すべての単語をフレーズとして含む BOOLEAN MODE の検索クエリを返す
+func booleanSearchQuery(query string) (string, error) {
+	terms := searchTerms(query)
+	if len(terms) == 0 {
+		return "", ErrEmptySearchQuery
+	}
+
+	phrases := make([]string, 0, len(terms))
+	for _, term := range terms {
+		phrases = append(phrases, `+"`+strings.Join(ngramTokens(term), " ")+`"`)
+	}
+
+	return strings.Join(phrases, " "), nil
+}
+
+// upsertSearchDocument : 検索インデックスを更新する
+// 伏字を含むテキストは、伏字部分を除いたトークンも別に保存する
+func upsertSearchDocument(ctx context.Context, e sqlx.ExecerContext, kind string, sourceID, ticketID int64, target ticketEventTarget, text string) error {
+	if _, err := e.ExecContext(ctx, `
+		INSERT INTO search_documents (kind, source_id, ticket_id, note_id, review_id, tokens, censored_tokens)
+		VALUES (?, ?, ?, ?, ?, ?, ?)
+		ON DUPLICATE KEY UPDATE
+			tokens = VALUES(tokens),
+			censored_tokens = VALUES(censored_tokens)
+	`, kind, sourceID, ticketID, target.NoteID, target.ReviewID, searchTokens(text), searchTokens(censor.Content(text))); err != nil {
+		return fmt.Errorf("upsert search document: %w", err)
+	}
+
+	return nil
+}
+
+func ticketSearchText(title string, description sql.NullString) string {
+	return title + "\n" + description.String
+}
+
+func indexTicket(ctx context.Context, e sqlx.ExecerContext, ticketID int64, title string, description sql.NullString) error {
+	return upsertSearchDocument(ctx, e, SearchKindTicket, ticketID, ticketID, noTarget(), ticketSearchText(title, description))
+}
+
+func indexNote(ctx context.Context, e sqlx.ExecerContext, ticketID, noteID int64, content string) error {
+	return upsertSearchDocument(ctx, e, SearchKindNote, noteID, ticketID, noteTarget(noteID), content)
+}
+
+func indexReview(ctx context.Context, e sqlx.ExecerContext, ticketID, noteID, reviewID int64, comment sql.NullString) error {
+	return upsertSearchDocument(ctx, e, SearchKindReview, reviewID, ticketID, reviewTarget(noteID, reviewID), comment.String)
+}
+
+// IndexMissingSearchDocuments : 検索インデックスに登録されていないチケット・ノート・レビューを登録し、登録した件数を返す
+// 検索インデックスの追加前から存在するデータのために起動時に呼び出す
+func (r *Repository) IndexMissingSearchDocuments(ctx context.Context) (int, error) {
+	var tickets []struct {
+		ID          int64          `db:"id"`
+		Title       sql.NullString `db:"title"`
+		Description sql.NullString `db:"description"`
+	}
+	if err := r.db.SelectContext(ctx, &tickets, `
+		SELECT t.id, t.title, t.description FROM tickets t
+		LEFT JOIN search_documents d ON d.kind = 'ticket' AND d.source_id = t.id
+		WHERE d.id IS NULL
+	`); err != nil {
+		return 0, fmt.Errorf("select unindexed tickets: %w", err)
+	}
+	for _, t := range tickets {
+		if err := indexTicket(ctx, r.db, t.ID, t.Title.String, t.Description); err != nil {
+			return 0, err
+		}
+	}
+
+	var notes []struct {
+		ID       int64          `db:"id"`
+		TicketID int64          `db:"ticket_id"`
+		Content  sql.NullString `db:"content"`
+	}
+	if err := r.db.SelectContext(ctx, &notes, `
+		SELECT n.id, n.ticket_id, n.content FROM notes n
+		LEFT JOIN search_documents d ON d.kind = 'note' AND d.source_id = n.id
+		WHERE d.id IS NULL
+	`); err != nil {
+		return 0, fmt.Errorf("select unindexed notes: %w", err)
+	}
+	for _, n := range notes {
+		if err := indexNote(ctx, r.db, n.TicketID, n.ID, n.Content.String); err != nil {
+			return 0, err
+		}
+	}
+
+	var reviews []struct {
+		ID       int64          `db:"id"`
+		NoteID   int64          `db:"note_id"`
+		TicketID int64          `db:"ticket_id"`
+		Comment  sql.NullString `db:"comment"`
+	}
+	if err := r.db.SelectContext(ctx, &reviews, `
+		SELECT r.id, r.note_id, n.ticket_id, r.comment FROM reviews r
+		JOIN notes n ON r.note_id = n.id
+		LEFT JOIN search_documents d ON d.kind = 'review' AND d.source_id = r.id
+		WHERE d.id IS NULL
+	`); err != nil {
+		return 0, fmt.Errorf("select unindexed reviews: %w", err)
+	}
+	for _, rv := range reviews {
+		if err := indexReview(ctx, r.db, rv.TicketID, rv.NoteID, rv.ID, rv.Comment); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(tickets) + len(notes) + len(reviews), nil
+}
+
+// Search : チケットのタイトル・説明、ノートの本文、レビューのコメントを全文検索し、関連度の高い順に返す
+// 削除済みのチケット・ノート・レビューは含めない
+func (r *Repository) Search(ctx context.Context, params SearchParams) ([]*SearchHit, error) {
+	query, err := booleanSearchQuery(params.Query)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = DefaultSearchLimit
+	}
+	limit = min(limit, MaxSearchLimit)
+
+	// 伏字を見られないユーザーは伏字部分を含めて候補を探し、閲覧できない伏字を伏せたテキストで絞り込む
+	// 閲覧できない伏字の中身が順位に影響しないよう、関連度は伏字部分を除いたトークンで計算する
+	scoreColumn := "d.tokens"
+	if params.Censored {
+		scoreColumn = "d.censored_tokens"
+	}
+
+	conditions := ""
+	args := []interface{}{query, params.Viewer, params.Viewer, query}
+	if params.Restricted {
+		condition, visibleArgs := visibleCondition(params.Viewer)
+		conditions = "AND " + condition
+		args = append(args, visibleArgs...)
+	}
+
+	hits := []*SearchHit{}
+	for offset := 0; ; offset += limit {
+		page := []*SearchHit{}
+		if err := r.db.SelectContext(ctx, &page, `
+			SELECT
+				d.kind, d.ticket_id, d.note_id, d.review_id,
+				MATCH(`+scoreColumn+`) AGAINST (? IN BOOLEAN MODE) AS score,
+				(t.assignee = ? OR EXISTS (
+					SELECT 1 FROM ticket_sub_assignees tsa WHERE tsa.ticket_id = t.id AND tsa.sub_assignee = ?
+				)) AS assigned,
+				CASE d.kind
+					WHEN 'ticket' THEN CONCAT(COALESCE(t.title, ''), '\n', COALESCE(t.description, ''))
+					WHEN 'note' THEN COALESCE(n.content, '')
+					ELSE COALESCE(rv.comment, '')
+				END AS text
+			FROM search_documents d
+			JOIN tickets t ON d.ticket_id = t.id
+			LEFT JOIN notes n ON d.note_id = n.id
+			LEFT JOIN reviews rv ON d.review_id = rv.id
+			WHERE MATCH(d.tokens) AGAINST (? IN BOOLEAN MODE)
+				AND t.deleted_at IS NULL
+				AND (d.note_id IS NULL OR n.deleted_at IS NULL)
+				AND (d.review_id IS NULL OR rv.deleted_at IS NULL)
+				`+conditions+`
+			ORDER BY score DESC, d.id DESC
+			LIMIT ? OFFSET ?
+		`, append(args, limit, offset)...); err != nil {
+			return nil, fmt.Errorf("search documents: %w", err)
+		}
+
+		for _, hit := range page {
+			if params.Censored {
+				viewer := censor.Viewer{Role: params.Role, Assignee: hit.Assigned, Policy: params.Policy}
+				if !matchesSearchQuery(viewer.Apply(hit.Text), params.Query) {
+					continue
+				}
+			}
+			hits = append(hits, hit)
+			if len(hits) == limit {
+				return hits, nil
+			}
+		}
+		if len(page) < limit {
+			return hits, nil
+		}
+	}
+}
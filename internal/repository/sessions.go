@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+var (
+	ErrSessionNotFound = fmt.Errorf("session not found")
+	ErrStateNotFound   = fmt.Errorf("oauth state not found")
+)
+
+// CreateSession : 現在時刻から ttl の間有効なセッションを作成する (トークンはハッシュ化して渡す)
+func (r *Repository) CreateSession(ctx context.Context, tokenHash, traqID string, ttl time.Duration) error {
+	if _, err := r.db.ExecContext(ctx, `
+		INSERT INTO sessions (token_hash, traq_id, expires_at) VALUES (?, ?, CURRENT_TIMESTAMP + INTERVAL ? SECOND)
+	`, tokenHash, traqID, int64(ttl.Seconds())); err != nil {
+		return fmt.Errorf("insert session: %w", err)
+	}
+
+	return nil
+}
+
+// GetSessionUser : 有効期限内のセッションのユーザーの traQ ID を取得
+func (r *Repository) GetSessionUser(ctx context.Context, tokenHash string) (string, error) {
+	var traqID string
+	if err := r.db.GetContext(ctx, &traqID, `
+		SELECT traq_id FROM sessions WHERE token_hash = ? AND expires_at > CURRENT_TIMESTAMP
+	`, tokenHash); err != nil {
+		if err == sql.ErrNoRows {
+			return "", ErrSessionNotFound
+		}
+
+		return "", fmt.Errorf("select session: %w", err)
+	}
+
+	return traqID, nil
+}
+
+// DeleteSession : セッションを削除する
+func (r *Repository) DeleteSession(ctx context.Context, tokenHash string) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM sessions WHERE token_hash = ?`, tokenHash); err != nil {
+		return fmt.Errorf("delete session: %w", err)
+	}
+
+	return nil
+}
+
+// CreateOAuthState : 現在時刻から ttl の間有効な OAuth2 の state を保存する (state はハッシュ化して渡す)
+// ついでに期限切れの state とセッションを削除する
+func (r *Repository) CreateOAuthState(ctx context.Context, stateHash string, ttl time.Duration) error {
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM oauth_states WHERE expires_at <= CURRENT_TIMESTAMP`); err != nil {
+		return fmt.Errorf("delete expired oauth states: %w", err)
+	}
+	if _, err := r.db.ExecContext(ctx, `DELETE FROM sessions WHERE expires_at <= CURRENT_TIMESTAMP`); err != nil {
+		return fmt.Errorf("delete expired sessions: %w", err)
+	}
+
+	if _, err := r.db.ExecContext(ctx, `
+		INSERT INTO oauth_states (state_hash, expires_at) VALUES (?, CURRENT_TIMESTAMP + INTERVAL ? SECOND)
+	`, stateHash, int64(ttl.Seconds())); err != nil {
+		return fmt.Errorf("insert oauth state: %w", err)
+	}
+
+	return nil
+}
+
+// ConsumeOAuthState : 有効期限内の OAuth2 の state を削除する (一度しか使えない)
+func (r *Repository) ConsumeOAuthState(ctx context.Context, stateHash string) error {
+	res, err := r.db.ExecContext(ctx, `
+		DELETE FROM oauth_states WHERE state_hash = ? AND expires_at > CURRENT_TIMESTAMP
+	`, stateHash)
+	if err != nil {
+		return fmt.Errorf("delete oauth state: %w", err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("get affected rows: %w", err)
+	}
+	if affected == 0 {
+		return ErrStateNotFound
+	}
+
+	return nil
+}
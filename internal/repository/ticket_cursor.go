@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// ticketOrderBy : ソート順ごとの ORDER BY 句 (同じ値のチケットは ID で順序を固定する)
+// MariaDB では NULL は最小値として扱われるため、期日未設定のチケットは due_asc では先頭、due_desc では末尾になる
+var ticketOrderBy = map[string]string{
+	"due_asc":      "t.due ASC, t.id ASC",
+	"due_desc":     "t.due DESC, t.id DESC",
+	"created_desc": "t.created_at DESC, t.id DESC",
+}
+
+// ticketCursor : キーセットページネーションのカーソル
+// 前のページの最後のチケットのソートキーを保持する
+type ticketCursor struct {
+	Sort      string    `json:"sort"`
+	Due       *string   `json:"due"`
+	CreatedAt time.Time `json:"created_at"`
+	ID        int64     `json:"id"`
+}
+
+func encodeTicketCursor(sort string, last *Ticket) string {
+	cursor := ticketCursor{
+		Sort:      sort,
+		Due:       dateValue(last.Due),
+		CreatedAt: last.CreatedAt,
+		ID:        last.ID,
+	}
+	// フィールドはすべて JSON に変換できるため、エラーにはならない
+	b, _ := json.Marshal(cursor)
+
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeTicketCursor(s string, sort string) (*ticketCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+
+	//nolint:exhaustruct
+	cursor := &ticketCursor{}
+	if err := json.Unmarshal(b, cursor); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+	}
+	if cursor.Sort != sort {
+		return nil, fmt.Errorf("%w: cursor for %s cannot be used with %s", ErrInvalidCursor, cursor.Sort, sort)
+	}
+	if cursor.Due != nil {
+		if _, err := time.Parse(time.DateOnly, *cursor.Due); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrInvalidCursor, err)
+		}
+	}
+
+	return cursor, nil
+}
+
+// condition : カーソルより後ろのチケットに絞り込む WHERE 句の条件を返す
+func (c *ticketCursor) condition() (string, []interface{}) {
+	switch c.Sort {
+	case "due_asc":
+		if c.Due == nil {
+			return "(t.due IS NOT NULL OR t.id > ?)", []interface{}{c.ID}
+		}
+
+		return "(t.due > ? OR (t.due = ? AND t.id > ?))", []interface{}{*c.Due, *c.Due, c.ID}
+	case "due_desc":
+		if c.Due == nil {
+			return "(t.due IS NULL AND t.id < ?)", []interface{}{c.ID}
+		}
+
+		return "(t.due < ? OR t.due IS NULL OR (t.due = ? AND t.id < ?))", []interface{}{*c.Due, *c.Due, c.ID}
+	default:
+		return "(t.created_at < ? OR (t.created_at = ? AND t.id < ?))", []interface{}{c.CreatedAt, c.CreatedAt, c.ID}
+	}
+}
@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+)
+
+const (
+	TicketEventTicketCreated  = "ticket_created"
+	TicketEventTicketUpdated  = "ticket_updated"
+	TicketEventTicketDeleted  = "ticket_deleted"
+	TicketEventTicketRestored = "ticket_restored"
+	TicketEventStatusDerived  = "status_derived"
+	TicketEventNoteCreated    = "note_created"
+	TicketEventNoteUpdated    = "note_updated"
+	TicketEventNoteDeleted    = "note_deleted"
+	TicketEventReviewCreated  = "review_created"
+	TicketEventReviewUpdated  = "review_updated"
+	TicketEventReviewDeleted  = "review_deleted"
+)
+
+type (
+	// FieldChange : 変更されたフィールドと変更前後の値 (値がない場合は nil)
+	FieldChange struct {
+		Field  string  `json:"field"`
+		Before *string `json:"before"`
+		After  *string `json:"after"`
+	}
+
+	TicketEvent struct {
+		ID        int64         `db:"id"`
+		TicketID  int64         `db:"ticket_id"`
+		Actor     string        `db:"actor"`
+		Action    string        `db:"action"`
+		NoteID    sql.NullInt64 `db:"note_id"`
+		ReviewID  sql.NullInt64 `db:"review_id"`
+		Changes   []FieldChange `db:"-"`
+		CreatedAt time.Time     `db:"created_at"`
+	}
+
+	ticketEventTarget struct {
+		NoteID   sql.NullInt64
+		ReviewID sql.NullInt64
+	}
+)
+
+func noTarget() ticketEventTarget {
+	return ticketEventTarget{
+		NoteID:   sql.NullInt64{Int64: 0, Valid: false},
+		ReviewID: sql.NullInt64{Int64: 0, Valid: false},
+	}
+}
+
+func noteTarget(noteID int64) ticketEventTarget {
+	return ticketEventTarget{
+		NoteID:   sql.NullInt64{Int64: noteID, Valid: true},
+		ReviewID: sql.NullInt64{Int64: 0, Valid: false},
+	}
+}
+
+func reviewTarget(noteID, reviewID int64) ticketEventTarget {
+	return ticketEventTarget{
+		NoteID:   sql.NullInt64{Int64: noteID, Valid: true},
+		ReviewID: sql.NullInt64{Int64: reviewID, Valid: true},
+	}
+}
+
+// changeBuilder : フィールドごとの変更を積み上げる
+type changeBuilder []FieldChange
+
+func (b *changeBuilder) add(field string, before, after *string) {
+	if before == nil && after == nil {
+		return
+	}
+	if before != nil && after != nil && *before == *after {
+		return
+	}
+	*b = append(*b, FieldChange{Field: field, Before: before, After: after})
+}
+
+func (b *changeBuilder) addString(field, before, after string) {
+	b.add(field, &before, &after)
+}
+
+func (b *changeBuilder) addNullString(field string, before, after sql.NullString) {
+	b.add(field, nullStringValue(before), nullStringValue(after))
+}
+
+func (b *changeBuilder) addInt(field string, before, after int) {
+	b.addString(field, strconv.Itoa(before), strconv.Itoa(after))
+}
+
+func nullStringValue(s sql.NullString) *string {
+	if !s.Valid {
+		return nil
+	}
+
+	return &s.String
+}
+
+func listValue(values []string) string {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	return strings.Join(slices.Compact(sorted), ",")
+}
+
+func dateValue(t sql.NullTime) *string {
+	if !t.Valid {
+		return nil
+	}
+	v := t.Time.Format(time.DateOnly)
+
+	return &v
+}
+
+// recordTicketEvent : チケットの変更履歴を記録する
+// 呼び出し元のトランザクション内で追記する
+func recordTicketEvent(ctx context.Context, tx *sqlx.Tx, ticketID int64, actor, action string, target ticketEventTarget, changes []FieldChange) error {
+	if changes == nil {
+		changes = []FieldChange{}
+	}
+	changesJSON, err := json.Marshal(changes)
+	if err != nil {
+		return fmt.Errorf("marshal changes: %w", err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		INSERT INTO ticket_events (ticket_id, actor, action, note_id, review_id, changes) VALUES (?, ?, ?, ?, ?, ?)
+	`, ticketID, actor, action, target.NoteID, target.ReviewID, changesJSON); err != nil {
+		return fmt.Errorf("insert ticket event: %w", err)
+	}
+
+	return nil
+}
+
+// redactTicketEvents : 完全に削除したチケットの変更履歴から変更前後の値を消す
+// 誰がいつ何を変更したかは監査のため残し、タイトル・本文などの内容は残さない
+func redactTicketEvents(ctx context.Context, tx *sqlx.Tx, ticketID int64) error {
+	rows, err := tx.QueryxContext(ctx, `
+		SELECT id, changes FROM ticket_events WHERE ticket_id = ? FOR UPDATE
+	`, ticketID)
+	if err != nil {
+		return fmt.Errorf("select ticket events: %w", err)
+	}
+	redacted := map[int64][]byte{}
+	for rows.Next() {
+		var id int64
+		var changesJSON []byte
+		if err := rows.Scan(&id, &changesJSON); err != nil {
+			rows.Close()
+
+			return fmt.Errorf("scan ticket event: %w", err)
+		}
+		changes := []FieldChange{}
+		if err := json.Unmarshal(changesJSON, &changes); err != nil {
+			rows.Close()
+
+			return fmt.Errorf("unmarshal changes: %w", err)
+		}
+		for i := range changes {
+			changes[i].Before = nil
+			changes[i].After = nil
+		}
+		if redacted[id], err = json.Marshal(changes); err != nil {
+			rows.Close()
+
+			return fmt.Errorf("marshal changes: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		rows.Close()
+
+		return fmt.Errorf("iterate ticket events: %w", err)
+	}
+	rows.Close()
+
+	for id, changesJSON := range redacted {
+		if _, err := tx.ExecContext(ctx, `
+			UPDATE ticket_events SET changes = ? WHERE id = ?
+		`, changesJSON, id); err != nil {
+			return fmt.Errorf("redact ticket event: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// GetTicketEvents : チケットの変更履歴を古い順に取得
+func (r *Repository) GetTicketEvents(ctx context.Context, ticketID int64) ([]*TicketEvent, error) {
+	rows, err := r.db.QueryxContext(ctx, `
+		SELECT id, ticket_id, actor, action, note_id, review_id, changes, created_at
+		FROM ticket_events
+		WHERE ticket_id = ?
+		ORDER BY id ASC
+	`, ticketID)
+	if err != nil {
+		return nil, fmt.Errorf("select ticket events: %w", err)
+	}
+	defer rows.Close()
+
+	events := []*TicketEvent{}
+	for rows.Next() {
+		//nolint:exhaustruct
+		e := TicketEvent{}
+		var changes []byte
+		if err := rows.Scan(&e.ID, &e.TicketID, &e.Actor, &e.Action, &e.NoteID, &e.ReviewID, &changes, &e.CreatedAt); err != nil {
+			return nil, fmt.Errorf("scan ticket event: %w", err)
+		}
+		e.Changes = []FieldChange{}
+		if err := json.Unmarshal(changes, &e.Changes); err != nil {
+			return nil, fmt.Errorf("unmarshal changes: %w", err)
+		}
+		events = append(events, &e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate ticket events: %w", err)
+	}
+
+	return events, nil
+}
+
+// ticketFields : 履歴に記録するチケットのフィールド
+var ticketFields = []string{
+	"title",
+	"description",
+	"status",
+	"manual_status",
+	"assignee",
+	"sub_assignees",
+	"stakeholders",
+	"due",
+	"tags",
+	"visibility",
+}
+
+func ticketFieldValues(p *CreateTicketParams) map[string]*string {
+	values := make(map[string]*string, len(ticketFields))
+	if p == nil {
+		return values
+	}
+
+	manualStatus := strconv.FormatBool(p.ManualStatus)
+	subAssignees := listValue(p.SubAssignees)
+	stakeholders := listValue(p.Stakeholders)
+	tags := listValue(p.Tags)
+
+	values["title"] = &p.Title
+	values["description"] = nullStringValue(p.Description)
+	values["status"] = &p.Status
+	values["manual_status"] = &manualStatus
+	values["assignee"] = &p.Assignee
+	values["sub_assignees"] = &subAssignees
+	values["stakeholders"] = &stakeholders
+	values["due"] = dateValue(p.Due)
+	values["tags"] = &tags
+	values["visibility"] = &p.Visibility
+
+	return values
+}
+
+// diffTicketParams : チケットの変更前後の差分を返す (before が nil の場合は作成)
+func diffTicketParams(before, after *CreateTicketParams) []FieldChange {
+	beforeValues := ticketFieldValues(before)
+	afterValues := ticketFieldValues(after)
+
+	changes := changeBuilder{}
+	for _, field := range ticketFields {
+		changes.add(field, beforeValues[field], afterValues[field])
+	}
+
+	return changes
+}
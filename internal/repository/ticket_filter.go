@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"database/sql"
+	"time"
+)
+
+// TicketFilter : チケット一覧の絞り込み条件
+// 複数の値を指定したフィールドはいずれかに一致するもの、異なるフィールド同士はすべてに一致するものに絞り込む
+type TicketFilter struct {
+	Assignee     string
+	Statuses     []string
+	Tags         []string
+	SubAssignees []string
+	Stakeholders []string
+	// Involves : 担当者・副担当者・関係者・レビュー依頼先のいずれかに含まれるユーザー
+	Involves string
+	// DueBefore, DueAfter : 期日の範囲 (両端を含む)
+	DueBefore    sql.NullTime
+	DueAfter     sql.NullTime
+	UpdatedSince sql.NullTime
+	// Overdue : true の場合は期日を過ぎた未完了のチケット、false の場合はそれ以外のチケットに絞り込む
+	Overdue sql.NullBool
+	// VisibleTo : 指定した場合はそのユーザーが閲覧できる公開範囲のチケットに絞り込む (本職の場合は指定しない)
+	VisibleTo sql.NullString
+}
+
+// conditions : 絞り込み条件を WHERE 句の条件に変換する
+// スライスの引数は sqlx.In で展開する
+func (f TicketFilter) conditions(now time.Time) ([]string, []interface{}, error) {
+	conditions := []string{"t.deleted_at IS NULL"}
+	args := []interface{}{}
+
+	if f.Assignee != "" {
+		conditions = append(conditions, "t.assignee = ?")
+		args = append(args, f.Assignee)
+	}
+	if len(f.Statuses) > 0 {
+		for _, status := range f.Statuses {
+			if err := validateStatus(status); err != nil {
+				return nil, nil, err
+			}
+		}
+		conditions = append(conditions, "t.status IN (?)")
+		args = append(args, f.Statuses)
+	}
+	if len(f.Tags) > 0 {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM ticket_tags ftt WHERE ftt.ticket_id = t.id AND ftt.tag IN (?))")
+		args = append(args, f.Tags)
+	}
+	if len(f.SubAssignees) > 0 {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM ticket_sub_assignees ftsa WHERE ftsa.ticket_id = t.id AND ftsa.sub_assignee IN (?))")
+		args = append(args, f.SubAssignees)
+	}
+	if len(f.Stakeholders) > 0 {
+		conditions = append(conditions, "EXISTS (SELECT 1 FROM ticket_stakeholders fts WHERE fts.ticket_id = t.id AND fts.stakeholder IN (?))")
+		args = append(args, f.Stakeholders)
+	}
+	if f.Involves != "" {
+		conditions = append(conditions, `(
+			t.assignee = ?
+			OR EXISTS (SELECT 1 FROM ticket_sub_assignees itsa WHERE itsa.ticket_id = t.id AND itsa.sub_assignee = ?)
+			OR EXISTS (SELECT 1 FROM ticket_stakeholders its WHERE its.ticket_id = t.id AND its.stakeholder = ?)
+			OR EXISTS (
+				SELECT 1 FROM note_review_assignees inra
+				JOIN notes inn ON inra.note_id = inn.id
+				WHERE inn.ticket_id = t.id AND inn.deleted_at IS NULL AND inra.assignee = ?
+			)
+		)`)
+		args = append(args, f.Involves, f.Involves, f.Involves, f.Involves)
+	}
+	if f.DueBefore.Valid {
+		conditions = append(conditions, "t.due <= ?")
+		args = append(args, f.DueBefore.Time.Format(time.DateOnly))
+	}
+	if f.DueAfter.Valid {
+		conditions = append(conditions, "t.due >= ?")
+		args = append(args, f.DueAfter.Time.Format(time.DateOnly))
+	}
+	if f.UpdatedSince.Valid {
+		conditions = append(conditions, "t.updated_at >= ?")
+		args = append(args, f.UpdatedSince.Time)
+	}
+	if f.Overdue.Valid {
+		if f.Overdue.Bool {
+			conditions = append(conditions, "(t.due < ? AND t.status NOT IN (?))")
+		} else {
+			conditions = append(conditions, "(t.due IS NULL OR t.due >= ? OR t.status IN (?))")
+		}
+		args = append(args, now.In(JST).Format(time.DateOnly), closedTicketStatusList())
+	}
+	if f.VisibleTo.Valid {
+		condition, visibleArgs := visibleCondition(f.VisibleTo.String)
+		conditions = append(conditions, condition)
+		args = append(args, visibleArgs...)
+	}
+
+	return conditions, args, nil
+}
+
+// visibleCondition : 本職以外のユーザーが閲覧できる公開範囲のチケットに絞り込む条件 (チケットの別名は t)
+func visibleCondition(viewer string) (string, []interface{}) {
+	return `(
+			t.visibility = 'public'
+			OR (t.visibility = 'involved' AND (
+				t.assignee = ?
+				OR EXISTS (SELECT 1 FROM ticket_sub_assignees vtsa WHERE vtsa.ticket_id = t.id AND vtsa.sub_assignee = ?)
+				OR EXISTS (SELECT 1 FROM ticket_stakeholders vts WHERE vts.ticket_id = t.id AND vts.stakeholder = ?)
+			))
+		)`, []interface{}{viewer, viewer, viewer}
+}
+
+func closedTicketStatusList() []string {
+	statuses := make([]string, 0, len(closedTicketStatuses))
+	for _, status := range ticketStatusOrder {
+		if isClosedTicketStatus(status) {
+			statuses = append(statuses, status)
+		}
+	}
+
+	return statuses
+}
@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/authz"
+)
+
+// ticketStatusEdge : チケットのステータス遷移先
+// roles が空の場合は誰でも遷移できる
+type ticketStatusEdge struct {
+	to    string
+	roles []string
+}
+
+var managerOnly = []string{authz.RoleManager}
+
+// ticketStatusOrder : ステータスの表示順
+var ticketStatusOrder = []string{
+	"not_planned",
+	"not_written",
+	"waiting_review",
+	"waiting_sent",
+	"sent",
+	"milestone_scheduled",
+	"completed",
+	"forgotten",
+}
+
+// ticketStatusTransitions : チケットのステータス遷移グラフ
+// 完了・忘却からの再開と、忘却への遷移は本職のみ行える
+var ticketStatusTransitions = map[string][]ticketStatusEdge{
+	"not_planned": {
+		{to: "not_written", roles: nil},
+		{to: "milestone_scheduled", roles: nil},
+		{to: "completed", roles: nil},
+		{to: "forgotten", roles: managerOnly},
+	},
+	"not_written": {
+		{to: "not_planned", roles: nil},
+		{to: "waiting_review", roles: nil},
+		{to: "milestone_scheduled", roles: nil},
+		{to: "forgotten", roles: managerOnly},
+	},
+	"waiting_review": {
+		{to: "not_written", roles: nil},
+		{to: "waiting_sent", roles: nil},
+		{to: "forgotten", roles: managerOnly},
+	},
+	"waiting_sent": {
+		{to: "not_written", roles: nil},
+		{to: "waiting_review", roles: nil},
+		{to: "sent", roles: nil},
+		{to: "forgotten", roles: managerOnly},
+	},
+	"sent": {
+		{to: "not_written", roles: nil},
+		{to: "milestone_scheduled", roles: nil},
+		{to: "completed", roles: nil},
+		{to: "forgotten", roles: managerOnly},
+	},
+	"milestone_scheduled": {
+		{to: "not_written", roles: nil},
+		{to: "sent", roles: nil},
+		{to: "completed", roles: nil},
+		{to: "forgotten", roles: managerOnly},
+	},
+	"completed": {
+		{to: "not_written", roles: managerOnly},
+		{to: "milestone_scheduled", roles: managerOnly},
+	},
+	"forgotten": {
+		{to: "not_planned", roles: managerOnly},
+		{to: "not_written", roles: managerOnly},
+	},
+}
+
+var ErrInvalidStatusTransition = fmt.Errorf("invalid status transition")
+
+// AllowedTicketStatuses : role のユーザーが from から遷移できるステータスを返す
+func AllowedTicketStatuses(from string, role string) []string {
+	allowed := map[string]struct{}{}
+	for _, edge := range ticketStatusTransitions[from] {
+		if len(edge.roles) == 0 || slices.Contains(edge.roles, role) {
+			allowed[edge.to] = struct{}{}
+		}
+	}
+
+	res := make([]string, 0, len(allowed))
+	for _, status := range ticketStatusOrder {
+		if _, ok := allowed[status]; ok {
+			res = append(res, status)
+		}
+	}
+
+	return res
+}
+
+// validateStatusTransition : from から to への遷移が role のユーザーに許可されているか確認
+func validateStatusTransition(from, to, role string) error {
+	if from == to {
+		return nil
+	}
+	if !slices.Contains(AllowedTicketStatuses(from, role), to) {
+		return fmt.Errorf("%w: %s -> %s", ErrInvalidStatusTransition, from, to)
+	}
+
+	return nil
+}
+
+// deriveTicketStatus : ノートの種類と状態から導出されるチケットのステータスを返す
+func deriveTicketStatus(noteType, noteStatus string) (string, bool) {
+	if noteType == "incoming" {
+		return "not_written", true
+	}
+
+	switch noteStatus {
+	case "draft":
+		return "not_written", true
+	case "waiting_review":
+		return "waiting_review", true
+	case "waiting_sent":
+		return "waiting_sent", true
+	case "sent":
+		return "sent", true
+	default:
+		return "", false
+	}
+}
+
+// syncTicketStatus : 最新の発信・受信ノートからチケットのステータスを再計算する
+// 手動管理のチケットは変更しない。完了済みのチケットは reopen が true の場合のみ再開する
+// 自動で導出される遷移は手動の遷移グラフの制約を受けない
+func syncTicketStatus(ctx context.Context, tx *sqlx.Tx, ticketID int64, actor string, reopen bool) error {
+	var ticket struct {
+		Status       string       `db:"status"`
+		ManualStatus bool         `db:"manual_status"`
+		Due          sql.NullTime `db:"due"`
+	}
+	if err := tx.GetContext(ctx, &ticket, `
+		SELECT status, manual_status, due FROM tickets WHERE id = ? AND deleted_at IS NULL FOR UPDATE
+	`, ticketID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+
+		return fmt.Errorf("select ticket status: %w", err)
+	}
+	if ticket.ManualStatus {
+		return nil
+	}
+	closed := isClosedTicketStatus(ticket.Status)
+	if closed && !reopen {
+		return nil
+	}
+
+	var note struct {
+		Type   string `db:"type"`
+		Status string `db:"status"`
+	}
+	if err := tx.GetContext(ctx, &note, `
+		SELECT type, status FROM notes
+		WHERE ticket_id = ? AND deleted_at IS NULL AND type IN ('outgoing', 'incoming') AND status <> 'canceled'
+		ORDER BY created_at DESC, id DESC
+		LIMIT 1
+	`, ticketID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil
+		}
+
+		return fmt.Errorf("select latest note: %w", err)
+	}
+
+	status, ok := deriveTicketStatus(note.Type, note.Status)
+	if !ok || status == ticket.Status {
+		return nil
+	}
+
+	changes := changeBuilder{}
+	changes.addString("status", ticket.Status, status)
+	if closed {
+		// 再開時は期日を設定し直す
+		due, err := resetDueOnReopen(ctx, tx, ticketID)
+		if err != nil {
+			return err
+		}
+		if due.Valid {
+			changes.add("due", dateValue(ticket.Due), dateValue(due))
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, `
+		UPDATE tickets SET status = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
+	`, status, ticketID); err != nil {
+		return fmt.Errorf("update ticket status: %w", err)
+	}
+
+	return recordTicketEvent(ctx, tx, ticketID, actor, TicketEventStatusDerived, noTarget(), changes)
+}
+
+// resetDueOnReopen : 期日を設定し直し、設定した期日を返す
+func resetDueOnReopen(ctx context.Context, tx *sqlx.Tx, ticketID int64) (sql.NullTime, error) {
+	policy, err := getDuePolicy(ctx, tx)
+	if err != nil {
+		return sql.NullTime{}, err
+	}
+
+	tags := []string{}
+	if err := tx.SelectContext(ctx, &tags, `SELECT tag FROM ticket_tags WHERE ticket_id = ?`, ticketID); err != nil {
+		return sql.NullTime{}, fmt.Errorf("select tags: %w", err)
+	}
+
+	due := policy.DueFrom(time.Now(), tags)
+	if !due.Valid {
+		return due, nil
+	}
+	if _, err := tx.ExecContext(ctx, `UPDATE tickets SET due = ? WHERE id = ?`, due, ticketID); err != nil {
+		return sql.NullTime{}, fmt.Errorf("update due: %w", err)
+	}
+
+	return due, nil
+}
@@ -7,6 +7,9 @@ import (
 	"os"
 	"strings"
 	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/censor"
 )
 
 type (
@@ -14,6 +17,8 @@ type (
 		ID           int64          `db:"id"`
 		Title        string         `db:"title"`
 		Status       string         `db:"status"`
+		ManualStatus bool           `db:"manual_status"`
+		Visibility   string         `db:"visibility"`
 		Assignee     string         `db:"assignee"`
 		Due          sql.NullTime   `db:"due"`
 		Description  sql.NullString `db:"description"`
@@ -29,25 +34,48 @@ type (
 		Title        string
 		Description  sql.NullString
 		Status       string
+		ManualStatus bool
 		Assignee     string
 		SubAssignees []string
 		Stakeholders []string
 		Due          sql.NullTime
 		Tags         []string
+		// Visibility : 公開範囲 (空の場合は public)
+		Visibility string
 	}
 
 	GetTicketsParams struct {
-		Assignee string
-		Status   string
-		Sort     string
+		Filter TicketFilter
+		Sort   string
+		// Limit : 1 ページあたりの件数
+		// 0 の場合、Cursor も空であればすべてのチケットを返し、Cursor があれば DefaultTicketsLimit 件とする
+		Limit int
+		// Cursor : 前のページの NextCursor
+		Cursor string
+	}
+
+	// TicketPage : チケット一覧の 1 ページ分
+	TicketPage struct {
+		Tickets []*Ticket
+		// Total : フィルタに一致するチケットの総数
+		Total int
+		// NextCursor : 次のページを取得するためのカーソル (最後のページの場合は空)
+		NextCursor string
 	}
 )
 
+const (
+	DefaultTicketsLimit = 50
+	MaxTicketsLimit     = 100
+)
+
 var (
-	ErrTicketNotFound   = fmt.Errorf("ticket not found")
-	ErrInvalidStatus    = fmt.Errorf("invalid status")
-	ErrInvalidSort      = fmt.Errorf("invalid sort option")
-	ErrTagContainsComma = fmt.Errorf("tag contains comma")
+	ErrTicketNotFound    = fmt.Errorf("ticket not found")
+	ErrInvalidStatus     = fmt.Errorf("invalid status")
+	ErrInvalidSort       = fmt.Errorf("invalid sort option")
+	ErrTagContainsComma  = fmt.Errorf("tag contains comma")
+	ErrInvalidCursor     = fmt.Errorf("invalid cursor")
+	ErrInvalidVisibility = fmt.Errorf("invalid visibility")
 )
 
 func validateStatus(status string) error {
@@ -59,6 +87,15 @@ func validateStatus(status string) error {
 	}
 }
 
+func validateVisibility(visibility string) error {
+	switch visibility {
+	case "public", "involved", "managers":
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidVisibility, visibility)
+	}
+}
+
 func validateTicketSort(sort string) error {
 	switch sort {
 	case "due_asc", "due_desc", "created_desc":
@@ -78,45 +115,96 @@ func validateTags(tags []string) error {
 	return nil
 }
 
-func (r *Repository) GetTickets(ctx context.Context, params GetTicketsParams) ([]*Ticket, error) {
-	query := `
+// splitGroupConcat : GROUP_CONCAT の結果をスライスに変換
+func splitGroupConcat(s sql.NullString) []string {
+	if !s.Valid || s.String == "" {
+		return []string{}
+	}
+
+	return strings.Split(s.String, ",")
+}
+
+// ticketsSelectQuery : 関連テーブルを結合してチケットを取得するクエリ (WHERE 句以降は呼び出し側で付ける)
+const ticketsSelectQuery = `
 		SELECT
-			t.id, t.title, t.status, t.assignee, t.due, t.description, t.created_at, t.updated_at, t.deleted_at,
+			t.id, t.title, t.status, t.manual_status, t.visibility, t.assignee, t.due, t.description, t.created_at, t.updated_at, t.deleted_at,
 			GROUP_CONCAT(DISTINCT tsa.sub_assignee) AS sub_assignees,
 			GROUP_CONCAT(DISTINCT ts.stakeholder) AS stakeholders,
 			GROUP_CONCAT(DISTINCT tt.tag) AS tags
 		FROM tickets t
 		LEFT JOIN ticket_sub_assignees tsa ON t.id = tsa.ticket_id
 		LEFT JOIN ticket_stakeholders ts ON t.id = ts.ticket_id
-		LEFT JOIN ticket_tags tt ON t.id = tt.ticket_id
-		WHERE t.deleted_at IS NULL`
+		LEFT JOIN ticket_tags tt ON t.id = tt.ticket_id`
+
+func (r *Repository) GetTickets(ctx context.Context, params GetTicketsParams) (*TicketPage, error) {
+	conditions, args, err := params.Filter.conditions(time.Now())
+	if err != nil {
+		return nil, err
+	}
 
-	args := []interface{}{}
-	if params.Assignee != "" {
-		query += " AND t.assignee = ?"
-		args = append(args, params.Assignee)
+	sort := params.Sort
+	if err := validateTicketSort(sort); err != nil {
+		sort = "created_desc"
 	}
-	if params.Status != "" {
-		if err := validateStatus(params.Status); err != nil {
+	// ページ分割を指定しない場合は、これまで通りすべてのチケットを返す
+	paginated := params.Limit > 0 || params.Cursor != ""
+	limit := params.Limit
+	if limit <= 0 {
+		limit = DefaultTicketsLimit
+	}
+	limit = min(limit, MaxTicketsLimit)
+
+	countQuery, countArgs, err := sqlx.In(`SELECT COUNT(*) FROM tickets t WHERE `+strings.Join(conditions, " AND "), args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build count query: %w", err)
+	}
+	var total int
+	if err := r.db.GetContext(ctx, &total, r.db.Rebind(countQuery), countArgs...); err != nil {
+		return nil, fmt.Errorf("failed to count tickets: %w", err)
+	}
+
+	if params.Cursor != "" {
+		cursor, err := decodeTicketCursor(params.Cursor, sort)
+		if err != nil {
 			return nil, err
 		}
-		query += " AND t.status = ?"
-		args = append(args, params.Status)
-	}
-	query += " GROUP BY t.id"
-	if err := validateTicketSort(params.Sort); err == nil {
-		switch params.Sort {
-		case "due_asc":
-			query += " ORDER BY t.due ASC"
-		case "due_desc":
-			query += " ORDER BY t.due DESC"
-		case "created_desc":
-			query += " ORDER BY t.created_at DESC"
-		}
-	} else {
-		query += " ORDER BY t.created_at DESC"
+		condition, cursorArgs := cursor.condition()
+		conditions = append(conditions, condition)
+		args = append(args, cursorArgs...)
+	}
+
+	query := ticketsSelectQuery + `
+		WHERE ` + strings.Join(conditions, " AND ") + `
+		GROUP BY t.id
+		ORDER BY ` + ticketOrderBy[sort]
+	if paginated {
+		query += `
+		LIMIT ?`
+		args = append(args, limit+1)
+	}
+	query, args, err = sqlx.In(query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build tickets query: %w", err)
+	}
+	tickets, err := r.queryTickets(ctx, r.db.Rebind(query), args...)
+	if err != nil {
+		return nil, err
 	}
 
+	page := &TicketPage{
+		Tickets:    tickets,
+		Total:      total,
+		NextCursor: "",
+	}
+	if paginated && len(tickets) > limit {
+		page.Tickets = tickets[:limit]
+		page.NextCursor = encodeTicketCursor(sort, tickets[limit-1])
+	}
+
+	return page, nil
+}
+
+func (r *Repository) queryTickets(ctx context.Context, query string, args ...interface{}) ([]*Ticket, error) {
 	rows, err := r.db.QueryxContext(ctx, query, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get tickets: %w", err)
@@ -128,27 +216,15 @@ func (r *Repository) GetTickets(ctx context.Context, params GetTicketsParams) ([
 		var t Ticket
 		var subAssignees, stakeholders, tags sql.NullString
 		err := rows.Scan(
-			&t.ID, &t.Title, &t.Status, &t.Assignee, &t.Due, &t.Description, &t.CreatedAt, &t.UpdatedAt, &t.DeletedAt,
+			&t.ID, &t.Title, &t.Status, &t.ManualStatus, &t.Visibility, &t.Assignee, &t.Due, &t.Description, &t.CreatedAt, &t.UpdatedAt, &t.DeletedAt,
 			&subAssignees, &stakeholders, &tags,
 		)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan ticket: %w", err)
 		}
-		if subAssignees.Valid && subAssignees.String != "" {
-			t.SubAssignees = strings.Split(subAssignees.String, ",")
-		} else {
-			t.SubAssignees = []string{}
-		}
-		if stakeholders.Valid && stakeholders.String != "" {
-			t.Stakeholders = strings.Split(stakeholders.String, ",")
-		} else {
-			t.Stakeholders = []string{}
-		}
-		if tags.Valid && tags.String != "" {
-			t.Tags = strings.Split(tags.String, ",")
-		} else {
-			t.Tags = []string{}
-		}
+		t.SubAssignees = splitGroupConcat(subAssignees)
+		t.Stakeholders = splitGroupConcat(stakeholders)
+		t.Tags = splitGroupConcat(tags)
 		tickets = append(tickets, &t)
 	}
 	if err := rows.Err(); err != nil {
@@ -158,13 +234,19 @@ func (r *Repository) GetTickets(ctx context.Context, params GetTicketsParams) ([
 	return tickets, nil
 }
 
-func (r *Repository) CreateTicket(ctx context.Context, params CreateTicketParams) (int64, error) {
+func (r *Repository) CreateTicket(ctx context.Context, creator string, params CreateTicketParams) (int64, error) {
 	if err := validateStatus(params.Status); err != nil {
 		return 0, err
 	}
 	if err := validateTags(params.Tags); err != nil {
 		return 0, err
 	}
+	if params.Visibility == "" {
+		params.Visibility = "public"
+	}
+	if err := validateVisibility(params.Visibility); err != nil {
+		return 0, err
+	}
 
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
@@ -176,9 +258,17 @@ func (r *Repository) CreateTicket(ctx context.Context, params CreateTicketParams
 		}
 	}()
 
+	if !params.Due.Valid {
+		policy, err := getDuePolicy(ctx, tx)
+		if err != nil {
+			return 0, err
+		}
+		params.Due = policy.DueFrom(time.Now(), params.Tags)
+	}
+
 	res, err := tx.ExecContext(ctx, `
-		INSERT INTO tickets (title, description, status, assignee, due) VALUES (?, ?, ?, ?, ?)
-	`, params.Title, params.Description, params.Status, params.Assignee, params.Due)
+		INSERT INTO tickets (title, description, status, manual_status, visibility, assignee, due) VALUES (?, ?, ?, ?, ?, ?, ?)
+	`, params.Title, params.Description, params.Status, params.ManualStatus, params.Visibility, params.Assignee, params.Due)
 	if err != nil {
 		return 0, fmt.Errorf("failed to insert ticket: %w", err)
 	}
@@ -244,16 +334,28 @@ func (r *Repository) CreateTicket(ctx context.Context, params CreateTicketParams
 		}
 	}
 
+	if err := indexTicket(ctx, tx, ticketID, params.Title, params.Description); err != nil {
+		return 0, err
+	}
+
+	if err := recordTicketEvent(ctx, tx, ticketID, creator, TicketEventTicketCreated, noTarget(), diffTicketParams(nil, &params)); err != nil {
+		return 0, err
+	}
+
 	if err := tx.Commit(); err != nil {
 		return 0, fmt.Errorf("failed to commit transaction: %w", err)
 	}
-	botMessage := fmt.Sprintf("## 新しいチケット(ID: %d)が作成されました\nタイトル: %s\n担当者: @%s\n副担当: %v\n関係者: %v\nタグ: %v\n締め切り: %v\n%s", ticketID, params.Title, params.Assignee, params.SubAssignees, params.Stakeholders, params.Tags, params.Due.Time, params.Description.String)
-	if err := r.bot.PostMessage(ctx, os.Getenv("CREATE_TICKET_CHANNEL_ID"), botMessage); err != nil {
-		fmt.Printf("failed to send ticket creation notification: %v\n", err)
-	}
-	if err := r.bot.PostDirectMessage(ctx, "2e0c6679-166f-455a-b8b0-35cdfd257256", botMessage); err != nil {
-		fmt.Printf("failed to send ticket creation notification: %v\n", err)
+
+	botMessage := fmt.Sprintf("## 新しいチケット(ID: %d)が作成されました\nタイトル: %s\n担当者: %s\n副担当: %s\n関係者: %s\nタグ: %v\n締め切り: %v\n%s", ticketID, params.Title, mentions([]string{params.Assignee}), mentions(params.SubAssignees), mentions(params.Stakeholders), params.Tags, params.Due.Time, params.Description.String)
+	// 公開範囲が限られたチケットは存在自体を知らせないため、チャンネルには投稿しない
+	// チャンネルは誰でも閲覧できるため、伏字はすべて伏せる
+	if params.Visibility == "public" {
+		if err := r.bot.PostMessage(ctx, os.Getenv("CREATE_TICKET_CHANNEL_ID"), censor.Content(botMessage)); err != nil {
+			fmt.Printf("failed to send ticket creation notification: %v\n", err)
+		}
 	}
+	recipients := append(append([]string{params.Assignee}, params.SubAssignees...), params.Stakeholders...)
+	r.sendDirectMessages(ctx, ticketID, creator, recipients, botMessage)
 
 	return ticketID, nil
 }
@@ -288,7 +390,7 @@ func (r *Repository) GetTicketByID(ctx context.Context, ticketID int64) (*Ticket
 	return ticket, nil
 }
 
-func (r *Repository) UpdateTicket(ctx context.Context, ticketID int64, params CreateTicketParams) error {
+func (r *Repository) UpdateTicket(ctx context.Context, ticketID int64, updater, role string, params CreateTicketParams) error {
 	if err := validateStatus(params.Status); err != nil {
 		return err
 	}
@@ -297,6 +399,10 @@ func (r *Repository) UpdateTicket(ctx context.Context, ticketID int64, params Cr
 		return err
 	}
 
+	if err := validateVisibility(params.Visibility); err != nil {
+		return err
+	}
+
 	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
 		return fmt.Errorf("failed to begin transaction: %w", err)
@@ -307,9 +413,29 @@ func (r *Repository) UpdateTicket(ctx context.Context, ticketID int64, params Cr
 		}
 	}()
 
+	current, err := getTicketParamsForUpdate(ctx, tx, ticketID)
+	if err != nil {
+		return err
+	}
+
+	if err := validateStatusTransition(current.Status, params.Status, role); err != nil {
+		return err
+	}
+
+	// 完了済みのチケットが再開された場合、期日が変更されていなければ再設定する
+	if isClosedTicketStatus(current.Status) && !isClosedTicketStatus(params.Status) && sameDate(current.Due, params.Due) {
+		policy, err := getDuePolicy(ctx, tx)
+		if err != nil {
+			return err
+		}
+		if due := policy.DueFrom(time.Now(), params.Tags); due.Valid {
+			params.Due = due
+		}
+	}
+
 	res, err := tx.ExecContext(ctx, `
-		UPDATE tickets SET title = ?, description = ?, status = ?, assignee = ?, due = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
-	`, params.Title, params.Description, params.Status, params.Assignee, params.Due, ticketID)
+		UPDATE tickets SET title = ?, description = ?, status = ?, manual_status = ?, visibility = ?, assignee = ?, due = ?, updated_at = CURRENT_TIMESTAMP WHERE id = ?
+	`, params.Title, params.Description, params.Status, params.ManualStatus, params.Visibility, params.Assignee, params.Due, ticketID)
 	if err != nil {
 		return fmt.Errorf("failed to update ticket: %w", err)
 	}
@@ -387,6 +513,16 @@ func (r *Repository) UpdateTicket(ctx context.Context, ticketID int64, params Cr
 		}
 	}
 
+	if err := indexTicket(ctx, tx, ticketID, params.Title, params.Description); err != nil {
+		return err
+	}
+
+	if changes := diffTicketParams(current, &params); len(changes) > 0 {
+		if err := recordTicketEvent(ctx, tx, ticketID, updater, TicketEventTicketUpdated, noTarget(), changes); err != nil {
+			return err
+		}
+	}
+
 	if err := tx.Commit(); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
@@ -394,9 +530,65 @@ func (r *Repository) UpdateTicket(ctx context.Context, ticketID int64, params Cr
 	return nil
 }
 
-func (r *Repository) DeleteTicket(ctx context.Context, ticketID int64) error {
-	res, err := r.db.ExecContext(ctx, `
-		UPDATE tickets SET deleted_at = CURRENT_TIMESTAMP WHERE id = ?
+// getTicketParamsForUpdate : 更新前のチケットを行ロックを取って取得
+func getTicketParamsForUpdate(ctx context.Context, tx *sqlx.Tx, ticketID int64) (*CreateTicketParams, error) {
+	var ticket struct {
+		Title        string         `db:"title"`
+		Description  sql.NullString `db:"description"`
+		Status       string         `db:"status"`
+		ManualStatus bool           `db:"manual_status"`
+		Visibility   string         `db:"visibility"`
+		Assignee     string         `db:"assignee"`
+		Due          sql.NullTime   `db:"due"`
+	}
+	if err := tx.GetContext(ctx, &ticket, `
+		SELECT title, description, status, manual_status, visibility, assignee, due FROM tickets WHERE id = ? AND deleted_at IS NULL FOR UPDATE
+	`, ticketID); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, ErrTicketNotFound
+		}
+
+		return nil, fmt.Errorf("failed to select ticket: %w", err)
+	}
+
+	params := &CreateTicketParams{
+		Title:        ticket.Title,
+		Description:  ticket.Description,
+		Status:       ticket.Status,
+		ManualStatus: ticket.ManualStatus,
+		Assignee:     ticket.Assignee,
+		SubAssignees: []string{},
+		Stakeholders: []string{},
+		Due:          ticket.Due,
+		Tags:         []string{},
+		Visibility:   ticket.Visibility,
+	}
+	if err := tx.SelectContext(ctx, &params.SubAssignees, "SELECT sub_assignee FROM ticket_sub_assignees WHERE ticket_id = ?", ticketID); err != nil {
+		return nil, fmt.Errorf("failed to select sub_assignees: %w", err)
+	}
+	if err := tx.SelectContext(ctx, &params.Stakeholders, "SELECT stakeholder FROM ticket_stakeholders WHERE ticket_id = ?", ticketID); err != nil {
+		return nil, fmt.Errorf("failed to select stakeholders: %w", err)
+	}
+	if err := tx.SelectContext(ctx, &params.Tags, "SELECT tag FROM ticket_tags WHERE ticket_id = ?", ticketID); err != nil {
+		return nil, fmt.Errorf("failed to select tags: %w", err)
+	}
+
+	return params, nil
+}
+
+func (r *Repository) DeleteTicket(ctx context.Context, ticketID int64, deleter string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			fmt.Printf("failed to rollback: %v\n", err)
+		}
+	}()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE tickets SET deleted_at = CURRENT_TIMESTAMP WHERE id = ? AND deleted_at IS NULL
 	`, ticketID)
 	if err != nil {
 		return fmt.Errorf("failed to delete ticket: %w", err)
@@ -409,5 +601,13 @@ func (r *Repository) DeleteTicket(ctx context.Context, ticketID int64) error {
 		return ErrTicketNotFound
 	}
 
+	if err := recordTicketEvent(ctx, tx, ticketID, deleter, TicketEventTicketDeleted, noTarget(), nil); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
 	return nil
 }
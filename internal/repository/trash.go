@@ -0,0 +1,130 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+
+	"github.com/jmoiron/sqlx"
+)
+
+// GetTrashedTickets : 削除済み (ゴミ箱にある) チケットを削除日時の新しい順に取得
+func (r *Repository) GetTrashedTickets(ctx context.Context) ([]*Ticket, error) {
+	query := ticketsSelectQuery + `
+		WHERE t.deleted_at IS NOT NULL
+		GROUP BY t.id
+		ORDER BY t.deleted_at DESC, t.id DESC`
+
+	return r.queryTickets(ctx, query)
+}
+
+// RestoreTicket : 削除済みのチケットを元に戻す
+func (r *Repository) RestoreTicket(ctx context.Context, ticketID int64, restorer string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			fmt.Printf("failed to rollback: %v\n", err)
+		}
+	}()
+
+	res, err := tx.ExecContext(ctx, `
+		UPDATE tickets SET deleted_at = NULL WHERE id = ? AND deleted_at IS NOT NULL
+	`, ticketID)
+	if err != nil {
+		return fmt.Errorf("failed to restore ticket: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrTicketNotFound
+	}
+
+	if err := recordTicketEvent(ctx, tx, ticketID, restorer, TicketEventTicketRestored, noTarget(), nil); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeTicket : 削除済みのチケットを完全に削除する
+// ノート・レビューは外部キーの ON DELETE CASCADE で削除され、変更履歴は変更前後の値を消して監査のため残す
+func (r *Repository) PurgeTicket(ctx context.Context, ticketID int64) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			fmt.Printf("failed to rollback: %v\n", err)
+		}
+	}()
+
+	if err := purgeTicket(ctx, tx, ticketID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// PurgeExpiredTickets : 削除から retentionDays 日以上経過したチケットを完全に削除し、削除した件数を返す
+func (r *Repository) PurgeExpiredTickets(ctx context.Context, retentionDays int) (int64, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			fmt.Printf("failed to rollback: %v\n", err)
+		}
+	}()
+
+	var ticketIDs []int64
+	if err := tx.SelectContext(ctx, &ticketIDs, `
+		SELECT id FROM tickets WHERE deleted_at IS NOT NULL AND deleted_at <= CURRENT_TIMESTAMP - INTERVAL ? DAY FOR UPDATE
+	`, retentionDays); err != nil {
+		return 0, fmt.Errorf("failed to get expired tickets: %w", err)
+	}
+	for _, ticketID := range ticketIDs {
+		if err := purgeTicket(ctx, tx, ticketID); err != nil {
+			return 0, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return int64(len(ticketIDs)), nil
+}
+
+// purgeTicket : 削除済みのチケットを削除し、変更履歴の変更前後の値を消す
+func purgeTicket(ctx context.Context, tx *sqlx.Tx, ticketID int64) error {
+	res, err := tx.ExecContext(ctx, `
+		DELETE FROM tickets WHERE id = ? AND deleted_at IS NOT NULL
+	`, ticketID)
+	if err != nil {
+		return fmt.Errorf("failed to purge ticket: %w", err)
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to get rows affected: %w", err)
+	}
+	if rowsAffected == 0 {
+		return ErrTicketNotFound
+	}
+
+	return redactTicketEvents(ctx, tx, ticketID)
+}
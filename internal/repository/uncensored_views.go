@@ -0,0 +1,101 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	DefaultUncensoredViewsLimit = 100
+	MaxUncensoredViewsLimit     = 1000
+)
+
+type (
+	// UncensoredViewTarget : 伏字を伏せずに返したテキストの場所 (ノートの本文・レビューのコメントの場合は NoteID を持つ)
+	UncensoredViewTarget struct {
+		TicketID int64
+		NoteID   sql.NullInt64
+	}
+
+	// UncensoredView : 伏字を伏せずに返した記録
+	UncensoredView struct {
+		ID        int64         `db:"id"`
+		Actor     string        `db:"actor"`
+		TicketID  int64         `db:"ticket_id"`
+		NoteID    sql.NullInt64 `db:"note_id"`
+		Endpoint  string        `db:"endpoint"`
+		CreatedAt time.Time     `db:"created_at"`
+	}
+
+	GetUncensoredViewsParams struct {
+		Actor string
+		Since sql.NullTime
+		Until sql.NullTime
+		Limit int
+	}
+)
+
+// RecordUncensoredViews : 伏字を伏せずに返したことを記録する
+func (r *Repository) RecordUncensoredViews(ctx context.Context, actor, endpoint string, targets []UncensoredViewTarget) error {
+	if len(targets) == 0 {
+		return nil
+	}
+
+	placeholders := make([]string, 0, len(targets))
+	args := make([]any, 0, len(targets)*4)
+	for _, target := range targets {
+		placeholders = append(placeholders, "(?, ?, ?, ?)")
+		args = append(args, actor, target.TicketID, target.NoteID, endpoint)
+	}
+
+	if _, err := r.db.ExecContext(ctx, `
+		INSERT INTO uncensored_view_logs (actor, ticket_id, note_id, endpoint) VALUES `+strings.Join(placeholders, ", "),
+		args...,
+	); err != nil {
+		return fmt.Errorf("insert uncensored view logs: %w", err)
+	}
+
+	return nil
+}
+
+// GetUncensoredViews : 伏字を伏せずに返した記録を新しい順に取得
+// Since・Until はその時刻を含む
+func (r *Repository) GetUncensoredViews(ctx context.Context, params GetUncensoredViewsParams) ([]*UncensoredView, error) {
+	conditions := []string{"1 = 1"}
+	args := []any{}
+	if params.Actor != "" {
+		conditions = append(conditions, "actor = ?")
+		args = append(args, params.Actor)
+	}
+	if params.Since.Valid {
+		conditions = append(conditions, "created_at >= ?")
+		args = append(args, params.Since.Time)
+	}
+	if params.Until.Valid {
+		conditions = append(conditions, "created_at <= ?")
+		args = append(args, params.Until.Time)
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = DefaultUncensoredViewsLimit
+	}
+	limit = min(limit, MaxUncensoredViewsLimit)
+	args = append(args, limit)
+
+	views := []*UncensoredView{}
+	if err := r.db.SelectContext(ctx, &views, `
+		SELECT id, actor, ticket_id, note_id, endpoint, created_at
+		FROM uncensored_view_logs
+		WHERE `+strings.Join(conditions, " AND ")+`
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
+	`, args...); err != nil {
+		return nil, fmt.Errorf("select uncensored view logs: %w", err)
+	}
+
+	return views, nil
+}
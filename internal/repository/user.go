@@ -5,6 +5,17 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/authz"
+)
+
+const (
+	DefaultRoleChangesLimit = 100
+	MaxRoleChangesLimit     = 1000
 )
 
 type (
@@ -12,9 +23,31 @@ type (
 		TraqID string `db:"traq_id"`
 		Role   string `db:"role"`
 	}
+
+	// RoleChange : ユーザーの追加・ロールの変更・削除の記録
+	// 追加の場合は BeforeRole、削除の場合は AfterRole が NULL になる
+	RoleChange struct {
+		ID         int64          `db:"id"`
+		TraqID     string         `db:"traq_id"`
+		Actor      string         `db:"actor"`
+		BeforeRole sql.NullString `db:"before_role"`
+		AfterRole  sql.NullString `db:"after_role"`
+		CreatedAt  time.Time      `db:"created_at"`
+	}
+
+	GetRoleChangesParams struct {
+		// TraqID : 指定した場合はそのユーザーの記録のみ
+		TraqID string
+		Limit  int
+	}
 )
 
-var ErrUserNotFound = fmt.Errorf("user not found")
+var (
+	ErrUserNotFound      = fmt.Errorf("user not found")
+	ErrUserAlreadyExists = fmt.Errorf("user already exists")
+	// ErrNoManager : 変更すると本職が 1 人もいなくなる (誰でもユーザーを変更できる初期設定の状態に戻ってしまう)
+	ErrNoManager = fmt.Errorf("user change would leave no manager")
+)
 
 func (r *Repository) GetUsers(ctx context.Context) ([]*User, error) {
 	users := []*User{}
@@ -25,49 +58,274 @@ func (r *Repository) GetUsers(ctx context.Context) ([]*User, error) {
 	return users, nil
 }
 
-func (r *Repository) UpdateUsers(ctx context.Context, users []*User) (err error) {
-	tx, err := r.db.BeginTxx(ctx, nil)
+func (r *Repository) GetUserRoleByTraqID(ctx context.Context, traqID string) (string, error) {
+	var role string
+	err := r.db.GetContext(ctx, &role, "SELECT role FROM users WHERE traq_id = ?", traqID)
 	if err != nil {
-		return fmt.Errorf("begin transaction: %w", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("get user role by traq id: %w", err)
 	}
-	defer func() {
-		if err != nil {
-			_ = tx.Rollback()
+
+	return role, nil
+}
+
+// HasManager : 本職が1人以上登録されているか
+func (r *Repository) HasManager(ctx context.Context) (bool, error) {
+	var exists bool
+	if err := r.db.GetContext(ctx, &exists, "SELECT EXISTS (SELECT 1 FROM users WHERE role = 'manager')"); err != nil {
+		return false, fmt.Errorf("select manager existence: %w", err)
+	}
+
+	return exists, nil
+}
+
+// CreateUser : ユーザーを追加し、変更を記録する
+func (r *Repository) CreateUser(ctx context.Context, actor string, user *User) (*RoleChange, error) {
+	var change *RoleChange
+	err := r.withUsersLocked(ctx, func(tx *sqlx.Tx, current map[string]string) error {
+		if _, ok := current[user.TraqID]; ok {
+			return ErrUserAlreadyExists
 		}
-	}()
 
-	_, err = tx.ExecContext(ctx, "DELETE FROM users")
+		var err error
+		change, err = applyRoleChange(ctx, tx, actor, user.TraqID, "", user.Role)
+
+		return err
+	})
 	if err != nil {
-		return fmt.Errorf("delete all users: %w", err)
+		return nil, err
 	}
 
-	if len(users) == 0 {
-		err = tx.Commit()
+	return change, nil
+}
+
+// UpdateUserRole : ユーザーのロールを変更し、変更を記録する (ロールが変わらない場合は記録しない)
+func (r *Repository) UpdateUserRole(ctx context.Context, actor string, user *User) (*RoleChange, error) {
+	var change *RoleChange
+	err := r.withUsersLocked(ctx, func(tx *sqlx.Tx, current map[string]string) error {
+		before, ok := current[user.TraqID]
+		if !ok {
+			return ErrUserNotFound
+		}
+
+		var err error
+		change, err = applyRoleChange(ctx, tx, actor, user.TraqID, before, user.Role)
 
 		return err
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	query := "INSERT INTO users (traq_id, role) VALUES (:traq_id, :role)"
-	_, err = tx.NamedExecContext(ctx, query, users)
+	return change, nil
+}
+
+// DeleteUser : ユーザーを削除し、変更を記録する
+func (r *Repository) DeleteUser(ctx context.Context, actor, traqID string) error {
+	return r.withUsersLocked(ctx, func(tx *sqlx.Tx, current map[string]string) error {
+		before, ok := current[traqID]
+		if !ok {
+			return ErrUserNotFound
+		}
+
+		_, err := applyRoleChange(ctx, tx, actor, traqID, before, "")
+
+		return err
+	})
+}
+
+// SyncUsers : ユーザー一覧を users と同じにし、適用した変更を返す
+// users にないユーザーは削除し、ロールが変わらないユーザーは記録しない
+func (r *Repository) SyncUsers(ctx context.Context, actor string, users []*User) ([]*RoleChange, error) {
+	changes := []*RoleChange{}
+	err := r.withUsersLocked(ctx, func(tx *sqlx.Tx, current map[string]string) error {
+		for _, planned := range planUserSync(current, users) {
+			change, err := applyRoleChange(ctx, tx, actor, planned.TraqID, planned.BeforeRole.String, planned.AfterRole.String)
+			if err != nil {
+				return err
+			}
+			changes = append(changes, change)
+		}
+
+		return nil
+	})
 	if err != nil {
-		return fmt.Errorf("bulk insert users: %w", err)
+		return nil, err
 	}
 
-	err = tx.Commit()
+	return changes, nil
+}
 
-	return err
+// PreviewSyncUsers : SyncUsers で適用される変更を、適用せずに返す (ID・変更者・日時は空になる)
+func (r *Repository) PreviewSyncUsers(ctx context.Context, users []*User) ([]*RoleChange, error) {
+	currentUsers, err := r.GetUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	current := make(map[string]string, len(currentUsers))
+	for _, user := range currentUsers {
+		current[user.TraqID] = user.Role
+	}
+
+	return planUserSync(current, users), nil
 }
 
-func (r *Repository) GetUserRoleByTraqID(ctx context.Context, traqID string) (string, error) {
-	var role string
-	err := r.db.GetContext(ctx, &role, "SELECT role FROM users WHERE traq_id = ?", traqID)
+// planUserSync : ユーザー一覧を current から users にするための変更を traQ ID 順に返す
+func planUserSync(current map[string]string, users []*User) []*RoleChange {
+	desired := make(map[string]string, len(users))
+	for _, user := range users {
+		desired[user.TraqID] = user.Role
+	}
+
+	traqIDs := make([]string, 0, len(current)+len(desired))
+	for traqID := range current {
+		traqIDs = append(traqIDs, traqID)
+	}
+	for traqID := range desired {
+		if _, ok := current[traqID]; !ok {
+			traqIDs = append(traqIDs, traqID)
+		}
+	}
+	slices.Sort(traqIDs)
+
+	changes := []*RoleChange{}
+	for _, traqID := range traqIDs {
+		before, after := current[traqID], desired[traqID]
+		if before == after {
+			continue
+		}
+		changes = append(changes, &RoleChange{
+			ID:         0,
+			TraqID:     traqID,
+			Actor:      "",
+			BeforeRole: sql.NullString{String: before, Valid: before != ""},
+			AfterRole:  sql.NullString{String: after, Valid: after != ""},
+			CreatedAt:  time.Time{},
+		})
+	}
+
+	return changes
+}
+
+// GetRoleChanges : ユーザーの追加・ロールの変更・削除の記録を新しい順に取得
+func (r *Repository) GetRoleChanges(ctx context.Context, params GetRoleChangesParams) ([]*RoleChange, error) {
+	conditions := []string{"1 = 1"}
+	args := []any{}
+	if params.TraqID != "" {
+		conditions = append(conditions, "traq_id = ?")
+		args = append(args, params.TraqID)
+	}
+
+	limit := params.Limit
+	if limit <= 0 {
+		limit = DefaultRoleChangesLimit
+	}
+	limit = min(limit, MaxRoleChangesLimit)
+	args = append(args, limit)
+
+	changes := []*RoleChange{}
+	if err := r.db.SelectContext(ctx, &changes, `
+		SELECT id, traq_id, actor, before_role, after_role, created_at
+		FROM user_role_changes
+		WHERE `+strings.Join(conditions, " AND ")+`
+		ORDER BY created_at DESC, id DESC
+		LIMIT ?
+	`, args...); err != nil {
+		return nil, fmt.Errorf("select user role changes: %w", err)
+	}
+
+	return changes, nil
+}
+
+// withUsersLocked : ユーザー一覧の行ロックを取ったトランザクションで fn を実行する
+// current は traQ ID からロールへの対応で、fn がエラーを返した場合はロールバックする
+// 本職がいる状態から 1 人もいなくなる変更は ErrNoManager を返してロールバックする
+func (r *Repository) withUsersLocked(ctx context.Context, fn func(tx *sqlx.Tx, current map[string]string) error) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
 	if err != nil {
-		if errors.Is(err, sql.ErrNoRows) {
-			return "", nil
+		return fmt.Errorf("begin transaction: %w", err)
+	}
+	defer func() {
+		if err := tx.Rollback(); err != nil && err != sql.ErrTxDone {
+			fmt.Printf("failed to rollback: %v\n", err)
 		}
+	}()
 
-		return "", fmt.Errorf("get user role by traq id: %w", err)
+	users := []*User{}
+	if err := tx.SelectContext(ctx, &users, "SELECT traq_id, role FROM users FOR UPDATE"); err != nil {
+		return fmt.Errorf("select users for update: %w", err)
+	}
+	current := make(map[string]string, len(users))
+	hadManager := false
+	for _, user := range users {
+		current[user.TraqID] = user.Role
+		hadManager = hadManager || user.Role == authz.RoleManager
 	}
 
-	return role, nil
+	if err := fn(tx, current); err != nil {
+		return err
+	}
+
+	if hadManager {
+		var hasManager bool
+		if err := tx.GetContext(ctx, &hasManager, "SELECT EXISTS (SELECT 1 FROM users WHERE role = 'manager')"); err != nil {
+			return fmt.Errorf("select manager existence: %w", err)
+		}
+		if !hasManager {
+			return ErrNoManager
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// applyRoleChange : ユーザーのロールを before から after に変更し、変更を記録する
+// before が空の場合は追加、after が空の場合は削除になり、変更がない場合は nil を返す
+func applyRoleChange(ctx context.Context, tx *sqlx.Tx, actor, traqID, before, after string) (*RoleChange, error) {
+	if before == after {
+		return nil, nil
+	}
+
+	var err error
+	switch {
+	case before == "":
+		_, err = tx.ExecContext(ctx, "INSERT INTO users (traq_id, role) VALUES (?, ?)", traqID, after)
+	case after == "":
+		_, err = tx.ExecContext(ctx, "DELETE FROM users WHERE traq_id = ?", traqID)
+	default:
+		_, err = tx.ExecContext(ctx, "UPDATE users SET role = ? WHERE traq_id = ?", after, traqID)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("change role of user %s: %w", traqID, err)
+	}
+
+	change := &RoleChange{
+		ID:         0,
+		TraqID:     traqID,
+		Actor:      actor,
+		BeforeRole: sql.NullString{String: before, Valid: before != ""},
+		AfterRole:  sql.NullString{String: after, Valid: after != ""},
+		CreatedAt:  time.Time{},
+	}
+	res, err := tx.ExecContext(ctx, `
+		INSERT INTO user_role_changes (traq_id, actor, before_role, after_role) VALUES (?, ?, ?, ?)
+	`, change.TraqID, change.Actor, change.BeforeRole, change.AfterRole)
+	if err != nil {
+		return nil, fmt.Errorf("insert user role change: %w", err)
+	}
+	if change.ID, err = res.LastInsertId(); err != nil {
+		return nil, fmt.Errorf("get last insert id: %w", err)
+	}
+	if err := tx.GetContext(ctx, &change.CreatedAt, "SELECT created_at FROM user_role_changes WHERE id = ?", change.ID); err != nil {
+		return nil, fmt.Errorf("select user role change: %w", err)
+	}
+
+	return change, nil
 }
@@ -0,0 +1,47 @@
+package auth
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+const (
+	// SessionCookieName はセッションを保持する Cookie の名前
+	SessionCookieName = "anshin_session"
+	// StateCookieName はログインを開始したブラウザに OAuth2 の state を結び付ける Cookie の名前
+	StateCookieName = "anshin_oauth_state"
+)
+
+type Config struct {
+	// Provider は OAuth2 のログインに使う Provider (nil の場合は OAuth2 でログインできない)
+	Provider Provider
+	// SessionTTL はセッションの有効期間
+	SessionTTL time.Duration
+	// CookieSecure は Cookie に Secure 属性を付与するか
+	CookieSecure bool
+	// LoginRedirectURL はログイン後のリダイレクト先
+	LoginRedirectURL string
+	// TrustedProxyCIDRs は X-Forwarded-User ヘッダーを信頼するプロキシの CIDR (空の場合はヘッダーを使わない)
+	TrustedProxyCIDRs []string
+}
+
+// NewToken はセッション・state に使うランダムなトークンを生成する
+func NewToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// HashToken はトークンを保存用にハッシュ化する
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,30 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// FakeProvider はテスト用の Provider
+// 認可コードをそのままログインしたユーザーの traQ ID として扱う
+type FakeProvider struct{}
+
+var _ Provider = (*FakeProvider)(nil)
+
+// NewFakeProvider はテスト用の Provider を作成する
+func NewFakeProvider() *FakeProvider {
+	return &FakeProvider{}
+}
+
+func (p *FakeProvider) AuthCodeURL(state string) string {
+	return "https://fake.invalid/oauth2/authorize?state=" + url.QueryEscape(state)
+}
+
+func (p *FakeProvider) Exchange(_ context.Context, code string) (string, error) {
+	if code == "" {
+		return "", fmt.Errorf("failed to exchange code: empty code")
+	}
+
+	return code, nil
+}
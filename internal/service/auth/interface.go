@@ -0,0 +1,14 @@
+package auth
+
+import (
+	"context"
+)
+
+// Provider は OAuth2 の認可コードフローを抽象化したインターフェース
+// テスト時には FakeProvider に差し替えることで、実際の traQ との通信を避けられる
+type Provider interface {
+	// AuthCodeURL は state を付与した認可画面の URL を返す
+	AuthCodeURL(state string) string
+	// Exchange は認可コードをトークンに交換し、ログインしたユーザーの traQ ID を返す
+	Exchange(ctx context.Context, code string) (string, error)
+}
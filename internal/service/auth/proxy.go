@@ -0,0 +1,61 @@
+package auth
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+)
+
+// ForwardedUserHeader は信頼できるプロキシが traQ ID を付与するヘッダー
+const ForwardedUserHeader = "X-Forwarded-User"
+
+// ParseCIDRs は CIDR 表記のリストを解析する
+func ParseCIDRs(cidrs []string) ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cidrs))
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy CIDR %q: %w", cidr, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+// TrustedProxy は接続元が信頼できるプロキシでないリクエストから X-Forwarded-User ヘッダーを取り除く
+// prefixes が空の場合はすべてのリクエストから取り除く
+func TrustedProxy(next http.Handler, prefixes []netip.Prefix) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(ForwardedUserHeader) != "" && !isTrusted(r.RemoteAddr, prefixes) {
+			r.Header.Del(ForwardedUserHeader)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func isTrusted(remoteAddr string, prefixes []netip.Prefix) bool {
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return false
+	}
+	addr = addr.Unmap()
+
+	for _, prefix := range prefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
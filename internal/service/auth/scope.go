@@ -0,0 +1,33 @@
+package auth
+
+// Scope は個人用APIトークンで実行できる操作の範囲
+// いずれのスコープでもトークンを発行したユーザーの権限を超える操作はできない
+type Scope string
+
+const (
+	// ScopeRead は読み取りのみ
+	ScopeRead Scope = "read"
+	// ScopeTicketsWrite はチケット・ノート・レビューの作成・編集・削除まで (ゴミ箱からの復元・完全削除は含まない)
+	ScopeTicketsWrite Scope = "tickets:write"
+	// ScopeAdmin はすべての操作
+	ScopeAdmin Scope = "admin"
+)
+
+// APITokenPrefix は個人用APIトークンの先頭に付ける文字列 (漏洩時に検出しやすくするため)
+const APITokenPrefix = "anshin_"
+
+var scopeLevels = map[Scope]int{
+	ScopeRead:         1,
+	ScopeTicketsWrite: 2,
+	ScopeAdmin:        3,
+}
+
+// Allows は required のスコープが必要な操作をこのスコープで実行できるか
+func (s Scope) Allows(required Scope) bool {
+	level, ok := scopeLevels[s]
+	if !ok {
+		return false
+	}
+
+	return level >= scopeLevels[required]
+}
@@ -0,0 +1,68 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/traPtitech/go-traq"
+	"golang.org/x/oauth2"
+)
+
+type TraQConfig struct {
+	Origin       string
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+}
+
+// TraQProvider は traQ の OAuth2 で認証する Provider
+type TraQProvider struct {
+	origin string
+	oauth  *oauth2.Config
+}
+
+var _ Provider = (*TraQProvider)(nil)
+
+func NewTraQProvider(cfg TraQConfig) (*TraQProvider, error) {
+	if cfg.Origin == "" || cfg.ClientID == "" {
+		return nil, fmt.Errorf("traQ OAuth config is incomplete: origin and client ID are required")
+	}
+
+	return &TraQProvider{
+		origin: cfg.Origin,
+		oauth: &oauth2.Config{
+			ClientID:     cfg.ClientID,
+			ClientSecret: cfg.ClientSecret,
+			Endpoint: oauth2.Endpoint{
+				AuthURL:       cfg.Origin + "/api/v3/oauth2/authorize",
+				DeviceAuthURL: "",
+				TokenURL:      cfg.Origin + "/api/v3/oauth2/token",
+				AuthStyle:     oauth2.AuthStyleInParams,
+			},
+			RedirectURL: cfg.RedirectURL,
+			Scopes:      []string{"read"},
+		},
+	}, nil
+}
+
+func (p *TraQProvider) AuthCodeURL(state string) string {
+	return p.oauth.AuthCodeURL(state)
+}
+
+func (p *TraQProvider) Exchange(ctx context.Context, code string) (string, error) {
+	token, err := p.oauth.Exchange(ctx, code)
+	if err != nil {
+		return "", fmt.Errorf("failed to exchange code: %w", err)
+	}
+
+	cfg := traq.NewConfiguration()
+	cfg.Servers = traq.ServerConfigurations{{URL: p.origin + "/api/v3", Description: "", Variables: nil}}
+	cfg.HTTPClient = p.oauth.Client(ctx, token)
+
+	me, _, err := traq.NewAPIClient(cfg).MeAPI.GetMe(ctx).Execute()
+	if err != nil {
+		return "", fmt.Errorf("failed to get me: %w", err)
+	}
+
+	return me.Name, nil
+}
@@ -0,0 +1,161 @@
+package authz
+
+import "slices"
+
+const (
+	RoleManager   = "manager"
+	RoleAssistant = "assistant"
+	RoleMember    = "member"
+)
+
+type (
+	// Action : 権限を確認する操作
+	Action string
+
+	// Relation : 操作対象のチケット・ノートとユーザーの関係 (複数を組み合わせられる)
+	Relation uint8
+
+	// Rule : 操作を実行できるユーザー
+	// Roles のいずれかのロールを持つか、Relations のいずれかの関係を持つ場合に実行できる
+	Rule struct {
+		// AnyUser : 認証済みであれば (ユーザー登録されていなくても) 実行できる
+		AnyUser   bool
+		Roles     []string
+		Relations Relation
+	}
+)
+
+const (
+	// ActionPublic : ログインなど認証前の操作
+	ActionPublic Action = "public"
+	// ActionSelf : 自分の情報・APIトークンの操作
+	ActionSelf Action = "self"
+	// ActionView : チケット・ノート・レビュー・ユーザーの閲覧と検索
+	ActionView Action = "view"
+	// ActionCreateTicket : チケットの作成
+	ActionCreateTicket Action = "create_ticket"
+	// ActionEditTicket : チケットの編集、ノートの作成、AI の利用
+	ActionEditTicket Action = "edit_ticket"
+	// ActionEditNote : ノートの編集・削除・ステータスの変更・レビュー依頼
+	ActionEditNote Action = "edit_note"
+	// ActionReview : レビューの作成・編集・削除 (編集・削除は自分のレビューのみ)
+	ActionReview Action = "review"
+	// ActionDeleteTicket : チケットをゴミ箱に移動
+	ActionDeleteTicket Action = "delete_ticket"
+	// ActionManageTrash : ゴミ箱の閲覧・復元・完全削除
+	ActionManageTrash Action = "manage_trash"
+	// ActionManageUsers : ユーザーのロールの変更
+	ActionManageUsers Action = "manage_users"
+	// ActionManageConfig : 設定・伏字の閲覧ルールの変更、個人情報のスキャン、監査ログの閲覧
+	ActionManageConfig Action = "manage_config"
+)
+
+// チケットの公開範囲
+const (
+	// VisibilityPublic : 全員が閲覧できる
+	VisibilityPublic = "public"
+	// VisibilityInvolved : 本職と担当者・副担当者・関係者のみ閲覧できる
+	VisibilityInvolved = "involved"
+	// VisibilityManagers : 本職のみ閲覧できる
+	VisibilityManagers = "managers"
+)
+
+const (
+	// RelationStakeholder : チケットの関係者
+	RelationStakeholder Relation = 1 << iota
+	// RelationAssignee : チケットの担当者・副担当者
+	RelationAssignee
+	// RelationAuthor : ノートの作成者
+	RelationAuthor
+)
+
+// Policy : 操作ごとの実行できるユーザー
+var Policy = map[Action]Rule{
+	ActionPublic: {AnyUser: true, Roles: nil, Relations: 0},
+	ActionSelf:   {AnyUser: true, Roles: nil, Relations: 0},
+	ActionView:   {AnyUser: true, Roles: nil, Relations: 0},
+	ActionCreateTicket: {
+		AnyUser:   false,
+		Roles:     []string{RoleManager, RoleAssistant},
+		Relations: 0,
+	},
+	ActionEditTicket: {
+		AnyUser:   false,
+		Roles:     []string{RoleManager, RoleAssistant},
+		Relations: RelationStakeholder | RelationAssignee,
+	},
+	ActionEditNote: {
+		AnyUser:   false,
+		Roles:     []string{RoleManager},
+		Relations: RelationAuthor,
+	},
+	ActionReview: {
+		AnyUser:   false,
+		Roles:     []string{RoleManager, RoleAssistant, RoleMember},
+		Relations: 0,
+	},
+	ActionDeleteTicket: {AnyUser: false, Roles: []string{RoleManager}, Relations: 0},
+	ActionManageTrash:  {AnyUser: false, Roles: []string{RoleManager}, Relations: 0},
+	ActionManageUsers:  {AnyUser: false, Roles: []string{RoleManager}, Relations: 0},
+	ActionManageConfig: {AnyUser: false, Roles: []string{RoleManager}, Relations: 0},
+}
+
+// Allowed : ロール・操作対象との関係から操作を実行できるか (ルールのない操作は実行できない)
+func Allowed(role string, relations Relation, action Action) bool {
+	rule, ok := Policy[action]
+	if !ok {
+		return false
+	}
+	if rule.AnyUser {
+		return true
+	}
+
+	return slices.Contains(rule.Roles, role) || relations&rule.Relations != 0
+}
+
+// NeedsRelations : ロールだけでは判断できず、操作対象との関係を調べる必要があるか
+func NeedsRelations(role string, action Action) bool {
+	rule, ok := Policy[action]
+	if !ok || rule.AnyUser {
+		return false
+	}
+
+	return rule.Relations != 0 && !slices.Contains(rule.Roles, role)
+}
+
+// TicketRelations : チケットとユーザーの関係
+func TicketRelations(userID, assignee string, subAssignees, stakeholders []string) Relation {
+	var relations Relation
+	if userID == "" {
+		return relations
+	}
+	if assignee == userID || slices.Contains(subAssignees, userID) {
+		relations |= RelationAssignee
+	}
+	if slices.Contains(stakeholders, userID) {
+		relations |= RelationStakeholder
+	}
+
+	return relations
+}
+
+// TicketVisible : チケットの公開範囲から、ユーザーがチケットの存在を知ってよいか (本職は常に閲覧できる)
+func TicketVisible(role string, relations Relation, visibility string) bool {
+	if role == RoleManager {
+		return true
+	}
+
+	switch visibility {
+	case VisibilityPublic:
+		return true
+	case VisibilityInvolved:
+		return relations&(RelationStakeholder|RelationAssignee) != 0
+	default:
+		return false
+	}
+}
+
+// CanSetVisibility : チケットの公開範囲を指定できるか (本職のみの公開範囲は本職しか指定できない)
+func CanSetVisibility(role string, visibility string) bool {
+	return visibility != VisibilityManagers || role == RoleManager
+}
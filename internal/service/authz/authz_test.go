@@ -0,0 +1,118 @@
+package authz
+
+import "testing"
+
+func TestAllowed(t *testing.T) {
+	const (
+		T = true
+		F = false
+	)
+
+	roles := []string{RoleManager, RoleAssistant, RoleMember, ""}
+	relations := []Relation{0, RelationStakeholder, RelationAssignee, RelationAuthor}
+
+	// ロール (行: 本職・補佐・部員・未登録) × 関係 (列: なし・関係者・担当者・作成者) ごとに実行できるか
+	matrix := map[Action][4][4]bool{
+		ActionPublic:       {{T, T, T, T}, {T, T, T, T}, {T, T, T, T}, {T, T, T, T}},
+		ActionSelf:         {{T, T, T, T}, {T, T, T, T}, {T, T, T, T}, {T, T, T, T}},
+		ActionView:         {{T, T, T, T}, {T, T, T, T}, {T, T, T, T}, {T, T, T, T}},
+		ActionCreateTicket: {{T, T, T, T}, {T, T, T, T}, {F, F, F, F}, {F, F, F, F}},
+		ActionEditTicket:   {{T, T, T, T}, {T, T, T, T}, {F, T, T, F}, {F, T, T, F}},
+		ActionEditNote:     {{T, T, T, T}, {F, F, F, T}, {F, F, F, T}, {F, F, F, T}},
+		ActionReview:       {{T, T, T, T}, {T, T, T, T}, {T, T, T, T}, {F, F, F, F}},
+		ActionDeleteTicket: {{T, T, T, T}, {F, F, F, F}, {F, F, F, F}, {F, F, F, F}},
+		ActionManageTrash:  {{T, T, T, T}, {F, F, F, F}, {F, F, F, F}, {F, F, F, F}},
+		ActionManageUsers:  {{T, T, T, T}, {F, F, F, F}, {F, F, F, F}, {F, F, F, F}},
+		ActionManageConfig: {{T, T, T, T}, {F, F, F, F}, {F, F, F, F}, {F, F, F, F}},
+		Action("unknown"):  {{F, F, F, F}, {F, F, F, F}, {F, F, F, F}, {F, F, F, F}},
+	}
+
+	for action, want := range matrix {
+		for i, role := range roles {
+			for j, relation := range relations {
+				if got := Allowed(role, relation, action); got != want[i][j] {
+					t.Errorf("Allowed(%q, %d, %q) = %v, want %v", role, relation, action, got, want[i][j])
+				}
+			}
+		}
+	}
+
+	for action := range Policy {
+		if _, ok := matrix[action]; !ok {
+			t.Errorf("action %q is not covered by the matrix", action)
+		}
+	}
+}
+
+func TestNeedsRelations(t *testing.T) {
+	tests := []struct {
+		role   string
+		action Action
+		want   bool
+	}{
+		{RoleManager, ActionEditTicket, false},
+		{RoleAssistant, ActionEditTicket, false},
+		{RoleMember, ActionEditTicket, true},
+		{"", ActionEditTicket, true},
+		{RoleManager, ActionEditNote, false},
+		{RoleAssistant, ActionEditNote, true},
+		{RoleMember, ActionDeleteTicket, false},
+		{RoleMember, ActionView, false},
+		{RoleMember, Action("unknown"), false},
+	}
+
+	for _, tt := range tests {
+		if got := NeedsRelations(tt.role, tt.action); got != tt.want {
+			t.Errorf("NeedsRelations(%q, %q) = %v, want %v", tt.role, tt.action, got, tt.want)
+		}
+	}
+}
+
+func TestTicketRelations(t *testing.T) {
+	tests := []struct {
+		userID string
+		want   Relation
+	}{
+		{"assignee", RelationAssignee},
+		{"sub", RelationAssignee},
+		{"stakeholder", RelationStakeholder},
+		{"both", RelationAssignee | RelationStakeholder},
+		{"other", 0},
+		{"", 0},
+	}
+
+	for _, tt := range tests {
+		got := TicketRelations(tt.userID, "assignee", []string{"sub", "both"}, []string{"stakeholder", "both"})
+		if got != tt.want {
+			t.Errorf("TicketRelations(%q) = %d, want %d", tt.userID, got, tt.want)
+		}
+	}
+}
+
+func TestTicketVisible(t *testing.T) {
+	const (
+		T = true
+		F = false
+	)
+
+	roles := []string{RoleManager, RoleAssistant, RoleMember, ""}
+	relations := []Relation{0, RelationStakeholder, RelationAssignee}
+
+	// ロール (行: 本職・補佐・部員・未登録) × 関係 (列: なし・関係者・担当者) ごとに閲覧できるか
+	matrix := map[string][4][3]bool{
+		VisibilityPublic:   {{T, T, T}, {T, T, T}, {T, T, T}, {T, T, T}},
+		VisibilityInvolved: {{T, T, T}, {F, T, T}, {F, T, T}, {F, T, T}},
+		VisibilityManagers: {{T, T, T}, {F, F, F}, {F, F, F}, {F, F, F}},
+		"unknown":          {{T, T, T}, {F, F, F}, {F, F, F}, {F, F, F}},
+	}
+
+	for visibility, want := range matrix {
+		for i, role := range roles {
+			for j, relation := range relations {
+				if got := TicketVisible(role, relation, visibility); got != want[i][j] {
+					t.Errorf("TicketVisible(%q, %d, %q) = %v, want %v", role, relation, visibility, got, want[i][j])
+				}
+			}
+		}
+	}
+}
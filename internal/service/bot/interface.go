@@ -30,6 +30,13 @@ type MessageSender interface {
 	PostDirectMessage(ctx context.Context, userID string, content string) error
 }
 
+// UserResolver は traQ ID からユーザー UUID への解決を抽象化したインターフェース
+// traQ のユーザー一覧をキャッシュする userdir.Directory が実装する
+type UserResolver interface {
+	// ResolveUserID は traQ ID に対応するユーザー UUID を返す
+	ResolveUserID(ctx context.Context, traqID string) (string, error)
+}
+
 // EventHandler は Bot イベントのハンドラを抽象化したインターフェース
 type EventHandler interface {
 	// OnMessageCreated はメッセージ作成イベントのハンドラを登録する
@@ -0,0 +1,181 @@
+package censor
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/traP-jp/anshin-techo-backend/internal/service/authz"
+)
+
+var (
+	// pattern : !!text!! または !!category:text!! にマッチする
+	pattern = regexp.MustCompile(`!!(?:([a-z][a-z0-9_]*):)?(.*?)!!`)
+	// placeholderPattern : !!■■■!! または !!category:■■■!! にマッチする
+	placeholderPattern = regexp.MustCompile(`!!(?:([a-z][a-z0-9_]*):)?■■■!!`)
+	// CategoryPattern : カテゴリ名として使える文字列
+	CategoryPattern = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)
+)
+
+// Replacement : 伏せ字の置換後フォーマット
+const Replacement = "!!■■■!!"
+
+type (
+	// Rule : 伏字のカテゴリを閲覧できるユーザー
+	Rule struct {
+		// Roles : 閲覧できるロール (本職は常に閲覧できる)
+		Roles []string
+		// Assignees : チケットの担当者・副担当者も閲覧できるか
+		Assignees bool
+	}
+
+	// Policy : 伏字のカテゴリごとの閲覧ルール
+	// カテゴリのない伏字とルールのないカテゴリは本職のみ閲覧できる
+	Policy map[string]Rule
+
+	// Viewer : 伏字を含むテキストを閲覧するユーザー
+	Viewer struct {
+		Role string
+		// Assignee : 閲覧するチケットの担当者・副担当者か
+		Assignee bool
+		Policy   Policy
+	}
+)
+
+// placeholder : カテゴリに応じた伏字の置換後の文字列
+func placeholder(category string) string {
+	if category == "" {
+		return Replacement
+	}
+
+	return "!!" + category + ":■■■!!"
+}
+
+// Content : 文字列内の !!text!! を !!■■■!! に、!!category:text!! を !!category:■■■!! に置換
+func Content(input string) string {
+	return pattern.ReplaceAllStringFunc(input, func(span string) string {
+		return placeholder(pattern.FindStringSubmatch(span)[1])
+	})
+}
+
+// CanSee : 伏字のカテゴリを閲覧できるか
+func (v Viewer) CanSee(category string) bool {
+	if v.Role == authz.RoleManager {
+		return true
+	}
+	if category == "" {
+		return false
+	}
+
+	rule, ok := v.Policy[category]
+	if !ok {
+		return false
+	}
+	if rule.Assignees && v.Assignee {
+		return true
+	}
+
+	return slices.Contains(rule.Roles, v.Role)
+}
+
+// Apply : 閲覧できないカテゴリの伏字を置換する
+func (v Viewer) Apply(input string) string {
+	return pattern.ReplaceAllStringFunc(input, func(span string) string {
+		category := pattern.FindStringSubmatch(span)[1]
+		if v.CanSee(category) {
+			return span
+		}
+
+		return placeholder(category)
+	})
+}
+
+// Reveals : 伏字を伏せずに閲覧するか (置換後の文字列のみの伏字は含めない)
+func (v Viewer) Reveals(input string) bool {
+	for _, match := range pattern.FindAllStringSubmatch(input, -1) {
+		if match[2] != "■■■" && v.CanSee(match[1]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// hiddenSpans : 閲覧できない伏字とそのカテゴリを出現順に返す
+func (v Viewer) hiddenSpans(input string) (spans, categories []string) {
+	spans, categories = []string{}, []string{}
+	for _, match := range pattern.FindAllStringSubmatch(input, -1) {
+		if !v.CanSee(match[1]) {
+			spans = append(spans, match[0])
+			categories = append(categories, match[1])
+		}
+	}
+
+	return spans, categories
+}
+
+// AmbiguousEditError : 伏字のまま編集されたテキストの伏字の数またはカテゴリが元のテキストと合わず、どの伏字を残すか決められない
+type AmbiguousEditError struct {
+	// Expected : 元のテキストの閲覧できない伏字の数
+	Expected int
+	// Actual : 編集後のテキストに含まれる伏字の置換後の文字列の数
+	Actual int
+	// CategoryMismatch : 伏字の数は合うが、カテゴリが元のテキストと順番に対応しない
+	CategoryMismatch bool
+	// DelimiterMismatch : 伏字を戻すと区切りの !! が元の伏字と別の位置で対応し、伏字の中身が伏字の外に出る
+	DelimiterMismatch bool
+}
+
+func (e *AmbiguousEditError) Error() string {
+	if e.CategoryMismatch {
+		return "ambiguous censored edit: placeholder categories do not match"
+	}
+	if e.DelimiterMismatch {
+		return "ambiguous censored edit: placeholder delimiters do not match"
+	}
+
+	return fmt.Sprintf("ambiguous censored edit: expected %d placeholders, got %d", e.Expected, e.Actual)
+}
+
+// Restore : 伏字が適用された状態で編集されたテキストの置換後の文字列を、元のテキストの閲覧できない伏字で順番に置き換える
+// 伏字の数、または伏字のカテゴリが元のテキストと合わない場合 (伏字をすべて消した場合を含む) は AmbiguousEditError を返す
+// 戻したテキストで閲覧できない伏字が元の伏字と順番に一致しない場合 (置換後の文字列の前後に !! を足した場合など) も AmbiguousEditError を返す
+func (v Viewer) Restore(original, edited string) (string, error) {
+	secrets, categories := v.hiddenSpans(original)
+	placeholders := placeholderPattern.FindAllStringSubmatchIndex(edited, -1)
+	if len(placeholders) != len(secrets) {
+		return "", &AmbiguousEditError{Expected: len(secrets), Actual: len(placeholders), CategoryMismatch: false, DelimiterMismatch: false}
+	}
+	for i, loc := range placeholders {
+		category := ""
+		if loc[2] >= 0 {
+			category = edited[loc[2]:loc[3]]
+		}
+		if category != categories[i] {
+			return "", &AmbiguousEditError{Expected: len(secrets), Actual: len(placeholders), CategoryMismatch: true, DelimiterMismatch: false}
+		}
+	}
+
+	var b strings.Builder
+	last := 0
+	for i, loc := range placeholders {
+		b.WriteString(edited[last:loc[0]])
+		b.WriteString(secrets[i])
+		last = loc[1]
+	}
+	b.WriteString(edited[last:])
+	restored := b.String()
+
+	// 戻した伏字が別の伏字として読まれると、伏字の中身が伏せられずに返される
+	if spans, _ := v.hiddenSpans(restored); !slices.Equal(spans, secrets) || v.Apply(restored) != edited {
+		return "", &AmbiguousEditError{Expected: len(secrets), Actual: len(placeholders), CategoryMismatch: false, DelimiterMismatch: true}
+	}
+
+	return restored, nil
+}
+
+// Spans : 文字列内の伏字の位置をバイト単位で返す
+func Spans(input string) [][]int {
+	return pattern.FindAllStringIndex(input, -1)
+}
@@ -0,0 +1,90 @@
+package censor
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestRestore(t *testing.T) {
+	assistant := Viewer{
+		Role:     "assistant",
+		Assignee: false,
+		Policy:   Policy{"contact": Rule{Roles: []string{"assistant"}, Assignees: false}},
+	}
+
+	tests := []struct {
+		name     string
+		original string
+		edited   string
+		want     string
+		// wantErr : 返すべき AmbiguousEditError (nil なら成功する)
+		wantErr *AmbiguousEditError
+	}{
+		{
+			name:     "restore hidden spans in order",
+			original: "price is !!price:1000000!! yen, call !!contact:090!! or !!secret!!",
+			edited:   "the price is !!price:■■■!! yen, call !!contact:080!! or !!■■■!!",
+			want:     "the price is !!price:1000000!! yen, call !!contact:080!! or !!secret!!",
+			wantErr:  nil,
+		},
+		{
+			name:     "placeholder removed",
+			original: "price is !!price:1000000!! yen",
+			edited:   "price is unknown",
+			want:     "",
+			wantErr:  &AmbiguousEditError{Expected: 1, Actual: 0, CategoryMismatch: false, DelimiterMismatch: false},
+		},
+		{
+			name:     "placeholder category changed",
+			original: "price is !!price:1000000!! yen",
+			edited:   "price is !!contact:■■■!! yen",
+			want:     "",
+			wantErr:  &AmbiguousEditError{Expected: 1, Actual: 1, CategoryMismatch: true, DelimiterMismatch: false},
+		},
+		{
+			name:     "delimiter added before a placeholder",
+			original: "price is !!price:1000000!! yen",
+			edited:   "price is !!!!price:■■■!! yen",
+			want:     "",
+			wantErr:  &AmbiguousEditError{Expected: 1, Actual: 1, CategoryMismatch: false, DelimiterMismatch: true},
+		},
+		{
+			name:     "visible span opened before a placeholder",
+			original: "price is !!price:1000000!! yen",
+			edited:   "!!contact:!!price:■■■!!",
+			want:     "",
+			wantErr:  &AmbiguousEditError{Expected: 1, Actual: 1, CategoryMismatch: false, DelimiterMismatch: true},
+		},
+		{
+			name:     "delimiter added after a placeholder",
+			original: "price is !!price:1000000!! yen",
+			edited:   "price is !!price:■■■!!!! yen!!",
+			want:     "",
+			wantErr:  &AmbiguousEditError{Expected: 1, Actual: 1, CategoryMismatch: false, DelimiterMismatch: true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := assistant.Restore(tt.original, tt.edited)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("Restore() error = %v", err)
+				}
+				if got != tt.want {
+					t.Errorf("Restore() = %q, want %q", got, tt.want)
+				}
+
+				return
+			}
+
+			var ambiguous *AmbiguousEditError
+			if !errors.As(err, &ambiguous) {
+				t.Fatalf("Restore() = %q, %v, want AmbiguousEditError", got, err)
+			}
+			if *ambiguous != *tt.wantErr {
+				t.Errorf("Restore() error = %+v, want %+v", *ambiguous, *tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,96 @@
+package pii
+
+import (
+	"regexp"
+	"slices"
+)
+
+const (
+	KindEmail       = "email"
+	KindPhone       = "phone"
+	KindPostalCode  = "postal_code"
+	KindBankAccount = "bank_account"
+)
+
+type (
+	// Finding : 検出した個人情報の種類とバイト単位の位置
+	Finding struct {
+		Kind  string
+		Start int
+		End   int
+	}
+
+	// Detector : テキストから個人情報を検出する
+	Detector interface {
+		Detect(text string) []Finding
+	}
+
+	// PatternDetector : 正規表現で個人情報を検出する
+	// サブマッチがある場合は最初のサブマッチの位置を検出結果とする
+	PatternDetector struct {
+		Kind    string
+		Pattern *regexp.Regexp
+	}
+
+	// Detectors : 複数の Detector の検出結果を位置順にまとめる (重なる検出結果は先に始まるものを優先する)
+	Detectors []Detector
+)
+
+func (d PatternDetector) Detect(text string) []Finding {
+	findings := []Finding{}
+	for _, loc := range d.Pattern.FindAllStringSubmatchIndex(text, -1) {
+		start, end := loc[0], loc[1]
+		if len(loc) >= 4 && loc[2] >= 0 {
+			start, end = loc[2], loc[3]
+		}
+		findings = append(findings, Finding{Kind: d.Kind, Start: start, End: end})
+	}
+
+	return findings
+}
+
+func (ds Detectors) Detect(text string) []Finding {
+	all := []Finding{}
+	for _, d := range ds {
+		all = append(all, d.Detect(text)...)
+	}
+	slices.SortStableFunc(all, func(a, b Finding) int {
+		if a.Start != b.Start {
+			return a.Start - b.Start
+		}
+
+		return b.End - a.End
+	})
+
+	findings := []Finding{}
+	for _, f := range all {
+		if len(findings) > 0 && f.Start < findings[len(findings)-1].End {
+			continue
+		}
+		findings = append(findings, f)
+	}
+
+	return findings
+}
+
+// NewDefaultDetector : メールアドレス・日本の電話番号・郵便番号・口座番号を検出する
+func NewDefaultDetector() Detector {
+	return Detectors{
+		PatternDetector{
+			Kind:    KindEmail,
+			Pattern: regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*\.[A-Za-z]{2,}`),
+		},
+		PatternDetector{
+			Kind:    KindPhone,
+			Pattern: regexp.MustCompile(`(?:\+81[- ]?\d{1,4}|\b0\d{1,4})-\d{1,4}-\d{4}\b|\b0[5789]0\d{8}\b`),
+		},
+		PatternDetector{
+			Kind:    KindPostalCode,
+			Pattern: regexp.MustCompile(`〒\s*\d{3}-?\d{4}\b|\b\d{3}-\d{4}\b`),
+		},
+		PatternDetector{
+			Kind:    KindBankAccount,
+			Pattern: regexp.MustCompile(`(?:口座番号|口座|普通|当座)\s*(?:預金)?\s*[:：]?\s*(\d{7})\b`),
+		},
+	}
+}
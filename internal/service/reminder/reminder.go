@@ -0,0 +1,239 @@
+package reminder
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/authz"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/bot"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/censor"
+)
+
+const (
+	defaultInterval = 10 * time.Minute
+	// noteReminderOffset は送信待ちのノートのリマインドの記録に用いる間隔 (基準日時ごとに 1 回だけ送る)
+	noteReminderOffset = 0
+)
+
+type Config struct {
+	// Interval は未送信のリマインドを確認する間隔
+	Interval time.Duration
+	// Now は現在時刻を返す (テスト時に差し替える)
+	Now func() time.Time
+}
+
+// Scheduler は設定されたリマインド間隔に従って担当者に DM を送るサービス
+type Scheduler struct {
+	repo     *repository.Repository
+	sender   bot.MessageSender
+	resolver bot.UserResolver
+	interval time.Duration
+	now      func() time.Time
+}
+
+// NewScheduler は新しい Scheduler を作成する
+func NewScheduler(repo *repository.Repository, sender bot.MessageSender, resolver bot.UserResolver, cfg Config) *Scheduler {
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+	now := cfg.Now
+	if now == nil {
+		now = time.Now
+	}
+
+	return &Scheduler{
+		repo:     repo,
+		sender:   sender,
+		resolver: resolver,
+		interval: interval,
+		now:      now,
+	}
+}
+
+// Run は ctx がキャンセルされるまで定期的にリマインドを送信する
+func (s *Scheduler) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := s.RunOnce(ctx); err != nil {
+			log.Printf("failed to send reminders: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce は送信すべきリマインドを 1 回だけ確認して送信する
+func (s *Scheduler) RunOnce(ctx context.Context) error {
+	cfg, err := s.repo.GetConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("get config: %w", err)
+	}
+
+	now := s.now()
+	if err := s.remindOverdueTickets(ctx, now, cfg.ReminderInterval.OverdueDay); err != nil {
+		return err
+	}
+	if err := s.remindWaitingSentNotes(ctx, now, cfg.ReminderInterval.NotesentHour); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Scheduler) remindOverdueTickets(ctx context.Context, now time.Time, overdueDays []int) error {
+	offsets := slices.Clone(overdueDays)
+	offsets = slices.DeleteFunc(offsets, func(d int) bool { return d < 0 })
+	if len(offsets) == 0 {
+		return nil
+	}
+	slices.Sort(offsets)
+
+	today := toDate(now)
+	tickets, err := s.repo.GetOverdueTickets(ctx, today.AddDate(0, 0, 1-offsets[0]))
+	if err != nil {
+		return fmt.Errorf("get overdue tickets: %w", err)
+	}
+
+	for _, ticket := range tickets {
+		due := toDate(ticket.Due.Time)
+		daysOverdue := int(today.Sub(due).Hours() / 24)
+
+		// 停止中に複数の日数を過ぎていた場合でも、まとめて 1 通だけ送る
+		claimed := []int{}
+		for _, offset := range offsets {
+			if offset > daysOverdue {
+				break
+			}
+			ok, err := s.repo.MarkReminderSent(ctx, repository.ReminderKindTicketOverdue, ticket.ID, offset, ticket.Due.Time)
+			if err != nil {
+				return fmt.Errorf("mark reminder sent: %w", err)
+			}
+			if ok {
+				claimed = append(claimed, offset)
+			}
+		}
+		if len(claimed) == 0 {
+			continue
+		}
+
+		message := fmt.Sprintf(
+			"## チケット(ID: %d)の期日を%d日過ぎています\nタイトル: %s\n期日: %s\nステータス: %s",
+			ticket.ID, daysOverdue, ticket.Title, due.Format(time.DateOnly), ticket.Status,
+		)
+		if s.notify(ctx, ticket.ID, ticket.Visibility, append([]string{ticket.Assignee}, ticket.SubAssignees...), message) {
+			continue
+		}
+		for _, offset := range claimed {
+			if err := s.repo.UnmarkReminderSent(ctx, repository.ReminderKindTicketOverdue, ticket.ID, offset, ticket.Due.Time); err != nil {
+				return fmt.Errorf("unmark reminder sent: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (s *Scheduler) remindWaitingSentNotes(ctx context.Context, now time.Time, notesentHour int) error {
+	if notesentHour <= 0 {
+		return nil
+	}
+
+	notes, err := s.repo.GetWaitingSentNotes(ctx, now.Add(-time.Duration(notesentHour)*time.Hour))
+	if err != nil {
+		return fmt.Errorf("get waiting sent notes: %w", err)
+	}
+
+	for _, note := range notes {
+		// 送信待ちに戻ったノートには改めて送るため、最後に更新された日時を基準にする
+		// 設定した時間を変更しても送り直さないよう、時間は記録に含めない
+		ok, err := s.repo.MarkReminderSent(ctx, repository.ReminderKindNoteWaitingSent, note.ID, noteReminderOffset, note.UpdatedAt)
+		if err != nil {
+			return fmt.Errorf("mark reminder sent: %w", err)
+		}
+		if !ok {
+			continue
+		}
+
+		message := fmt.Sprintf(
+			"## チケット(ID: %d)のノート(ID: %d)が承認後%d時間以上送信されていません\n作成者: @%s",
+			note.TicketID, note.ID, notesentHour, note.UserID,
+		)
+		if s.notify(ctx, note.TicketID, note.Visibility, append([]string{note.Assignee}, note.SubAssignees...), message) {
+			continue
+		}
+		if err := s.repo.UnmarkReminderSent(ctx, repository.ReminderKindNoteWaitingSent, note.ID, noteReminderOffset, note.UpdatedAt); err != nil {
+			return fmt.Errorf("unmark reminder sent: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// notify は重複を除いた宛先それぞれに DM を送る
+// 宛先はチケットの担当者・副担当者で、チケットの公開範囲外の宛先には送らない
+// 本文の伏字は宛先ごとに、宛先が閲覧できないカテゴリを伏せる
+// 送信に失敗しても他の宛先への送信は続け、誰にも届かずに失敗した場合は false を返す (再送する)
+func (s *Scheduler) notify(ctx context.Context, ticketID int64, visibility string, traqIDs []string, message string) bool {
+	policy, err := s.repo.GetCensorPolicy(ctx)
+	if err != nil {
+		log.Printf("failed to get censor policy: %v", err)
+
+		return false
+	}
+
+	delivered, failed := false, false
+	seen := make(map[string]struct{}, len(traqIDs))
+	for _, traqID := range traqIDs {
+		if _, ok := seen[traqID]; ok || traqID == "" {
+			continue
+		}
+		seen[traqID] = struct{}{}
+
+		role, err := s.repo.GetUserRoleByTraqID(ctx, traqID)
+		if err != nil {
+			log.Printf("failed to get role of %s: %v", traqID, err)
+
+			continue
+		}
+		if !authz.TicketVisible(role, authz.RelationAssignee, visibility) {
+			continue
+		}
+
+		userID, err := s.resolver.ResolveUserID(ctx, traqID)
+		if err != nil {
+			log.Printf("failed to resolve user %s: %v", traqID, err)
+			failed = true
+
+			continue
+		}
+		// 宛先はチケットの担当者・副担当者なので、担当者として伏字の閲覧ルールを適用する
+		viewer := censor.Viewer{Role: role, Assignee: true, Policy: policy}
+		content := s.repo.CensorDirectMessage(ctx, traqID, viewer, ticketID, message)
+		if err := s.sender.PostDirectMessage(ctx, userID, content); err != nil {
+			log.Printf("failed to send reminder to %s: %v", traqID, err)
+			failed = true
+
+			continue
+		}
+		delivered = true
+	}
+
+	return delivered || !failed
+}
+
+func toDate(t time.Time) time.Time {
+	t = t.In(repository.JST)
+
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, repository.JST)
+}
@@ -0,0 +1,76 @@
+package retention
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+)
+
+const defaultInterval = time.Hour
+
+type Config struct {
+	// Interval は保持期間を過ぎたチケットを確認する間隔
+	Interval time.Duration
+}
+
+// Purger は設定された保持期間を過ぎた削除済みチケットを完全に削除するサービス
+type Purger struct {
+	repo     *repository.Repository
+	interval time.Duration
+}
+
+// NewPurger は新しい Purger を作成する
+func NewPurger(repo *repository.Repository, cfg Config) *Purger {
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	return &Purger{
+		repo:     repo,
+		interval: interval,
+	}
+}
+
+// Run は ctx がキャンセルされるまで定期的に削除済みチケットを完全に削除する
+func (p *Purger) Run(ctx context.Context) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := p.RunOnce(ctx); err != nil {
+			log.Printf("failed to purge trashed tickets: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce は保持期間を過ぎた削除済みチケットを 1 回だけ完全に削除し、削除した件数を返す
+// 保持期間が 0 の場合は何もしない
+func (p *Purger) RunOnce(ctx context.Context) (int64, error) {
+	cfg, err := p.repo.GetConfig(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get config: %w", err)
+	}
+	if cfg.TrashRetentionDays <= 0 {
+		return 0, nil
+	}
+
+	purged, err := p.repo.PurgeExpiredTickets(ctx, cfg.TrashRetentionDays)
+	if err != nil {
+		return 0, fmt.Errorf("purge expired tickets: %w", err)
+	}
+	if purged > 0 {
+		log.Printf("purged %d trashed tickets", purged)
+	}
+
+	return purged, nil
+}
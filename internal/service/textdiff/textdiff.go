@@ -0,0 +1,204 @@
+package textdiff
+
+import "strings"
+
+const (
+	OpEqual  = "equal"
+	OpInsert = "insert"
+	OpDelete = "delete"
+)
+
+// maxLCSCells は最長共通部分列の表の要素数の上限
+// 共通の先頭・末尾を除いても上限を超える場合は、残りをすべて削除・追加した差分にする
+const maxLCSCells = 1 << 20
+
+// Line は差分の 1 行
+type Line struct {
+	// Op は OpEqual (変更なし), OpInsert (追加), OpDelete (削除) のいずれか
+	Op   string
+	Text string
+	// Segments は書き換えられた行の文字単位の差分
+	// 削除された行には OpEqual と OpDelete、追加された行には OpEqual と OpInsert の区間が入る
+	// 対応する行がない追加・削除と、変更のない行では nil
+	Segments []Segment
+}
+
+// Segment は文字単位の差分の区間
+type Segment struct {
+	Op   string
+	Text string
+}
+
+// Lines は before から after への行単位の差分を返す
+// 最長共通部分列を変更なしの行とし、同じ位置の削除は追加より先に並べる
+// 連続する削除と追加は先頭から順に対応させ、文字単位の差分を Segments に入れる
+// 変更が大きすぎる場合は、共通の先頭・末尾以外をすべて削除・追加した差分になる
+func Lines(before, after string) []Line {
+	ops := diff(splitLines(before), splitLines(after))
+
+	lines := make([]Line, 0, len(ops))
+	for i := 0; i < len(ops); {
+		if ops[i].op == OpEqual {
+			lines = append(lines, Line{Op: OpEqual, Text: ops[i].value, Segments: nil})
+			i++
+
+			continue
+		}
+
+		// 変更のない行までの削除と追加をまとめて扱う
+		var deleted, inserted []string
+		for ; i < len(ops) && ops[i].op != OpEqual; i++ {
+			if ops[i].op == OpDelete {
+				deleted = append(deleted, ops[i].value)
+			} else {
+				inserted = append(inserted, ops[i].value)
+			}
+		}
+
+		deletedSegments := make([][]Segment, len(deleted))
+		insertedSegments := make([][]Segment, len(inserted))
+		for k := 0; k < len(deleted) && k < len(inserted); k++ {
+			deletedSegments[k], insertedSegments[k] = chars(deleted[k], inserted[k])
+		}
+		for k, text := range deleted {
+			lines = append(lines, Line{Op: OpDelete, Text: text, Segments: deletedSegments[k]})
+		}
+		for k, text := range inserted {
+			lines = append(lines, Line{Op: OpInsert, Text: text, Segments: insertedSegments[k]})
+		}
+	}
+
+	return lines
+}
+
+// Changed は差分に追加・削除が含まれるか
+func Changed(lines []Line) bool {
+	for _, line := range lines {
+		if line.Op != OpEqual {
+			return true
+		}
+	}
+
+	return false
+}
+
+// chars は before から after への文字単位の差分を、before 側と after 側の区間に分けて返す
+func chars(before, after string) ([]Segment, []Segment) {
+	ops := diff(strings.Split(before, ""), strings.Split(after, ""))
+
+	var beforeSegments, afterSegments []Segment
+	for _, op := range ops {
+		switch op.op {
+		case OpEqual:
+			beforeSegments = appendSegment(beforeSegments, OpEqual, op.value)
+			afterSegments = appendSegment(afterSegments, OpEqual, op.value)
+		case OpDelete:
+			beforeSegments = appendSegment(beforeSegments, OpDelete, op.value)
+		case OpInsert:
+			afterSegments = appendSegment(afterSegments, OpInsert, op.value)
+		}
+	}
+
+	return beforeSegments, afterSegments
+}
+
+// appendSegment は直前の区間と同じ種類の場合は連結する
+func appendSegment(segments []Segment, op, text string) []Segment {
+	if n := len(segments); n > 0 && segments[n-1].Op == op {
+		segments[n-1].Text += text
+
+		return segments
+	}
+
+	return append(segments, Segment{Op: op, Text: text})
+}
+
+type diffOp struct {
+	op    string
+	value string
+}
+
+// diff は a から b への要素単位の差分を返す
+// 共通の先頭・末尾を除いた残りの最長共通部分列を変更なしの要素とする
+func diff(a, b []string) []diffOp {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	ops := make([]diffOp, 0, len(a)+len(b))
+	for _, value := range a[:prefix] {
+		ops = append(ops, diffOp{op: OpEqual, value: value})
+	}
+	middleA, middleB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]
+	if len(middleA)*len(middleB) > maxLCSCells {
+		ops = appendReplace(ops, middleA, middleB)
+	} else {
+		ops = appendLCS(ops, middleA, middleB)
+	}
+	for _, value := range a[len(a)-suffix:] {
+		ops = append(ops, diffOp{op: OpEqual, value: value})
+	}
+
+	return ops
+}
+
+// appendReplace は a をすべて削除して b をすべて追加する差分を ops に追加する
+func appendReplace(ops []diffOp, a, b []string) []diffOp {
+	for _, value := range a {
+		ops = append(ops, diffOp{op: OpDelete, value: value})
+	}
+	for _, value := range b {
+		ops = append(ops, diffOp{op: OpInsert, value: value})
+	}
+
+	return ops
+}
+
+// appendLCS は最長共通部分列を変更なしの要素とした a から b への差分を ops に追加する
+func appendLCS(ops []diffOp, a, b []string) []diffOp {
+	// lcs[i][j] は a[i:] と b[j:] の最長共通部分列の長さ
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			ops = append(ops, diffOp{op: OpEqual, value: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			ops = append(ops, diffOp{op: OpDelete, value: a[i]})
+			i++
+		default:
+			ops = append(ops, diffOp{op: OpInsert, value: b[j]})
+			j++
+		}
+	}
+
+	return appendReplace(ops, a[i:], b[j:])
+}
+
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+}
@@ -0,0 +1,110 @@
+package textdiff
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLines(t *testing.T) {
+	tests := map[string]struct {
+		before, after string
+		want          []Line
+	}{
+		"empty": {
+			before: "",
+			after:  "",
+			want:   []Line{},
+		},
+		"from empty": {
+			before: "",
+			after:  "a\nb",
+			want: []Line{
+				{Op: OpInsert, Text: "a", Segments: nil},
+				{Op: OpInsert, Text: "b", Segments: nil},
+			},
+		},
+		"to empty": {
+			before: "a",
+			after:  "",
+			want: []Line{
+				{Op: OpDelete, Text: "a", Segments: nil},
+			},
+		},
+		"insert": {
+			before: "a\nc",
+			after:  "a\nb\nc",
+			want: []Line{
+				{Op: OpEqual, Text: "a", Segments: nil},
+				{Op: OpInsert, Text: "b", Segments: nil},
+				{Op: OpEqual, Text: "c", Segments: nil},
+			},
+		},
+		"delete": {
+			before: "a\nb\nc",
+			after:  "a\nc",
+			want: []Line{
+				{Op: OpEqual, Text: "a", Segments: nil},
+				{Op: OpDelete, Text: "b", Segments: nil},
+				{Op: OpEqual, Text: "c", Segments: nil},
+			},
+		},
+		"replace": {
+			before: "a\nhello world\nc",
+			after:  "a\nhello there\nc",
+			want: []Line{
+				{Op: OpEqual, Text: "a", Segments: nil},
+				{Op: OpDelete, Text: "hello world", Segments: []Segment{{Op: OpEqual, Text: "hello "}, {Op: OpDelete, Text: "wo"}, {Op: OpEqual, Text: "r"}, {Op: OpDelete, Text: "ld"}}},
+				{Op: OpInsert, Text: "hello there", Segments: []Segment{{Op: OpEqual, Text: "hello "}, {Op: OpInsert, Text: "the"}, {Op: OpEqual, Text: "r"}, {Op: OpInsert, Text: "e"}}},
+				{Op: OpEqual, Text: "c", Segments: nil},
+			},
+		},
+		"multibyte": {
+			before: "協賛をお願いします。",
+			after:  "ご協賛をお願いいたします。",
+			want: []Line{
+				{Op: OpDelete, Text: "協賛をお願いします。", Segments: []Segment{{Op: OpEqual, Text: "協賛をお願いします。"}}},
+				{Op: OpInsert, Text: "ご協賛をお願いいたします。", Segments: []Segment{{Op: OpInsert, Text: "ご"}, {Op: OpEqual, Text: "協賛をお願い"}, {Op: OpInsert, Text: "いた"}, {Op: OpEqual, Text: "します。"}}},
+			},
+		},
+		"crlf": {
+			before: "a\r\nb",
+			after:  "a\nb",
+			want: []Line{
+				{Op: OpEqual, Text: "a", Segments: nil},
+				{Op: OpEqual, Text: "b", Segments: nil},
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := Lines(tt.before, tt.after); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lines(%q, %q) = %+v, want %+v", tt.before, tt.after, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinesTooLarge(t *testing.T) {
+	// 文字単位の表が上限を超える行は、共通の先頭・末尾以外をまとめて削除・追加する
+	before := "[" + strings.Repeat("あい", 1000) + "]"
+	after := "[" + strings.Repeat("いあ", 1000) + "]"
+
+	want := []Line{
+		{Op: OpDelete, Text: before, Segments: []Segment{{Op: OpEqual, Text: "["}, {Op: OpDelete, Text: strings.Repeat("あい", 1000)}, {Op: OpEqual, Text: "]"}}},
+		{Op: OpInsert, Text: after, Segments: []Segment{{Op: OpEqual, Text: "["}, {Op: OpInsert, Text: strings.Repeat("いあ", 1000)}, {Op: OpEqual, Text: "]"}}},
+	}
+	if got := Lines(before, after); !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines() = %+v, want %+v", got, want)
+	}
+}
+
+func TestChanged(t *testing.T) {
+	if Changed(Lines("a\nb", "a\nb")) {
+		t.Error("Changed() = true for the same text")
+	}
+	if !Changed(Lines("a\nb", "a\nc")) {
+		t.Error("Changed() = false for different text")
+	}
+}
@@ -0,0 +1,206 @@
+package userdir
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/traP-jp/anshin-techo-backend/internal/service/bot"
+	"github.com/traPtitech/go-traq"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	defaultTTL = 10 * time.Minute
+	// missRefreshInterval は見つからないユーザーのため、または取得に失敗した後に再取得する最短の間隔
+	missRefreshInterval = time.Minute
+)
+
+var (
+	// ErrUserNotFound は traQ にユーザーが見つからない
+	ErrUserNotFound = fmt.Errorf("traq user not found")
+	// ErrUnavailable は traQ API クライアントが設定されていない
+	ErrUnavailable = fmt.Errorf("traq api client is not available")
+)
+
+type Config struct {
+	// TTL は traQ から取得したユーザー一覧をキャッシュする期間
+	TTL time.Duration
+}
+
+// Profile は traQ のユーザー情報
+type Profile struct {
+	// ID は traQ のユーザー UUID
+	ID          string
+	TraqID      string
+	DisplayName string
+	IconFileID  string
+	Bot         bool
+	Suspended   bool
+}
+
+// Directory は traQ ID・ユーザー UUID・表示名を相互に解決する、traQ のユーザー一覧のキャッシュ
+type Directory struct {
+	traq *traq.APIClient
+	ttl  time.Duration
+	now  func() time.Time
+
+	// refreshing は同時に複数の取得を行わないためのもの (取得中は mu を取得しない)
+	refreshing singleflight.Group
+
+	mu        sync.Mutex
+	byTraqID  map[string]*Profile
+	byID      map[string]*Profile
+	fetchedAt time.Time
+	failedAt  time.Time
+}
+
+var _ bot.UserResolver = (*Directory)(nil)
+
+// New は新しい Directory を作成する
+func New(client *traq.APIClient, cfg Config) *Directory {
+	ttl := cfg.TTL
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+
+	return &Directory{
+		traq:       client,
+		ttl:        ttl,
+		now:        time.Now,
+		refreshing: singleflight.Group{},
+		mu:         sync.Mutex{},
+		byTraqID:   map[string]*Profile{},
+		byID:       map[string]*Profile{},
+		fetchedAt:  time.Time{},
+		failedAt:   time.Time{},
+	}
+}
+
+// ByTraqID は traQ ID に対応するユーザー情報を返す
+func (d *Directory) ByTraqID(ctx context.Context, traqID string) (*Profile, error) {
+	return d.lookup(ctx, func() (*Profile, bool) {
+		p, ok := d.byTraqID[traqID]
+
+		return p, ok
+	})
+}
+
+// ByID は traQ のユーザー UUID に対応するユーザー情報を返す
+func (d *Directory) ByID(ctx context.Context, userID string) (*Profile, error) {
+	return d.lookup(ctx, func() (*Profile, bool) {
+		p, ok := d.byID[userID]
+
+		return p, ok
+	})
+}
+
+// ResolveUserID は traQ ID に対応するユーザー UUID を返す
+func (d *Directory) ResolveUserID(ctx context.Context, traqID string) (string, error) {
+	p, err := d.ByTraqID(ctx, traqID)
+	if err != nil {
+		return "", err
+	}
+
+	return p.ID, nil
+}
+
+// lookup はキャッシュからユーザーを探す
+// キャッシュが古い場合、またはユーザーが見つからず前回の取得から一定時間経っている場合は traQ から取得し直す
+// 取得に失敗した場合は、一度でも取得できていれば古いキャッシュを使い、一定時間は取得し直さない
+func (d *Directory) lookup(ctx context.Context, find func() (*Profile, bool)) (*Profile, error) {
+	if d.needsRefresh(find) {
+		if err := d.refresh(ctx); err != nil {
+			d.mu.Lock()
+			fetched := !d.fetchedAt.IsZero()
+			d.mu.Unlock()
+			if !fetched {
+				return nil, err
+			}
+			log.Printf("failed to refresh traq users, using the cached users: %v", err)
+		}
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	p, ok := find()
+	if !ok {
+		return nil, ErrUserNotFound
+	}
+	copied := *p
+
+	return &copied, nil
+}
+
+// needsRefresh はキャッシュを traQ から取得し直すべきか
+func (d *Directory) needsRefresh(find func() (*Profile, bool)) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	now := d.now()
+	// 取得済みの一覧があれば、取得に失敗した直後は古いキャッシュを使う
+	if !d.fetchedAt.IsZero() && now.Sub(d.failedAt) < missRefreshInterval {
+		return false
+	}
+	elapsed := now.Sub(d.fetchedAt)
+	if elapsed >= d.ttl {
+		return true
+	}
+	_, ok := find()
+
+	return !ok && elapsed >= missRefreshInterval
+}
+
+// refresh は traQ からユーザー一覧を取得し直す
+// 同時に呼び出された場合は 1 回だけ取得し、取得中はキャッシュのロックを取らない
+func (d *Directory) refresh(ctx context.Context) error {
+	_, err, _ := d.refreshing.Do("users", func() (any, error) {
+		return nil, d.fetch(ctx)
+	})
+
+	return err
+}
+
+// fetch は traQ からユーザー一覧を取得してキャッシュを置き換える
+func (d *Directory) fetch(ctx context.Context) error {
+	if d.traq == nil {
+		return ErrUnavailable
+	}
+
+	users, _, err := d.traq.UserAPI.GetUsers(ctx).IncludeSuspended(true).Execute()
+	if err != nil {
+		d.mu.Lock()
+		d.failedAt = d.now()
+		d.mu.Unlock()
+
+		return fmt.Errorf("get traq users: %w", err)
+	}
+
+	byTraqID := make(map[string]*Profile, len(users))
+	byID := make(map[string]*Profile, len(users))
+	for _, user := range users {
+		p := &Profile{
+			ID:          user.Id,
+			TraqID:      user.Name,
+			DisplayName: user.DisplayName,
+			IconFileID:  user.IconFileId,
+			Bot:         user.Bot,
+			Suspended:   user.State != traq.USERACCOUNTSTATE_active,
+		}
+		byTraqID[p.TraqID] = p
+		byID[p.ID] = p
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.byTraqID = byTraqID
+	d.byID = byID
+	d.fetchedAt = d.now()
+	d.failedAt = time.Time{}
+
+	return nil
+}
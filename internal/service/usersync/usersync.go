@@ -0,0 +1,175 @@
+package usersync
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/traP-jp/anshin-techo-backend/internal/repository"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/authz"
+	"github.com/traPtitech/go-traq"
+)
+
+const defaultInterval = time.Hour
+
+// Actor は定期的な同期でロールの変更記録に残す変更者
+const Actor = "traq-group-sync"
+
+var (
+	// ErrNotConfigured は同期する traQ グループが 1 つも設定されていない
+	ErrNotConfigured = fmt.Errorf("no traq groups are configured for user sync")
+	// ErrNoManager は同期すると本職が 1 人もいなくなる (誰でもユーザーを変更できる初期設定の状態に戻ってしまう)
+	ErrNoManager = fmt.Errorf("user sync would leave no manager")
+)
+
+type Config struct {
+	// Interval は traQ グループから同期する間隔
+	Interval time.Duration
+	// ManagerGroupIDs, AssistantGroupIDs, MemberGroupIDs はそれぞれのロールにする traQ グループの UUID
+	// 複数のグループに所属するユーザーは、本職・補佐・部員の順で上位のロールになる
+	ManagerGroupIDs   []string
+	AssistantGroupIDs []string
+	MemberGroupIDs    []string
+}
+
+// Syncer は traQ グループのメンバーをユーザーとロールに同期するサービス
+type Syncer struct {
+	repo     *repository.Repository
+	traq     *traq.APIClient
+	interval time.Duration
+	groups   []roleGroups
+}
+
+type roleGroups struct {
+	role     string
+	groupIDs []string
+}
+
+// NewSyncer は新しい Syncer を作成する
+func NewSyncer(repo *repository.Repository, client *traq.APIClient, cfg Config) *Syncer {
+	interval := cfg.Interval
+	if interval <= 0 {
+		interval = defaultInterval
+	}
+
+	return &Syncer{
+		repo:     repo,
+		traq:     client,
+		interval: interval,
+		groups: []roleGroups{
+			{role: authz.RoleManager, groupIDs: cfg.ManagerGroupIDs},
+			{role: authz.RoleAssistant, groupIDs: cfg.AssistantGroupIDs},
+			{role: authz.RoleMember, groupIDs: cfg.MemberGroupIDs},
+		},
+	}
+}
+
+// Configured は同期する traQ グループが設定されているか
+func (s *Syncer) Configured() bool {
+	for _, g := range s.groups {
+		if len(g.groupIDs) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Run は ctx がキャンセルされるまで定期的に traQ グループから同期する
+// 同期する traQ グループが設定されていない場合は何もしない
+func (s *Syncer) Run(ctx context.Context) {
+	if !s.Configured() {
+		return
+	}
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		changes, err := s.RunOnce(ctx, Actor, false)
+		if err != nil {
+			log.Printf("failed to sync users from traq groups: %v", err)
+		} else if len(changes) > 0 {
+			log.Printf("synced %d user changes from traq groups", len(changes))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce は traQ グループから 1 回だけ同期し、変更を返す
+// dryRun が true の場合は変更を適用せずに返す
+func (s *Syncer) RunOnce(ctx context.Context, actor string, dryRun bool) ([]*repository.RoleChange, error) {
+	if !s.Configured() {
+		return nil, ErrNotConfigured
+	}
+
+	users, err := s.groupUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	hasManager := false
+	for _, user := range users {
+		hasManager = hasManager || user.Role == authz.RoleManager
+	}
+	if !hasManager {
+		return nil, ErrNoManager
+	}
+
+	if dryRun {
+		changes, err := s.repo.PreviewSyncUsers(ctx, users)
+		if err != nil {
+			return nil, fmt.Errorf("preview user sync: %w", err)
+		}
+
+		return changes, nil
+	}
+
+	changes, err := s.repo.SyncUsers(ctx, actor, users)
+	if err != nil {
+		return nil, fmt.Errorf("sync users: %w", err)
+	}
+
+	return changes, nil
+}
+
+// groupUsers は設定された traQ グループのメンバーとロールを取得する
+// 凍結されたユーザー・Bot は含めない
+func (s *Syncer) groupUsers(ctx context.Context) ([]*repository.User, error) {
+	roles := map[string]string{}
+	for _, g := range s.groups {
+		for _, groupID := range g.groupIDs {
+			members, _, err := s.traq.GroupAPI.GetUserGroupMembers(ctx, groupID).Execute()
+			if err != nil {
+				return nil, fmt.Errorf("get members of traq group %s: %w", groupID, err)
+			}
+			for _, member := range members {
+				// 上位のロールのグループから順に見るので、すでにロールがあれば上書きしない
+				if _, ok := roles[member.Id]; !ok {
+					roles[member.Id] = g.role
+				}
+			}
+		}
+	}
+
+	traqUsers, _, err := s.traq.UserAPI.GetUsers(ctx).IncludeSuspended(false).Execute()
+	if err != nil {
+		return nil, fmt.Errorf("get traq users: %w", err)
+	}
+
+	users := make([]*repository.User, 0, len(roles))
+	for _, traqUser := range traqUsers {
+		role, ok := roles[traqUser.Id]
+		if !ok || traqUser.Bot {
+			continue
+		}
+		users = append(users, &repository.User{TraqID: traqUser.Name, Role: role})
+	}
+
+	return users, nil
+}
@@ -1,15 +1,23 @@
 package main
 
 import (
+	"context"
+	"errors"
 	"log"
 	"net/http"
 	"os"
+	"time"
 
 	"github.com/ras0q/goalie"
 	"github.com/traP-jp/anshin-techo-backend/infrastructure/config"
 	"github.com/traP-jp/anshin-techo-backend/infrastructure/database"
 	"github.com/traP-jp/anshin-techo-backend/infrastructure/injector"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/auth"
 	"github.com/traP-jp/anshin-techo-backend/internal/service/bot"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/reminder"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/retention"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/userdir"
+	"github.com/traP-jp/anshin-techo-backend/internal/service/usersync"
 )
 
 func main() {
@@ -41,22 +49,96 @@ func run() (err error) {
 		return err
 	}
 
+	// traQ ID とユーザー UUID・表示名の対応は Bot の traQ API クライアントで取得してキャッシュする
+	users := userdir.New(botService.API(), userdir.Config{TTL: c.UserCacheTTL})
+
 	// Bot のイベントハンドラを登録
 	botHandlerService := injector.InjectBotHandlerService(injector.Dependencies{
-		DB:  db,
-		Bot: botService,
+		DB:    db,
+		Bot:   botService,
+		Users: users,
 	})
 	botHandlerService.RegisterHandlers(botService)
 
+	// 検索インデックスの追加前から存在するデータを検索インデックスに登録
+	if _, err := injector.InjectRepository(injector.Dependencies{
+		DB:    db,
+		Bot:   botService,
+		Users: users,
+	}).IndexMissingSearchDocuments(context.Background()); err != nil {
+		return err
+	}
+
+	// OAuth2 のクライアント ID が設定されている場合は traQ の OAuth2 で認証する
+	// 設定されていない場合は信頼できるプロキシの X-Forwarded-User ヘッダーだけで認証し、ログインのエンドポイントは 404 を返す
+	var authProvider auth.Provider
+	if c.OAuthClientID != "" {
+		authProvider, err = auth.NewTraQProvider(auth.TraQConfig{
+			Origin:       os.Getenv("TRAQ_ORIGIN"),
+			ClientID:     c.OAuthClientID,
+			ClientSecret: c.OAuthClientSecret,
+			RedirectURL:  c.OAuthRedirectURL,
+		})
+		if err != nil {
+			return err
+		}
+	} else if len(c.TrustedProxyCIDRs) == 0 {
+		return errors.New("no authentication is configured: set TRAQ_OAUTH_CLIENT_ID or TRUSTED_PROXY_CIDRS")
+	}
+
+	// traQ グループからユーザーとロールを同期する設定
+	userSyncCfg := usersync.Config{
+		Interval:          c.UserSyncInterval,
+		ManagerGroupIDs:   c.ManagerGroupIDs,
+		AssistantGroupIDs: c.AssistantGroupIDs,
+		MemberGroupIDs:    c.MemberGroupIDs,
+	}
+
 	// サーバーの初期化
 	server, err := injector.InjectServer(injector.Dependencies{
-		DB:  db,
-		Bot: botService,
-	})
+		DB:    db,
+		Bot:   botService,
+		Users: users,
+	}, auth.Config{
+		Provider:          authProvider,
+		SessionTTL:        c.SessionTTL,
+		CookieSecure:      c.CookieSecure,
+		LoginRedirectURL:  c.LoginRedirectURL,
+		TrustedProxyCIDRs: c.TrustedProxyCIDRs,
+	}, userSyncCfg)
 	if err != nil {
 		return err
 	}
 
+	// リマインドのスケジューラを goroutine で起動
+	scheduler := injector.InjectReminderScheduler(injector.Dependencies{
+		DB:    db,
+		Bot:   botService,
+		Users: users,
+	}, reminder.Config{
+		Interval: c.ReminderInterval,
+		Now:      time.Now,
+	})
+	go scheduler.Run(context.Background())
+
+	// 削除済みチケットの自動削除を goroutine で起動
+	purger := injector.InjectTrashPurger(injector.Dependencies{
+		DB:    db,
+		Bot:   botService,
+		Users: users,
+	}, retention.Config{
+		Interval: c.TrashPurgeInterval,
+	})
+	go purger.Run(context.Background())
+
+	// traQ グループからの同期を goroutine で起動
+	syncer := injector.InjectUserSyncer(injector.Dependencies{
+		DB:    db,
+		Bot:   botService,
+		Users: users,
+	}, userSyncCfg)
+	go syncer.Run(context.Background())
+
 	// HTTP サーバーを goroutine で起動
 	go func() {
 		if err := http.ListenAndServe(c.AppAddr, server); err != nil {